@@ -9,6 +9,8 @@ require (
 	github.com/urfave/cli v1.20.0
 	golang.org/x/crypto v0.0.0-20181112202954-3d3f9f413869
 	golang.org/x/sys v0.0.0-20191029155521-f43be2a4598c
+	golang.org/x/text v0.3.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 go 1.14
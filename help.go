@@ -30,13 +30,15 @@ PARAMETERS:
    Timezone
        Local | UTC
    Import Format
-       CSV | TSV | FIXED | JSON | LTSV
+       CSV | TSV | FIXED | JSON | LTSV | XML | YAML
    Export Format
-       CSV | TSV | FIXED | JSON | LTSV | GFM | ORG | TEXT
+       CSV | TSV | FIXED | JSON | LTSV | GFM | ORG | TEXT | XML | YAML | HTML | SQL
    Import Character Encodings
        AUTO | UTF8 | UTF8M | UTF16 | UTF16BE | UTF16LE | UTF16BEM | UTF16LEM | SJIS
+       | EUCJP | ISO2022JP | GB18030 | BIG5 | EUCKR | LATIN1 | WINDOWS1250 - WINDOWS1258
    Export Character Encodings
        UTF8 | UTF8M | UTF16 | UTF16BE | UTF16LE | UTF16BEM | UTF16LEM | SJIS
+       | EUCJP | ISO2022JP | GB18030 | BIG5 | EUCKR | LATIN1 | WINDOWS1250 - WINDOWS1258
    Line Break
        CRLF | CR | LF
    JSON Escape Type
@@ -0,0 +1,49 @@
+package action
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+func Diff(ctx context.Context, proc *query.Processor, table1 string, table2 string, keys []string) error {
+	scope := query.NewReferenceScope(proc.Tx)
+
+	result, err := query.Diff(ctx, scope, parser.Identifier{Literal: "DIFF"}, tableIdentifier(table1), tableIdentifier(table2), keys)
+	if err != nil {
+		return err
+	}
+
+	w := proc.Tx.Session.Stdout()
+	if proc.Tx.Flags.ExportOptions.Format == cmd.TEXT {
+		if err = query.WriteDiffText(ctx, w, result, proc.Tx.Flags, proc.Tx.Palette); err != nil {
+			return err
+		}
+	} else {
+		_, err = query.EncodeView(ctx, w, result.View, proc.Tx.Flags.ExportOptions.Copy(), proc.Tx.Palette)
+		if err != nil {
+			if err != query.EmptyResultSetError && err != query.DataEmpty {
+				return err
+			}
+		} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak {
+			if _, err = w.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+				return query.NewSystemError(err.Error())
+			}
+		}
+	}
+
+	if 0 < result.Count() {
+		return query.NewDifferencesFoundError(result.Summary())
+	}
+	return nil
+}
+
+func tableIdentifier(table string) parser.QueryExpression {
+	if strings.EqualFold(table, "STDIN") {
+		return parser.Stdin{}
+	}
+	return parser.Identifier{Literal: table}
+}
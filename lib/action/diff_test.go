@@ -0,0 +1,48 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/file"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var diffTests = []struct {
+	Name   string
+	Table1 string
+	Table2 string
+	Keys   []string
+	Error  string
+}{
+	{
+		Name:   "File Not Exist Error",
+		Table1: "notexist",
+		Table2: "notexist2",
+		Keys:   []string{"column1"},
+		Error:  "file notexist does not exist",
+	},
+}
+
+func TestDiff(t *testing.T) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	ctx := context.Background()
+
+	for _, v := range diffTests {
+		proc := query.NewProcessor(tx)
+		err := Diff(ctx, proc, v.Table1, v.Table2, v.Keys)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package action
+
+import (
+	"context"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+func Profile(ctx context.Context, proc *query.Processor, table string, topN int) error {
+	scope := query.NewReferenceScope(proc.Tx)
+
+	profile, err := query.Profile(ctx, scope, tableIdentifier(table), topN)
+	if err != nil {
+		return err
+	}
+
+	if proc.Tx.Flags.ExportOptions.Format == cmd.JSON {
+		s, err := profile.EncodeJson(proc.Tx.Flags.ExportOptions.PrettyPrint)
+		if err != nil {
+			return err
+		}
+		return proc.Tx.Session.WriteToStdoutWithLineBreak(s)
+	}
+
+	w := query.NewObjectWriter(proc.Tx)
+	profile.WriteText(w)
+	w.Title1 = "Profile of"
+	w.Title2 = table
+	w.Title2Effect = cmd.IdentifierEffect
+	return proc.Tx.Session.WriteToStdout("\n" + w.String() + "\n")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -18,7 +19,12 @@ import (
 	"github.com/mithrandie/go-file/v2"
 )
 
-func Run(ctx context.Context, proc *query.Processor, input string, sourceFile string, outfile string) error {
+const (
+	InterruptMessage = "(To exit, press Ctrl+C again or Ctrl+D, or execute \"EXIT;\")"
+	CanceledMessage  = "Canceled: the running statement has been canceled, and its changes are discarded."
+)
+
+func Run(ctx context.Context, proc *query.Processor, input string, sourceFile string, outfile string) (err error) {
 	start := time.Now()
 
 	defer func() {
@@ -55,6 +61,27 @@ func Run(ctx context.Context, proc *query.Processor, input string, sourceFile st
 		proc.Tx.Session.SetOutFile(fp)
 	}
 
+	if proc.Tx.Flags.ExportOptions.Format == cmd.HTML && proc.Tx.Flags.ExportOptions.HtmlDocument {
+		var w io.Writer = proc.Tx.Session.Stdout()
+		if proc.Tx.Session.OutFile() != nil {
+			w = proc.Tx.Session.OutFile()
+		}
+		options := proc.Tx.Flags.ExportOptions.Copy()
+
+		title := "csvq"
+		if 0 < len(sourceFile) {
+			title = filepath.Base(sourceFile)
+		}
+		if err = query.EncodeHTMLDocumentHeader(w, title, options, proc.Tx.Palette); err != nil {
+			return err
+		}
+		defer func() {
+			if e := query.EncodeHTMLDocumentFooter(w, options); e != nil && err == nil {
+				err = e
+			}
+		}()
+	}
+
 	proc.Tx.AutoCommit = true
 	_, err = proc.Execute(ctx, statements)
 	return err
@@ -83,10 +110,16 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 
 	StartUpMessage := "" +
 		"csvq interactive shell\n" +
-		"Press Ctrl+D or execute \"EXIT;\" to terminate this shell.\n\n"
+		"Press Ctrl+D or execute \"EXIT;\" to terminate this shell.\n" +
+		"Press Ctrl+C to cancel the running statement.\n\n"
 	proc.Log(StartUpMessage, false)
 
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
 	lines := make([]string, 0)
+	interrupted := false
 
 	for {
 		if ctx.Err() != nil {
@@ -100,8 +133,23 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 			if e == io.EOF {
 				break
 			}
+			if e == query.ErrInterrupt {
+				if len(lines) < 1 && len(strings.TrimSpace(line)) < 1 {
+					if interrupted {
+						break
+					}
+					interrupted = true
+					proc.Log(InterruptMessage, false)
+				} else {
+					interrupted = false
+				}
+				lines = lines[:0]
+				proc.Tx.Session.Terminal().SetPrompt(ctx)
+				continue
+			}
 			return query.NewIOError(nil, e.Error())
 		}
+		interrupted = false
 
 		line = strings.TrimRightFunc(line, unicode.IsSpace)
 
@@ -146,7 +194,14 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 			continue
 		}
 
-		flow, e := proc.Execute(ctx, statements)
+		flow, canceled, e := executeCancelable(ctx, proc, statements, interrupt)
+		if canceled {
+			proc.LogNotice(CanceledMessage, false)
+			interrupted = false
+			lines = lines[:0]
+			proc.Tx.Session.Terminal().SetPrompt(ctx)
+			continue
+		}
 		if e != nil {
 			if ex, ok := e.(*query.ForcedExit); ok {
 				err = ex
@@ -170,6 +225,36 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 	return err
 }
 
+// executeCancelable executes the statements with a context that is canceled when an interrupt signal is received.
+// The second return value reports whether the execution has been canceled by the signal.
+//
+// A canceled statement does not change any views, so the changes are discarded only for that statement.
+// The changes made by the preceding statements remain uncommitted.
+func executeCancelable(ctx context.Context, proc *query.Processor, statements []parser.Statement, interrupt <-chan os.Signal) (query.StatementFlow, bool, error) {
+	select {
+	case <-interrupt:
+	default:
+	}
+
+	execCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-done:
+		}
+	}()
+
+	flow, err := proc.Execute(execCtx, statements)
+	canceled := err != nil && execCtx.Err() != nil && ctx.Err() == nil
+	return flow, canceled, err
+}
+
 func showStats(ctx context.Context, proc *query.Processor, start time.Time) {
 	if ctx.Err() != nil {
 		return
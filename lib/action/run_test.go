@@ -7,19 +7,21 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 
 	"github.com/mithrandie/csvq/lib/query"
 )
 
 var executeTests = []struct {
-	Name    string
-	Input   string
-	OutFile string
-	Output  string
-	Stats   bool
-	Content string
-	Error   string
+	Name         string
+	Input        string
+	OutFile      string
+	HtmlDocument bool
+	Output       string
+	Stats        bool
+	Content      string
+	Error        string
 }{
 	{
 		Name:    "Select Query Output To File",
@@ -32,6 +34,32 @@ var executeTests = []struct {
 			"| 1 |\n" +
 			"+---+\n",
 	},
+	{
+		Name:         "Select Query Output To HTML Document",
+		Input:        "select 1 as a; select 'b' as b;",
+		OutFile:      GetTestFilePath("select_query_output_file.html"),
+		HtmlDocument: true,
+		Content: "" +
+			"<body>\n" +
+			"<table class=\"csvq\">\n" +
+			"<thead>\n" +
+			"<tr><th>a</th></tr>\n" +
+			"</thead>\n" +
+			"<tbody>\n" +
+			"<tr><td class=\"number\" style=\"text-align: right\">1</td></tr>\n" +
+			"</tbody>\n" +
+			"</table>\n" +
+			"<table class=\"csvq\">\n" +
+			"<thead>\n" +
+			"<tr><th>b</th></tr>\n" +
+			"</thead>\n" +
+			"<tbody>\n" +
+			"<tr><td class=\"string\">b</td></tr>\n" +
+			"</tbody>\n" +
+			"</table>\n" +
+			"</body>\n" +
+			"</html>\n",
+	},
 	{
 		Name:   "Print",
 		Input:  "var @a := 1; print @a;",
@@ -61,6 +89,14 @@ func TestRun(t *testing.T) {
 
 		tx.Session.SetOutFile(nil)
 
+		if v.HtmlDocument {
+			tx.Flags.ExportOptions.Format = cmd.HTML
+			tx.Flags.SetHtmlDocument(true)
+		} else {
+			tx.Flags.ExportOptions.Format = cmd.TEXT
+			tx.Flags.SetHtmlDocument(false)
+		}
+
 		out := query.NewOutput()
 		tx.Session.SetStdout(out)
 
@@ -94,7 +130,12 @@ func TestRun(t *testing.T) {
 			if 0 < len(v.OutFile) {
 				fp, _ := os.Open(v.OutFile)
 				buf, _ := ioutil.ReadAll(fp)
-				if string(buf) != v.Content {
+				if v.HtmlDocument {
+					content := string(buf)
+					if !strings.HasPrefix(content, "<!DOCTYPE html>\n") || !strings.HasSuffix(content, v.Content) {
+						t.Errorf("%s: content = %q, want a document that ends with %q", v.Name, content, v.Content)
+					}
+				} else if string(buf) != v.Content {
 					t.Errorf("%s: content = %q, want %q", v.Name, string(buf), v.Content)
 				}
 			}
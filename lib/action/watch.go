@@ -0,0 +1,134 @@
+package action
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	csvqfile "github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	WatchPollingInterval = 200 * time.Millisecond
+	WatchDebounceTime    = 500 * time.Millisecond
+)
+
+const clearScreenSequence = "\033[H\033[2J"
+
+type watchedFileState struct {
+	Exists  bool
+	ModTime time.Time
+	Size    int64
+}
+
+// Watch executes the input, and re-executes it every time the files loaded in the execution or the source file are modified.
+func Watch(ctx context.Context, proc *query.Processor, input string, sourceFile string) error {
+	if 0 < len(sourceFile) {
+		if abs, err := filepath.Abs(sourceFile); err == nil {
+			sourceFile = abs
+		}
+	}
+
+	for {
+		proc.Tx.ClearLoadedFilePaths()
+
+		runProc := query.NewProcessor(proc.Tx)
+		if err := Run(ctx, runProc, input, sourceFile, ""); err != nil {
+			if _, ok := err.(*query.ForcedExit); ok || ctx.Err() != nil {
+				return err
+			}
+			proc.LogError(err.Error())
+
+			if err = runProc.AutoRollback(); err != nil {
+				return err
+			}
+		}
+
+		paths := proc.Tx.LoadedFilePaths()
+		if 0 < len(sourceFile) {
+			paths = append(paths, sourceFile)
+		}
+		if len(paths) < 1 {
+			proc.LogWarn("No file to watch.", false)
+			return nil
+		}
+
+		if err := waitForModification(ctx, paths); err != nil {
+			return err
+		}
+
+		if 0 < len(sourceFile) {
+			s, err := query.LoadContentsFromFile(ctx, proc.Tx, parser.Identifier{Literal: sourceFile})
+			if err != nil {
+				return err
+			}
+			input = s
+		}
+
+		if terminal.IsTerminal(int(proc.Tx.Session.ScreenFd())) {
+			if err := proc.Tx.Session.WriteToStdout(clearScreenSequence); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// waitForModification blocks until any of the files is modified.
+// It returns after the files have not been modified for WatchDebounceTime and none of them is locked by other processes.
+func waitForModification(ctx context.Context, paths []string) error {
+	states := watchedFileStates(paths)
+	var modifiedAt time.Time
+
+	ticker := time.NewTicker(WatchPollingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return query.ConvertContextError(ctx.Err())
+		case <-ticker.C:
+		}
+
+		current := watchedFileStates(paths)
+		for i := range current {
+			if current[i] != states[i] {
+				states = current
+				modifiedAt = time.Now()
+				break
+			}
+		}
+
+		if modifiedAt.IsZero() || time.Since(modifiedAt) < WatchDebounceTime || isLocked(paths) {
+			continue
+		}
+		return nil
+	}
+}
+
+func watchedFileStates(paths []string) []watchedFileState {
+	states := make([]watchedFileState, len(paths))
+	for i, p := range paths {
+		if info, err := os.Stat(p); err == nil {
+			states[i] = watchedFileState{
+				Exists:  true,
+				ModTime: info.ModTime(),
+				Size:    info.Size(),
+			}
+		}
+	}
+	return states
+}
+
+func isLocked(paths []string) bool {
+	for _, p := range paths {
+		if csvqfile.LockExists(p) {
+			return true
+		}
+	}
+	return false
+}
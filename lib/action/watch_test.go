@@ -0,0 +1,41 @@
+package action
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	csvqfile "github.com/mithrandie/csvq/lib/file"
+)
+
+func TestWaitForModification(t *testing.T) {
+	fpath := GetTestFilePath("watch.csv")
+	if err := ioutil.WriteFile(fpath, []byte("c1\n1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	lockPath := csvqfile.LockFilePath(fpath)
+
+	go func() {
+		time.Sleep(2 * WatchPollingInterval)
+		_ = ioutil.WriteFile(lockPath, nil, 0644)
+		_ = ioutil.WriteFile(fpath, []byte("c1\n1\n2\n"), 0644)
+		time.Sleep(2 * WatchDebounceTime)
+		_ = os.Remove(lockPath)
+	}()
+
+	start := time.Now()
+	if err := waitForModification(context.Background(), []string{fpath}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if elapsed := time.Since(start); elapsed < 2*WatchDebounceTime {
+		t.Errorf("returned in %s before the lock file was removed", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*WatchPollingInterval)
+	defer cancel()
+	if err := waitForModification(ctx, []string{fpath}); err == nil {
+		t.Errorf("no error, want context error")
+	}
+}
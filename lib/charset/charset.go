@@ -0,0 +1,229 @@
+// Package charset provides character encodings that are not supported by go-text.
+//
+// The encodings are represented as values of text.Encoding that are out of the range of go-text,
+// so that they can be handled in the same way as the encodings of go-text.
+// Readers and writers of go-text cannot handle the encodings directly,
+// so data must be converted from or to UTF-8 by the functions in this package.
+package charset
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/go-text"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/transform"
+)
+
+const (
+	EUCJP text.Encoding = 0x80 + iota
+	ISO2022JP
+	GB18030
+	BIG5
+	EUCKR
+	LATIN1
+	WINDOWS1250
+	WINDOWS1251
+	WINDOWS1252
+	WINDOWS1253
+	WINDOWS1254
+	WINDOWS1255
+	WINDOWS1256
+	WINDOWS1257
+	WINDOWS1258
+)
+
+var EncodingLiteral = map[text.Encoding]string{
+	EUCJP:       "EUCJP",
+	ISO2022JP:   "ISO2022JP",
+	GB18030:     "GB18030",
+	BIG5:        "BIG5",
+	EUCKR:       "EUCKR",
+	LATIN1:      "LATIN1",
+	WINDOWS1250: "WINDOWS1250",
+	WINDOWS1251: "WINDOWS1251",
+	WINDOWS1252: "WINDOWS1252",
+	WINDOWS1253: "WINDOWS1253",
+	WINDOWS1254: "WINDOWS1254",
+	WINDOWS1255: "WINDOWS1255",
+	WINDOWS1256: "WINDOWS1256",
+	WINDOWS1257: "WINDOWS1257",
+	WINDOWS1258: "WINDOWS1258",
+}
+
+var ianaName = map[text.Encoding]string{
+	EUCJP:       "EUC-JP",
+	ISO2022JP:   "ISO-2022-JP",
+	GB18030:     "GB18030",
+	BIG5:        "Big5",
+	EUCKR:       "EUC-KR",
+	LATIN1:      "ISO-8859-1",
+	WINDOWS1250: "windows-1250",
+	WINDOWS1251: "windows-1251",
+	WINDOWS1252: "windows-1252",
+	WINDOWS1253: "windows-1253",
+	WINDOWS1254: "windows-1254",
+	WINDOWS1255: "windows-1255",
+	WINDOWS1256: "windows-1256",
+	WINDOWS1257: "windows-1257",
+	WINDOWS1258: "windows-1258",
+}
+
+var encodings = map[text.Encoding]encoding.Encoding{
+	EUCJP:       japanese.EUCJP,
+	ISO2022JP:   japanese.ISO2022JP,
+	GB18030:     simplifiedchinese.GB18030,
+	BIG5:        traditionalchinese.Big5,
+	EUCKR:       korean.EUCKR,
+	LATIN1:      charmap.ISO8859_1,
+	WINDOWS1250: charmap.Windows1250,
+	WINDOWS1251: charmap.Windows1251,
+	WINDOWS1252: charmap.Windows1252,
+	WINDOWS1253: charmap.Windows1253,
+	WINDOWS1254: charmap.Windows1254,
+	WINDOWS1255: charmap.Windows1255,
+	WINDOWS1256: charmap.Windows1256,
+	WINDOWS1257: charmap.Windows1257,
+	WINDOWS1258: charmap.Windows1258,
+}
+
+// IsExtended returns true if the encoding is provided by this package.
+func IsExtended(enc text.Encoding) bool {
+	_, ok := encodings[enc]
+	return ok
+}
+
+// String returns the name of the encoding.
+func String(enc text.Encoding) string {
+	if s, ok := EncodingLiteral[enc]; ok {
+		return s
+	}
+	return enc.String()
+}
+
+// IANAName returns the name registered in IANA of the encoding provided by this package.
+// If the encoding is not provided by this package, then an empty string is returned.
+func IANAName(enc text.Encoding) string {
+	return ianaName[enc]
+}
+
+// ParseEncoding parses the name of an encoding of go-text or of this package.
+func ParseEncoding(s string) (text.Encoding, error) {
+	if enc, err := text.ParseEncoding(s); err == nil {
+		return enc, nil
+	}
+
+	u := strings.ToUpper(s)
+	for enc, literal := range EncodingLiteral {
+		if u == literal {
+			return enc, nil
+		}
+	}
+	return text.AUTO, errors.New(fmt.Sprintf("%q cannot convert to Encoding", s))
+}
+
+// GetTransformDecoder returns a reader to transform character encoding from any encoding to UTF-8.
+func GetTransformDecoder(r io.Reader, enc text.Encoding) (io.Reader, error) {
+	if e, ok := encodings[enc]; ok {
+		return transform.NewReader(r, e.NewDecoder()), nil
+	}
+	return text.GetTransformDecoder(r, enc)
+}
+
+// GetTransformWriter returns a writer to transform character encoding from UTF-8 to another encoding.
+//
+// The writer converts data for each call of Write, so it is not necessary to close the writer.
+func GetTransformWriter(w io.Writer, enc text.Encoding) (io.Writer, error) {
+	if e, ok := encodings[enc]; ok {
+		return &Writer{w: w, enc: e}, nil
+	}
+	return text.GetTransformWriter(w, enc)
+}
+
+// ConvertReader returns a reader to read the data in UTF-8 and the encoding to be passed to readers of go-text.
+// If the encoding is not provided by this package, then the reader and the encoding are returned as they are.
+func ConvertReader(r io.Reader, enc text.Encoding) (io.Reader, text.Encoding) {
+	if e, ok := encodings[enc]; ok {
+		return transform.NewReader(r, e.NewDecoder()), text.UTF8
+	}
+	return r, enc
+}
+
+// ConvertWriter returns a writer to write the data written in UTF-8 and the encoding to be passed to writers of go-text.
+// If the encoding is not provided by this package, then the writer and the encoding are returned as they are.
+func ConvertWriter(w io.Writer, enc text.Encoding) (io.Writer, text.Encoding) {
+	if e, ok := encodings[enc]; ok {
+		return &Writer{w: w, enc: e}, text.UTF8
+	}
+	return w, enc
+}
+
+// Decode converts a string from any encoding to UTF-8.
+func Decode(src []byte, enc text.Encoding) ([]byte, error) {
+	r, err := GetTransformDecoder(bytes.NewReader(src), enc)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+// Writer converts UTF-8 data to another encoding.
+//
+// Every call of Write is converted independently, except for an incomplete character at the end of the data,
+// which is kept until the next call.
+type Writer struct {
+	w       io.Writer
+	enc     encoding.Encoding
+	pending []byte
+}
+
+func (e *Writer) Write(p []byte) (int, error) {
+	src := p
+	if 0 < len(e.pending) {
+		src = append(e.pending, p...)
+		e.pending = nil
+	}
+
+	n := len(src) - incompleteTailLength(src)
+	if n < len(src) {
+		e.pending = append(make([]byte, 0, len(src)-n), src[n:]...)
+	}
+	if n < 1 {
+		return len(p), nil
+	}
+
+	b, err := e.enc.NewEncoder().Bytes(src[:n])
+	if err != nil {
+		return 0, err
+	}
+	if _, err = e.w.Write(b); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func incompleteTailLength(b []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		c := b[len(b)-i]
+		if c < utf8.RuneSelf {
+			return 0
+		}
+		if utf8.RuneStart(c) {
+			if utf8.FullRune(b[len(b)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
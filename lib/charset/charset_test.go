@@ -0,0 +1,198 @@
+package charset
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+func encode(s string, enc text.Encoding) []byte {
+	b, _ := encodings[enc].NewEncoder().String(s)
+	return []byte(b)
+}
+
+var parseEncodingTests = []struct {
+	Input  string
+	Result text.Encoding
+	Error  string
+}{
+	{
+		Input:  "sjis",
+		Result: text.SJIS,
+	},
+	{
+		Input:  "eucjp",
+		Result: EUCJP,
+	},
+	{
+		Input:  "Windows1252",
+		Result: WINDOWS1252,
+	},
+	{
+		Input: "ascii",
+		Error: "\"ascii\" cannot convert to Encoding",
+	},
+}
+
+func TestParseEncoding(t *testing.T) {
+	for _, v := range parseEncodingTests {
+		result, err := ParseEncoding(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("result = %s, want %s for %q", String(result), String(v.Result), v.Input)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if s := String(GB18030); s != "GB18030" {
+		t.Errorf("result = %q, want %q", s, "GB18030")
+	}
+	if s := String(text.UTF16LE); s != "UTF16LE" {
+		t.Errorf("result = %q, want %q", s, "UTF16LE")
+	}
+}
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, enc := ConvertWriter(buf, EUCJP)
+	if enc != text.UTF8 {
+		t.Errorf("encoding = %s, want %s", String(enc), String(text.UTF8))
+	}
+
+	src := []byte("日本語,abc\n")
+	_, _ = w.Write(src[:4])
+	_, _ = w.Write(src[4:8])
+	_, _ = w.Write(src[8:])
+
+	expect := encode("日本語,abc\n", EUCJP)
+	if !bytes.Equal(buf.Bytes(), expect) {
+		t.Errorf("result = %x, want %x", buf.Bytes(), expect)
+	}
+}
+
+func TestWriter_UnsupportedCharacter(t *testing.T) {
+	w, _ := GetTransformWriter(&bytes.Buffer{}, LATIN1)
+	if _, err := w.Write([]byte("日本語")); err == nil {
+		t.Error("no error, want error")
+	}
+}
+
+func TestConvertReader(t *testing.T) {
+	r, enc := ConvertReader(bytes.NewReader(encode("中文,数据", GB18030)), GB18030)
+	if enc != text.UTF8 {
+		t.Errorf("encoding = %s, want %s", String(enc), String(text.UTF8))
+	}
+	b, _ := ioutil.ReadAll(r)
+	if string(b) != "中文,数据" {
+		t.Errorf("result = %q, want %q", string(b), "中文,数据")
+	}
+
+	src := strings.NewReader("abc")
+	r, enc = ConvertReader(src, text.SJIS)
+	if r != src || enc != text.SJIS {
+		t.Error("reader is converted, want not to be converted")
+	}
+}
+
+var detectInSpecifiedEncodingTests = []struct {
+	Name     string
+	Input    []byte
+	Encoding text.Encoding
+	Result   text.Encoding
+	Error    string
+}{
+	{
+		Name:     "Specified Encoding",
+		Input:    []byte("abc"),
+		Encoding: WINDOWS1250,
+		Result:   WINDOWS1250,
+	},
+	{
+		Name:     "UTF8",
+		Input:    []byte("name,city\n山田,東京\n"),
+		Encoding: text.AUTO,
+		Result:   text.UTF8,
+	},
+	{
+		Name:     "SJIS",
+		Input:    []byte("name,city\n\x8eR\x93c,\x93\x8c\x8b\x9e\n\x82\xa9\x82\xc8,\x82\xe0\x82\xcc\n"),
+		Encoding: text.AUTO,
+		Result:   text.SJIS,
+	},
+	{
+		Name:     "ISO-2022-JP",
+		Input:    encode("name,city\n山田,東京\n", ISO2022JP),
+		Encoding: text.AUTO,
+		Result:   ISO2022JP,
+	},
+	{
+		Name:     "EUC-JP",
+		Input:    encode("name,city\n山田,とうきょう\n", EUCJP),
+		Encoding: text.AUTO,
+		Result:   EUCJP,
+	},
+	{
+		Name:     "GB18030",
+		Input:    encode("name,city\n张伟,北京\n李娜,上海\n", GB18030),
+		Encoding: text.AUTO,
+		Result:   GB18030,
+	},
+	{
+		Name:     "Big5",
+		Input:    encode("name,city\n陳大文,臺北\n", BIG5),
+		Encoding: text.AUTO,
+		Result:   BIG5,
+	},
+	{
+		Name:     "EUC-KR",
+		Input:    encode("name,city\n김민준,서울\n", EUCKR),
+		Encoding: text.AUTO,
+		Result:   EUCKR,
+	},
+	{
+		Name:     "Windows-1252",
+		Input:    encode("name,city\nFrançois,Zürich\nJosé,Málaga\n", WINDOWS1252),
+		Encoding: text.AUTO,
+		Result:   WINDOWS1252,
+	},
+}
+
+func TestDetectInSpecifiedEncoding(t *testing.T) {
+	for _, v := range detectInSpecifiedEncodingTests {
+		r := bytes.NewReader(v.Input)
+		result, err := DetectInSpecifiedEncoding(r, v.Encoding)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, String(result), String(v.Result))
+		}
+		if r.Len() != len(v.Input) {
+			t.Errorf("%s: reader is not rewound", v.Name)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package charset
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mithrandie/go-text"
+)
+
+const sampleSize = 4096
+
+// inferenceCandidates are the encodings that are tried in the order of priority
+// when the encoding cannot be determined by go-text.
+var inferenceCandidates = []text.Encoding{
+	GB18030,
+	EUCJP,
+	BIG5,
+	EUCKR,
+	WINDOWS1252,
+}
+
+// frequentCharacters are some of the most frequently used characters in each language.
+// They distinguish the encodings that share the same byte sequences, such as GB2312 and KS X 1001.
+var frequentCharacters = map[text.Encoding]string{
+	text.SJIS: "日本人大年中一出会分学生時国上行者事自前地方社東京都府県市区町村田山川藤佐木井野小林高橋松名所",
+	EUCJP:     "日本人大年中一出会分学生時国上行者事自前地方社東京都府県市区町村田山川藤佐木井野小林高橋松名所",
+	GB18030:   "的一是不了在人有我他这个们中来上大为和国地到以说时要就出会可也你对生能而子那得于着下自之年过发后作里用道行所然家种事成方多经么去法学如都同现当没动面起看定天分还进好小部其些主样理心她本前开但因只从想实名称价号产品",
+	BIG5:      "的一是不了在人有我他這個們中來上大為和國地到以說時要就出會可也你對生能而子那得於著下自之年過發後作裡用道行所然家種事成方多經麼去法學如都同現當沒動面起看定天分還進好小部其些主樣理心她本前開但因只從想實名稱價號產品",
+	EUCKR:     "이다는의에고하가지을를서한로기사대자시도있것수그정나인아부어리보전들게해우일주제만되상과조여내동번름소",
+}
+
+var iso2022JPEscapeSequences = [][]byte{
+	[]byte("\x1b$B"),
+	[]byte("\x1b$@"),
+	[]byte("\x1b(J"),
+}
+
+// DetectInSpecifiedEncoding detects the character encoding in the same way as text.DetectInSpecifiedEncoding.
+//
+// If the specified encoding is AUTO, then the encodings of this package are also taken into account.
+// ISO-2022-JP is detected by its escape sequences, and the other encodings are inferred
+// only when the data is not in UTF-8 or UTF-16.
+func DetectInSpecifiedEncoding(r io.ReadSeeker, enc text.Encoding) (text.Encoding, error) {
+	if IsExtended(enc) {
+		return enc, nil
+	}
+
+	detected, err := text.DetectInSpecifiedEncoding(r, enc)
+	if enc != text.AUTO {
+		return detected, err
+	}
+	if err != nil && err != text.ErrUnknownEncoding {
+		return detected, err
+	}
+	switch detected {
+	case text.UTF8, text.SJIS, text.UTF16BE, text.UTF16LE:
+	default:
+		return detected, err
+	}
+
+	sample, eof, e := readSample(r)
+	if e != nil {
+		return detected, e
+	}
+
+	candidates := inferenceCandidates
+	if err == nil {
+		switch detected {
+		case text.UTF8:
+			if isISO2022JP(sample) {
+				return ISO2022JP, nil
+			}
+			if isValidUTF8(sample, eof) {
+				return detected, nil
+			}
+		case text.SJIS:
+			candidates = append([]text.Encoding{detected}, candidates...)
+		default:
+			// UTF-16 is inferred when the sample is neither UTF-8 nor Shift_JIS, but texts
+			// in UTF-16 rarely consist only of characters that do not contain a null byte.
+			if bytes.IndexByte(sample, 0) != -1 {
+				return detected, nil
+			}
+		}
+	}
+	if inferred, ok := InferEncoding(sample, eof, candidates); ok {
+		return inferred, nil
+	}
+	return detected, err
+}
+
+// InferEncoding returns the most plausible encoding of the sample in the candidates.
+//
+// The encodings in which the sample cannot be decoded are excluded, and the rest are scored
+// by the characters decoded from the sample. If more than one candidate has the best score,
+// then the one that appears first in the candidates is selected.
+func InferEncoding(sample []byte, eof bool, candidates []text.Encoding) (text.Encoding, bool) {
+	var inferred text.Encoding
+	bestScore := 0
+	found := false
+
+	for _, enc := range candidates {
+		s, ok := decodeSample(sample, eof, enc)
+		if !ok {
+			continue
+		}
+
+		score := plausibility(s, enc)
+		if !found || bestScore < score {
+			inferred = enc
+			bestScore = score
+			found = true
+		}
+	}
+
+	if !found || bestScore < 0 {
+		return text.AUTO, false
+	}
+	return inferred, true
+}
+
+func readSample(r io.ReadSeeker) ([]byte, bool, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, false, err
+	}
+
+	buf := make([]byte, sampleSize)
+	n, err := io.ReadFull(r, buf)
+	eof := err == io.EOF || err == io.ErrUnexpectedEOF
+	if err != nil && !eof {
+		return nil, false, err
+	}
+
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, false, err
+	}
+	return buf[:n], eof, nil
+}
+
+func isValidUTF8(sample []byte, eof bool) bool {
+	if !eof {
+		sample = sample[:len(sample)-incompleteTailLength(sample)]
+	}
+	return utf8.Valid(sample)
+}
+
+func isISO2022JP(sample []byte) bool {
+	for _, b := range sample {
+		if utf8.RuneSelf <= b {
+			return false
+		}
+	}
+	for _, seq := range iso2022JPEscapeSequences {
+		if bytes.Contains(sample, seq) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeSample decodes the sample and reports whether the sample is valid in the encoding.
+// If the sample does not end at the end of the data, then an incomplete character at the end is ignored.
+func decodeSample(sample []byte, eof bool, enc text.Encoding) ([]rune, bool) {
+	maxCut := 0
+	if !eof {
+		maxCut = utf8.UTFMax - 1
+	}
+
+	for cut := 0; cut <= maxCut && cut <= len(sample); cut++ {
+		b, err := Decode(sample[:len(sample)-cut], enc)
+		if err != nil || bytes.ContainsRune(b, utf8.RuneError) {
+			continue
+		}
+		return bytes.Runes(b), true
+	}
+	return nil, false
+}
+
+// plausibility scores the decoded characters.
+//
+// Characters that are frequently used in the language of the encoding raise the score,
+// and characters that rarely appear in texts, which are often produced by decoding in a wrong encoding, lower the score.
+func plausibility(s []rune, enc text.Encoding) int {
+	score := 0
+	for i, r := range s {
+		switch {
+		case r < utf8.RuneSelf:
+			if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+				score -= 2
+			}
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana) && !isHalfwidthKatakana(r):
+			if enc == EUCJP || enc == text.SJIS {
+				score += 2
+			}
+		case isHangulSyllable(r):
+			if enc == EUCKR && isFrequentlyUsed(r, enc) {
+				score++
+				if strings.ContainsRune(frequentCharacters[enc], r) {
+					score += 2
+				}
+			} else {
+				score--
+			}
+		case isCJKUnifiedIdeograph(r):
+			if isFrequentlyUsed(r, enc) {
+				score++
+				if strings.ContainsRune(frequentCharacters[enc], r) {
+					score += 2
+				}
+			}
+		case isHalfwidthKatakana(r):
+			score--
+		case isCJKPunctuation(r):
+			if enc != WINDOWS1252 {
+				score++
+			}
+		case unicode.IsLetter(r) && r < 0x0250:
+			if isNextToASCIILetter(s, i) {
+				score++
+			} else {
+				score--
+			}
+		default:
+			score -= 2
+		}
+	}
+	return score
+}
+
+// isFrequentlyUsed reports whether the character is in the range of frequently used characters in the encoding.
+// Characters out of the range are often produced by decoding in a wrong encoding.
+func isFrequentlyUsed(r rune, enc text.Encoding) bool {
+	e, ok := encodings[enc]
+	if !ok {
+		return true
+	}
+	b, err := e.NewEncoder().Bytes([]byte(string(r)))
+	if err != nil || len(b) != 2 {
+		return false
+	}
+
+	switch enc {
+	case GB18030, EUCKR:
+		return 0xa1 <= b[0] && 0xa1 <= b[1]
+	case BIG5:
+		return 0xa4 <= b[0] && b[0] <= 0xc6
+	}
+	return true
+}
+
+func isHangulSyllable(r rune) bool {
+	return 0xac00 <= r && r <= 0xd7a3
+}
+
+func isCJKUnifiedIdeograph(r rune) bool {
+	return 0x4e00 <= r && r <= 0x9fff
+}
+
+func isHalfwidthKatakana(r rune) bool {
+	return 0xff61 <= r && r <= 0xff9f
+}
+
+func isCJKPunctuation(r rune) bool {
+	return (0x3000 <= r && r <= 0x303f) || (0xff01 <= r && r <= 0xff5e)
+}
+
+func isNextToASCIILetter(s []rune, i int) bool {
+	isASCIILetter := func(r rune) bool {
+		return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+	}
+	return (0 < i && isASCIILetter(s[i-1])) || (i+1 < len(s) && isASCIILetter(s[i+1]))
+}
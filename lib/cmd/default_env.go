@@ -3,6 +3,7 @@ package cmd
 const DefaultEnvJson = `
 {
   "datetime_format": [],
+  "locale": "",
   "interactive_shell": {
     "history_file": ".csvq_history",
     "history_limit": 500,
@@ -10,9 +11,13 @@ const DefaultEnvJson = `
     "continuous_prompt": " > ",
     "completion": true,
     "kill_whole_line": false,
-    "vi_mode": false
+    "vi_mode": false,
+    "syntax_highlight": true,
+    "use_pager": true,
+    "pager": ""
   },
   "environment_variables": {},
+  "plugins": {},
   "palette": {
     "effectors": {
       "label": {
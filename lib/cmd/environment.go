@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/go-homedir"
@@ -28,8 +29,10 @@ const (
 
 type Environment struct {
 	DatetimeFormat       []string            `json:"datetime_format"`
+	Locale               string              `json:"locale"`
 	InteractiveShell     InteractiveShell    `json:"interactive_shell"`
 	EnvironmentVariables map[string]string   `json:"environment_variables"`
+	Plugins              map[string]Plugin   `json:"plugins"`
 	Palette              color.PaletteConfig `json:"palette"`
 }
 
@@ -49,6 +52,10 @@ func (e *Environment) Merge(e2 *Environment) {
 		e.DatetimeFormat = AppendStrIfNotExist(e.DatetimeFormat, f)
 	}
 
+	if 0 < len(e2.Locale) {
+		e.Locale = e2.Locale
+	}
+
 	if 0 < len(e2.InteractiveShell.HistoryFile) {
 		e.InteractiveShell.HistoryFile = e2.InteractiveShell.HistoryFile
 	}
@@ -77,10 +84,26 @@ func (e *Environment) Merge(e2 *Environment) {
 		e.InteractiveShell.ViMode = e2.InteractiveShell.ViMode
 	}
 
+	if e2.InteractiveShell.SyntaxHighlight != nil {
+		e.InteractiveShell.SyntaxHighlight = e2.InteractiveShell.SyntaxHighlight
+	}
+
+	if e2.InteractiveShell.UsePager != nil {
+		e.InteractiveShell.UsePager = e2.InteractiveShell.UsePager
+	}
+
+	if 0 < len(e2.InteractiveShell.Pager) {
+		e.InteractiveShell.Pager = e2.InteractiveShell.Pager
+	}
+
 	for k, v := range e2.EnvironmentVariables {
 		e.EnvironmentVariables[k] = v
 	}
 
+	for k, v := range e2.Plugins {
+		e.Plugins[strings.ToUpper(k)] = v
+	}
+
 	for k, v := range e2.Palette.Effectors {
 		e.Palette.Effectors[k] = v
 	}
@@ -94,6 +117,15 @@ type InteractiveShell struct {
 	Completion       *bool  `json:"completion"`
 	KillWholeLine    *bool  `json:"kill_whole_line"`
 	ViMode           *bool  `json:"vi_mode"`
+	SyntaxHighlight  *bool  `json:"syntax_highlight"`
+	UsePager         *bool  `json:"use_pager"`
+	Pager            string `json:"pager"`
+}
+
+type Plugin struct {
+	Command   string   `json:"command"`
+	Aggregate bool     `json:"aggregate"`
+	Timeout   *float64 `json:"timeout"`
 }
 
 func (e *Environment) Load(ctx context.Context, defaultWaitTimeout time.Duration, retryDelay time.Duration) (err error) {
@@ -133,6 +165,10 @@ func (e *Environment) Load(ctx context.Context, defaultWaitTimeout time.Duration
 			err = errors.New(fmt.Sprintf("failed to load %q: %s", fpath, err.Error()))
 			return
 		}
+		if _, err = ParseLocale(userDefinedEnv.Locale); err != nil {
+			err = errors.New(fmt.Sprintf("failed to load %q: %s", fpath, err.Error()))
+			return
+		}
 
 		e.Merge(userDefinedEnv)
 	}
@@ -8,7 +8,10 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
-	"time"
+
+	"github.com/mithrandie/csvq/lib/sql"
+	"github.com/mithrandie/csvq/lib/texttable"
+	"github.com/mithrandie/csvq/lib/xml"
 
 	"github.com/mithrandie/go-text"
 	txjson "github.com/mithrandie/go-text/json"
@@ -22,6 +25,12 @@ const (
 )
 const DelimitAutomatically = "SPACES"
 
+// DetectDelimiterAutomatically is the value of the delimiter flag to detect the dialect of CSV files automatically.
+const DetectDelimiterAutomatically = "AUTO"
+
+// AutoDelimiter is the delimiter of import options that indicates the dialect of CSV files is detected automatically.
+const AutoDelimiter rune = -1
+
 const (
 	RepositoryFlag               = "REPOSITORY"
 	TimezoneFlag                 = "TIMEZONE"
@@ -35,16 +44,31 @@ const (
 	EncodingFlag                 = "ENCODING"
 	NoHeaderFlag                 = "NO_HEADER"
 	WithoutNullFlag              = "WITHOUT_NULL"
+	LenientFlag                  = "LENIENT"
+	RejectFileFlag               = "REJECT_FILE"
+	SkipLinesFlag                = "SKIP_LINES"
+	CommentFlag                  = "COMMENT"
+	QuoteFlag                    = "QUOTE"
+	QuoteEscapeFlag              = "QUOTE_ESCAPE"
+	LocaleFlag                   = "LOCALE"
 	StripEndingLineBreakFlag     = "STRIP_ENDING_LINE_BREAK"
 	FormatFlag                   = "FORMAT"
 	ExportEncodingFlag           = "WRITE_ENCODING"
 	ExportDelimiterFlag          = "WRITE_DELIMITER"
 	ExportDelimiterPositionsFlag = "WRITE_DELIMITER_POSITIONS"
+	ExportQuoteFlag              = "WRITE_QUOTE"
+	ExportQuoteEscapeFlag        = "WRITE_QUOTE_ESCAPE"
 	WithoutHeaderFlag            = "WITHOUT_HEADER"
 	LineBreakFlag                = "LINE_BREAK"
 	EncloseAllFlag               = "ENCLOSE_ALL"
 	JsonEscapeFlag               = "JSON_ESCAPE"
 	PrettyPrintFlag              = "PRETTY_PRINT"
+	XmlRootElementFlag           = "XML_ROOT_ELEMENT"
+	XmlRowElementFlag            = "XML_ROW_ELEMENT"
+	SqlTableFlag                 = "SQL_TABLE"
+	SqlDialectFlag               = "SQL_DIALECT"
+	BorderStyleFlag              = "BORDER_STYLE"
+	ExpandedFlag                 = "EXPANDED"
 	EastAsianEncodingFlag        = "EAST_ASIAN_ENCODING"
 	CountDiacriticalSignFlag     = "COUNT_DIACRITICAL_SIGN"
 	CountFormatCodeFlag          = "COUNT_FORMAT_CODE"
@@ -68,16 +92,31 @@ var FlagList = []string{
 	EncodingFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
+	LenientFlag,
+	RejectFileFlag,
+	SkipLinesFlag,
+	CommentFlag,
+	QuoteFlag,
+	QuoteEscapeFlag,
+	LocaleFlag,
 	StripEndingLineBreakFlag,
 	FormatFlag,
 	ExportEncodingFlag,
 	ExportDelimiterFlag,
 	ExportDelimiterPositionsFlag,
+	ExportQuoteFlag,
+	ExportQuoteEscapeFlag,
 	WithoutHeaderFlag,
 	LineBreakFlag,
 	EncloseAllFlag,
 	JsonEscapeFlag,
 	PrettyPrintFlag,
+	XmlRootElementFlag,
+	XmlRowElementFlag,
+	SqlTableFlag,
+	SqlDialectFlag,
+	BorderStyleFlag,
+	ExpandedFlag,
 	EastAsianEncodingFlag,
 	CountDiacriticalSignFlag,
 	CountFormatCodeFlag,
@@ -100,6 +139,10 @@ const (
 	GFM
 	ORG
 	TEXT
+	XML
+	YAML
+	HTML
+	SQL
 )
 
 var FormatLiteral = map[Format]string{
@@ -111,6 +154,10 @@ var FormatLiteral = map[Format]string{
 	GFM:   "GFM",
 	ORG:   "ORG",
 	TEXT:  "TEXT",
+	XML:   "XML",
+	YAML:  "YAML",
+	HTML:  "HTML",
+	SQL:   "SQL",
 }
 
 func (f Format) String() string {
@@ -123,6 +170,8 @@ var ImportFormats = []Format{
 	FIXED,
 	JSON,
 	LTSV,
+	XML,
+	YAML,
 }
 
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
@@ -135,6 +184,62 @@ func JsonEscapeTypeToString(escapeType txjson.EscapeType) string {
 	return JsonEscapeTypeLiteral[escapeType]
 }
 
+var SqlDialectLiteral = map[sql.Dialect]string{
+	sql.PostgreSQL: "POSTGRES",
+	sql.MySQL:      "MYSQL",
+	sql.SQLite:     "SQLITE",
+}
+
+func SqlDialectToString(dialect sql.Dialect) string {
+	return SqlDialectLiteral[dialect]
+}
+
+var BorderStyleLiteral = map[texttable.BorderStyle]string{
+	texttable.ASCII:      "ASCII",
+	texttable.SingleLine: "SINGLE",
+	texttable.DoubleLine: "DOUBLE",
+	texttable.Minimal:    "MINIMAL",
+}
+
+func BorderStyleToString(style texttable.BorderStyle) string {
+	return BorderStyleLiteral[style]
+}
+
+type ExpandedDisplay int
+
+const (
+	ExpandedOff ExpandedDisplay = iota
+	ExpandedOn
+	ExpandedAuto
+)
+
+var ExpandedDisplayLiteral = map[ExpandedDisplay]string{
+	ExpandedOff:  "OFF",
+	ExpandedOn:   "ON",
+	ExpandedAuto: "AUTO",
+}
+
+func (e ExpandedDisplay) String() string {
+	return ExpandedDisplayLiteral[e]
+}
+
+// QuoteEscape is the way to escape quotation marks in enclosed fields of CSV.
+type QuoteEscape int
+
+const (
+	QuoteEscapeDouble QuoteEscape = iota
+	QuoteEscapeBackslash
+)
+
+var QuoteEscapeLiteral = map[QuoteEscape]string{
+	QuoteEscapeDouble:    "DOUBLE",
+	QuoteEscapeBackslash: "BACKSLASH",
+}
+
+func (e QuoteEscape) String() string {
+	return QuoteEscapeLiteral[e]
+}
+
 const (
 	CsvExt      = ".csv"
 	TsvExt      = ".tsv"
@@ -145,6 +250,11 @@ const (
 	SqlExt      = ".sql"
 	CsvqProcExt = ".cql"
 	TextExt     = ".txt"
+	XmlExt      = ".xml"
+	YamlExt     = ".yaml"
+	YmlExt      = ".yml"
+	HtmlExt     = ".html"
+	HtmExt      = ".htm"
 )
 
 type ImportOptions struct {
@@ -156,6 +266,16 @@ type ImportOptions struct {
 	Encoding           text.Encoding
 	NoHeader           bool
 	WithoutNull        bool
+	Lenient            bool
+	RejectFile         string
+	SkipLines          int
+	Comment            string
+	Quote              rune
+	QuoteEscape        QuoteEscape
+
+	// Locale is the name of the locale in which numbers and dates are written in files.
+	// The empty string means that the strings in files are converted in the standard way.
+	Locale string
 }
 
 func (ops ImportOptions) Copy() ImportOptions {
@@ -180,6 +300,13 @@ func NewImportOptions() ImportOptions {
 		Encoding:           text.AUTO,
 		NoHeader:           false,
 		WithoutNull:        false,
+		Lenient:            false,
+		RejectFile:         "",
+		SkipLines:          0,
+		Comment:            "",
+		Quote:              '"',
+		QuoteEscape:        QuoteEscapeDouble,
+		Locale:             "",
 	}
 }
 
@@ -190,11 +317,28 @@ type ExportOptions struct {
 	Delimiter            rune
 	DelimiterPositions   []int
 	SingleLine           bool
+	Quote                rune
+	QuoteEscape          QuoteEscape
 	WithoutHeader        bool
 	LineBreak            text.LineBreak
 	EncloseAll           bool
 	JsonEscape           txjson.EscapeType
 	PrettyPrint          bool
+	XmlRootElement       string
+	XmlRowElement        string
+	HtmlDocument         bool
+	SqlTable             string
+	SqlDialect           sql.Dialect
+	BorderStyle          texttable.BorderStyle
+	Expanded             ExpandedDisplay
+
+	// Locale is the name of the locale in which numbers and dates are written.
+	// It is set only to write files loaded with a locale, and query results are not affected.
+	Locale string
+
+	// Width of the screen to which query results are written.
+	// It is used to determine whether records are displayed in expanded mode when Expanded is ExpandedAuto.
+	ScreenWidth int
 
 	// For Calculation of String Width
 	EastAsianEncoding    bool
@@ -224,11 +368,21 @@ func NewExportOptions() ExportOptions {
 		Delimiter:            ',',
 		DelimiterPositions:   nil,
 		SingleLine:           false,
+		Quote:                '"',
+		QuoteEscape:          QuoteEscapeDouble,
 		WithoutHeader:        false,
 		LineBreak:            text.LF,
 		EncloseAll:           false,
 		JsonEscape:           txjson.Backslash,
 		PrettyPrint:          false,
+		XmlRootElement:       "rows",
+		XmlRowElement:        "row",
+		HtmlDocument:         false,
+		SqlTable:             "",
+		SqlDialect:           sql.PostgreSQL,
+		BorderStyle:          texttable.ASCII,
+		Expanded:             ExpandedOff,
+		ScreenWidth:          0,
 		EastAsianEncoding:    false,
 		CountDiacriticalSign: false,
 		CountFormatCode:      false,
@@ -277,13 +431,20 @@ func NewFlags(env *Environment) *Flags {
 		datetimeFormat = make([]string, 0, 4)
 	}
 
+	importOptions := NewImportOptions()
+	if env != nil {
+		if locale, err := ParseLocale(env.Locale); err == nil && locale != nil {
+			importOptions.Locale = locale.Name
+		}
+	}
+
 	return &Flags{
 		Repository:     "",
 		Location:       "Local",
 		DatetimeFormat: datetimeFormat,
 		AnsiQuotes:     false,
 		WaitTimeout:    10,
-		ImportOptions:  NewImportOptions(),
+		ImportOptions:  importOptions,
 		ExportOptions:  NewExportOptions(),
 		Quiet:          false,
 		LimitRecursion: 1000,
@@ -322,9 +483,9 @@ func (f *Flags) SetLocation(s string) error {
 		s = "UTC"
 	}
 
-	loc, err := time.LoadLocation(s)
+	loc, err := LoadLocation(s)
 	if err != nil {
-		return errors.New(fmt.Sprintf("timezone %q does not exist", s))
+		return err
 	}
 
 	f.Location = s
@@ -363,16 +524,16 @@ func (f *Flags) SetWaitTimeout(t float64) {
 func (f *Flags) SetImportFormat(s string) error {
 	fm, _, err := ParseFormat(s, f.ExportOptions.JsonEscape)
 	if err != nil {
-		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV|XML|YAML")
 	}
 
 	switch fm {
-	case CSV, TSV, FIXED, JSON, LTSV:
+	case CSV, TSV, FIXED, JSON, LTSV, XML, YAML:
 		f.ImportOptions.Format = fm
 		return nil
 	}
 
-	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV|XML|YAML")
 }
 
 func (f *Flags) SetDelimiter(s string) error {
@@ -380,7 +541,7 @@ func (f *Flags) SetDelimiter(s string) error {
 		return nil
 	}
 
-	delimiter, err := ParseDelimiter(s)
+	delimiter, err := ParseImportDelimiter(s)
 	if err != nil {
 		return err
 	}
@@ -429,6 +590,59 @@ func (f *Flags) SetWithoutNull(b bool) {
 	f.ImportOptions.WithoutNull = b
 }
 
+func (f *Flags) SetLenient(b bool) {
+	f.ImportOptions.Lenient = b
+}
+
+func (f *Flags) SetRejectFile(s string) {
+	f.ImportOptions.RejectFile = TrimSpace(s)
+}
+
+func (f *Flags) SetSkipLines(i int64) {
+	if i < 0 {
+		i = 0
+	}
+	f.ImportOptions.SkipLines = int(i)
+}
+
+func (f *Flags) SetComment(s string) {
+	f.ImportOptions.Comment = s
+}
+
+func (f *Flags) SetQuote(s string) error {
+	quote, err := ParseQuote(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.Quote = quote
+	return nil
+}
+
+func (f *Flags) SetQuoteEscape(s string) error {
+	escape, err := ParseQuoteEscape(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.QuoteEscape = escape
+	return nil
+}
+
+func (f *Flags) SetLocale(s string) error {
+	locale, err := ParseLocale(s)
+	if err != nil {
+		return err
+	}
+
+	if locale == nil {
+		f.ImportOptions.Locale = ""
+	} else {
+		f.ImportOptions.Locale = locale.Name
+	}
+	return nil
+}
+
 func (f *Flags) SetFormat(s string, outfile string) error {
 	var fm Format
 	var escape txjson.EscapeType
@@ -449,6 +663,14 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 			fm = GFM
 		case OrgExt:
 			fm = ORG
+		case XmlExt:
+			fm = XML
+		case YamlExt, YmlExt:
+			fm = YAML
+		case HtmlExt, HtmExt:
+			fm = HTML
+		case SqlExt:
+			fm = SQL
 		default:
 			return nil
 		}
@@ -470,7 +692,7 @@ func (f *Flags) SetWriteEncoding(s string) error {
 
 	encoding, err := ParseEncoding(s)
 	if err != nil || encoding == text.AUTO {
-		return errors.New("write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+		return errors.New("write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258")
 	}
 
 	f.ExportOptions.Encoding = encoding
@@ -505,6 +727,26 @@ func (f *Flags) SetWriteDelimiterPositions(s string) error {
 	return nil
 }
 
+func (f *Flags) SetWriteQuote(s string) error {
+	quote, err := ParseQuote(s)
+	if err != nil {
+		return errors.New("write-quote must be one character")
+	}
+
+	f.ExportOptions.Quote = quote
+	return nil
+}
+
+func (f *Flags) SetWriteQuoteEscape(s string) error {
+	escape, err := ParseQuoteEscape(s)
+	if err != nil {
+		return errors.New("write-quote-escape must be one of DOUBLE|BACKSLASH")
+	}
+
+	f.ExportOptions.QuoteEscape = escape
+	return nil
+}
+
 func (f *Flags) SetWithoutHeader(b bool) {
 	f.ExportOptions.WithoutHeader = b
 }
@@ -539,6 +781,62 @@ func (f *Flags) SetPrettyPrint(b bool) {
 	f.ExportOptions.PrettyPrint = b
 }
 
+func (f *Flags) SetXmlRootElement(s string) error {
+	if !xml.IsName(s) {
+		return errors.New(fmt.Sprintf("xml-root-element must be a valid xml name: %q", s))
+	}
+
+	f.ExportOptions.XmlRootElement = s
+	return nil
+}
+
+func (f *Flags) SetXmlRowElement(s string) error {
+	if !xml.IsName(s) {
+		return errors.New(fmt.Sprintf("xml-row-element must be a valid xml name: %q", s))
+	}
+
+	f.ExportOptions.XmlRowElement = s
+	return nil
+}
+
+func (f *Flags) SetSqlTable(s string) {
+	f.ExportOptions.SqlTable = s
+}
+
+func (f *Flags) SetSqlDialect(s string) error {
+	dialect, err := ParseSqlDialect(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.SqlDialect = dialect
+	return nil
+}
+
+func (f *Flags) SetBorderStyle(s string) error {
+	style, err := ParseBorderStyle(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.BorderStyle = style
+	return nil
+}
+
+func (f *Flags) SetExpanded(s string) error {
+	expanded, err := ParseExpandedDisplay(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.Expanded = expanded
+	return nil
+}
+
+func (f *Flags) SetHtmlDocument(b bool) {
+	f.ExportOptions.HtmlDocument = b
+}
+
 func (f *Flags) SetStripEndingLineBreak(b bool) {
 	f.ExportOptions.StripEndingLineBreak = b
 }
@@ -6,6 +6,9 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/mithrandie/csvq/lib/sql"
+	"github.com/mithrandie/csvq/lib/texttable"
+
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/json"
 )
@@ -169,7 +172,7 @@ func TestFlags_SetImportFormat(t *testing.T) {
 		t.Errorf("importFormat = %s, expect to set %s for empty string", flags.ImportOptions.Format, JSON)
 	}
 
-	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV"
+	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV|XML|YAML"
 	err := flags.SetImportFormat("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -198,7 +201,12 @@ func TestFlags_SetDelimiter(t *testing.T) {
 		t.Errorf("delimiter = %q, expect to set %q for %q", flags.ImportOptions.Delimiter, "\t", "\t")
 	}
 
-	expectErr := "delimiter must be one character"
+	_ = flags.SetDelimiter("auto")
+	if flags.ImportOptions.Delimiter != AutoDelimiter {
+		t.Errorf("delimiter = %q, expect to set %q for %q", flags.ImportOptions.Delimiter, AutoDelimiter, "auto")
+	}
+
+	expectErr := "delimiter must be one character or \"AUTO\""
 	err := flags.SetDelimiter("[a]")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "//")
@@ -206,7 +214,7 @@ func TestFlags_SetDelimiter(t *testing.T) {
 		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "//")
 	}
 
-	expectErr = "delimiter must be one character"
+	expectErr = "delimiter must be one character or \"AUTO\""
 	err = flags.SetDelimiter("//")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "//")
@@ -280,7 +288,7 @@ func TestFlags_SetEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.ImportOptions.Encoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS"
+	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258"
 	err := flags.SetEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -307,6 +315,103 @@ func TestFlags_SetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestFlags_SetLenient(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetLenient(true)
+	if !flags.ImportOptions.Lenient {
+		t.Errorf("lenient = %t, expect to set %t", flags.ImportOptions.Lenient, true)
+	}
+}
+
+func TestFlags_SetRejectFile(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetRejectFile(" rejected.csv ")
+	if flags.ImportOptions.RejectFile != "rejected.csv" {
+		t.Errorf("reject-file = %q, expect to set %q", flags.ImportOptions.RejectFile, "rejected.csv")
+	}
+}
+
+func TestFlags_SetSkipLines(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetSkipLines(5)
+	if flags.ImportOptions.SkipLines != 5 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.ImportOptions.SkipLines, 5)
+	}
+
+	flags.SetSkipLines(-1)
+	if flags.ImportOptions.SkipLines != 0 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.ImportOptions.SkipLines, 0)
+	}
+}
+
+func TestFlags_SetComment(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetComment("#")
+	if flags.ImportOptions.Comment != "#" {
+		t.Errorf("comment = %q, expect to set %q", flags.ImportOptions.Comment, "#")
+	}
+}
+
+func TestFlags_SetQuote(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetQuote("'")
+	if flags.ImportOptions.Quote != '\'' {
+		t.Errorf("quote = %q, expect to set %q", flags.ImportOptions.Quote, '\'')
+	}
+
+	expectErr := "quote must be one character"
+	err := flags.SetQuote("")
+	if err == nil {
+		t.Errorf("no error, want error %q for %q", expectErr, "")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %q", err.Error(), expectErr, "")
+	}
+}
+
+func TestFlags_SetQuoteEscape(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetQuoteEscape("backslash")
+	if flags.ImportOptions.QuoteEscape != QuoteEscapeBackslash {
+		t.Errorf("quote-escape = %s, expect to set %s", flags.ImportOptions.QuoteEscape, QuoteEscapeBackslash)
+	}
+
+	expectErr := "quote escape must be one of DOUBLE|BACKSLASH"
+	err := flags.SetQuoteEscape("hex")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "hex")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "hex")
+	}
+}
+
+func TestFlags_SetLocale(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetLocale("de_de.UTF-8")
+	if flags.ImportOptions.Locale != "de-DE" {
+		t.Errorf("locale = %q, expect to set %q", flags.ImportOptions.Locale, "de-DE")
+	}
+
+	_ = flags.SetLocale("")
+	if flags.ImportOptions.Locale != "" {
+		t.Errorf("locale = %q, expect to set %q", flags.ImportOptions.Locale, "")
+	}
+
+	expectErr := "locale \"xx-XX\" is not supported"
+	err := flags.SetLocale("xx-XX")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "xx-XX")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "xx-XX")
+	}
+}
+
 func TestFlags_SetFormat(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -345,6 +450,21 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, ORG, "foo.org")
 	}
 
+	_ = flags.SetFormat("", "foo.yml")
+	if flags.ExportOptions.Format != YAML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, YAML, "foo.yml")
+	}
+
+	_ = flags.SetFormat("", "foo.html")
+	if flags.ExportOptions.Format != HTML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, HTML, "foo.html")
+	}
+
+	_ = flags.SetFormat("", "foo.sql")
+	if flags.ExportOptions.Format != SQL {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, SQL, "foo.sql")
+	}
+
 	_ = flags.SetFormat("csv", "")
 	if flags.ExportOptions.Format != CSV {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, CSV, "csv")
@@ -401,7 +521,17 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	_ = flags.SetFormat("yaml", "")
+	if flags.ExportOptions.Format != YAML {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, YAML, "yaml")
+	}
+
+	_ = flags.SetFormat("sql", "")
+	if flags.ExportOptions.Format != SQL {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, SQL, "sql")
+	}
+
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XML|YAML|HTML|SQL"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -418,7 +548,7 @@ func TestFlags_SetWriteEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.ExportOptions.Encoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS"
+	expectErr := "write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258"
 	err := flags.SetWriteEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -449,6 +579,40 @@ func TestFlags_SetWriteDelimiter(t *testing.T) {
 	}
 }
 
+func TestFlags_SetWriteQuote(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetWriteQuote("'")
+	if flags.ExportOptions.Quote != '\'' {
+		t.Errorf("write-quote = %q, expect to set %q", flags.ExportOptions.Quote, '\'')
+	}
+
+	expectErr := "write-quote must be one character"
+	err := flags.SetWriteQuote("''")
+	if err == nil {
+		t.Errorf("no error, want error %q for %q", expectErr, "''")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %q", err.Error(), expectErr, "''")
+	}
+}
+
+func TestFlags_SetWriteQuoteEscape(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetWriteQuoteEscape("backslash")
+	if flags.ExportOptions.QuoteEscape != QuoteEscapeBackslash {
+		t.Errorf("write-quote-escape = %s, expect to set %s", flags.ExportOptions.QuoteEscape, QuoteEscapeBackslash)
+	}
+
+	expectErr := "write-quote-escape must be one of DOUBLE|BACKSLASH"
+	err := flags.SetWriteQuoteEscape("hex")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "hex")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "hex")
+	}
+}
+
 func TestFlags_SetWriteDelimiterPositions(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -567,6 +731,114 @@ func TestFlags_SetPrettyPrint(t *testing.T) {
 	}
 }
 
+func TestFlags_SetXmlRootElement(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetXmlRootElement("items")
+	if flags.ExportOptions.XmlRootElement != "items" {
+		t.Errorf("xml-root-element = %q, expect to set %q", flags.ExportOptions.XmlRootElement, "items")
+	}
+
+	expectErr := "xml-root-element must be a valid xml name: \"1items\""
+	err := flags.SetXmlRootElement("1items")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "1items")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "1items")
+	}
+}
+
+func TestFlags_SetXmlRowElement(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetXmlRowElement("item")
+	if flags.ExportOptions.XmlRowElement != "item" {
+		t.Errorf("xml-row-element = %q, expect to set %q", flags.ExportOptions.XmlRowElement, "item")
+	}
+
+	expectErr := "xml-row-element must be a valid xml name: \"\""
+	err := flags.SetXmlRowElement("")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "empty string")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "empty string")
+	}
+}
+
+func TestFlags_SetSqlTable(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetSqlTable("users")
+	if flags.ExportOptions.SqlTable != "users" {
+		t.Errorf("sql-table = %q, expect to set %q", flags.ExportOptions.SqlTable, "users")
+	}
+}
+
+func TestFlags_SetSqlDialect(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetSqlDialect("mysql")
+	if flags.ExportOptions.SqlDialect != sql.MySQL {
+		t.Errorf("sql-dialect = %s, expect to set %s for %s", SqlDialectToString(flags.ExportOptions.SqlDialect), SqlDialectToString(sql.MySQL), "mysql")
+	}
+
+	_ = flags.SetSqlDialect("postgresql")
+	if flags.ExportOptions.SqlDialect != sql.PostgreSQL {
+		t.Errorf("sql-dialect = %s, expect to set %s for %s", SqlDialectToString(flags.ExportOptions.SqlDialect), SqlDialectToString(sql.PostgreSQL), "postgresql")
+	}
+
+	expectErr := "sql dialect must be one of POSTGRES|MYSQL|SQLITE"
+	err := flags.SetSqlDialect("oracle")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "oracle")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "oracle")
+	}
+}
+
+func TestFlags_SetBorderStyle(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetBorderStyle("double")
+	if flags.ExportOptions.BorderStyle != texttable.DoubleLine {
+		t.Errorf("border-style = %s, expect to set %s for %s", BorderStyleToString(flags.ExportOptions.BorderStyle), BorderStyleToString(texttable.DoubleLine), "double")
+	}
+
+	expectErr := "border style must be one of ASCII|SINGLE|DOUBLE|MINIMAL"
+	err := flags.SetBorderStyle("bold")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "bold")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "bold")
+	}
+}
+
+func TestFlags_SetExpanded(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetExpanded("auto")
+	if flags.ExportOptions.Expanded != ExpandedAuto {
+		t.Errorf("expanded = %s, expect to set %s for %s", flags.ExportOptions.Expanded, ExpandedAuto, "auto")
+	}
+
+	expectErr := "expanded must be one of OFF|ON|AUTO"
+	err := flags.SetExpanded("always")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "always")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "always")
+	}
+}
+
+func TestFlags_SetHtmlDocument(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetHtmlDocument(true)
+	if !flags.ExportOptions.HtmlDocument {
+		t.Errorf("html-document = %t, expect to set %t", flags.ExportOptions.HtmlDocument, true)
+	}
+}
+
 func TestFlags_SetStripEndingLineBreak(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DateOrder is the order of the year, the month and the day in dates.
+type DateOrder int
+
+const (
+	DateOrderYMD DateOrder = iota
+	DateOrderDMY
+	DateOrderMDY
+)
+
+// Locale represents the conventions of a region to write numbers and dates.
+type Locale struct {
+	Name string
+
+	DecimalPoint rune
+
+	// ThousandsSeparators are the characters accepted as the thousands separator.
+	ThousandsSeparators []rune
+
+	DateOrder DateOrder
+
+	// DateSeparator is the separator used to write dates in the locale.
+	DateSeparator rune
+}
+
+// IsThousandsSeparator returns true if the character is accepted as the thousands separator in the locale.
+func (l *Locale) IsThousandsSeparator(r rune) bool {
+	for _, sep := range l.ThousandsSeparators {
+		if r == sep {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	commaSeparators  = []rune{','}
+	periodSeparators = []rune{'.'}
+	spaceSeparators  = []rune{' ', '\u00a0', '\u202f'}
+)
+
+var locales = map[string]*Locale{
+	"cs-CZ": {Name: "cs-CZ", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"da-DK": {Name: "da-DK", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"de-AT": {Name: "de-AT", DecimalPoint: ',', ThousandsSeparators: []rune{'.', ' ', '\u00a0'}, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"de-CH": {Name: "de-CH", DecimalPoint: '.', ThousandsSeparators: []rune{'\'', '\u2019'}, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"de-DE": {Name: "de-DE", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"el-GR": {Name: "el-GR", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"en-AU": {Name: "en-AU", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"en-GB": {Name: "en-GB", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"en-IE": {Name: "en-IE", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"en-NZ": {Name: "en-NZ", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"en-US": {Name: "en-US", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderMDY, DateSeparator: '/'},
+	"es-ES": {Name: "es-ES", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"es-MX": {Name: "es-MX", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"fi-FI": {Name: "fi-FI", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"fr-CA": {Name: "fr-CA", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderYMD, DateSeparator: '-'},
+	"fr-CH": {Name: "fr-CH", DecimalPoint: '.', ThousandsSeparators: []rune{'\'', '\u2019', ' ', '\u202f'}, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"fr-FR": {Name: "fr-FR", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"id-ID": {Name: "id-ID", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"it-IT": {Name: "it-IT", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"ja-JP": {Name: "ja-JP", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderYMD, DateSeparator: '/'},
+	"ko-KR": {Name: "ko-KR", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderYMD, DateSeparator: '.'},
+	"nb-NO": {Name: "nb-NO", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"nl-NL": {Name: "nl-NL", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '-'},
+	"pl-PL": {Name: "pl-PL", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"pt-BR": {Name: "pt-BR", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"pt-PT": {Name: "pt-PT", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '/'},
+	"ru-RU": {Name: "ru-RU", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"sv-SE": {Name: "sv-SE", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderYMD, DateSeparator: '-'},
+	"tr-TR": {Name: "tr-TR", DecimalPoint: ',', ThousandsSeparators: periodSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"uk-UA": {Name: "uk-UA", DecimalPoint: ',', ThousandsSeparators: spaceSeparators, DateOrder: DateOrderDMY, DateSeparator: '.'},
+	"zh-CN": {Name: "zh-CN", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderYMD, DateSeparator: '/'},
+	"zh-TW": {Name: "zh-TW", DecimalPoint: '.', ThousandsSeparators: commaSeparators, DateOrder: DateOrderYMD, DateSeparator: '/'},
+}
+
+// defaultRegions are the regions used when locales are specified only by languages.
+var defaultRegions = map[string]string{
+	"cs": "CZ",
+	"da": "DK",
+	"de": "DE",
+	"el": "GR",
+	"en": "US",
+	"es": "ES",
+	"fi": "FI",
+	"fr": "FR",
+	"id": "ID",
+	"it": "IT",
+	"ja": "JP",
+	"ko": "KR",
+	"nb": "NO",
+	"nl": "NL",
+	"pl": "PL",
+	"pt": "PT",
+	"ru": "RU",
+	"sv": "SE",
+	"tr": "TR",
+	"uk": "UA",
+	"zh": "CN",
+}
+
+// ParseLocale returns the locale specified by a language tag such as "de-DE", "de_DE.UTF-8" or "de".
+// If the string is empty, then nil is returned.
+func ParseLocale(s string) (*Locale, error) {
+	s = TrimSpace(s)
+	if len(s) < 1 {
+		return nil, nil
+	}
+
+	tag := s
+	if i := strings.IndexAny(tag, ".@"); -1 < i {
+		tag = tag[:i]
+	}
+	tag = strings.Replace(tag, "_", "-", -1)
+
+	lang := strings.ToLower(tag)
+	region := ""
+	if i := strings.IndexByte(tag, '-'); -1 < i {
+		lang = strings.ToLower(tag[:i])
+		region = strings.ToUpper(tag[i+1:])
+	}
+	if len(region) < 1 {
+		region = defaultRegions[lang]
+	}
+
+	if l, ok := locales[lang+"-"+region]; ok {
+		return l, nil
+	}
+	return nil, errors.New(fmt.Sprintf("locale %q is not supported", s))
+}
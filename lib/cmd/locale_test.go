@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+)
+
+var parseLocaleTests = []struct {
+	Input  string
+	Expect string
+	Error  string
+}{
+	{
+		Input:  "",
+		Expect: "",
+	},
+	{
+		Input:  "de-DE",
+		Expect: "de-DE",
+	},
+	{
+		Input:  "de_de.UTF-8",
+		Expect: "de-DE",
+	},
+	{
+		Input:  "fr_FR@euro",
+		Expect: "fr-FR",
+	},
+	{
+		Input:  "en",
+		Expect: "en-US",
+	},
+	{
+		Input:  "PT-br",
+		Expect: "pt-BR",
+	},
+	{
+		Input: "xx-XX",
+		Error: "locale \"xx-XX\" is not supported",
+	},
+	{
+		Input: "de-XX",
+		Error: "locale \"de-XX\" is not supported",
+	},
+}
+
+func TestParseLocale(t *testing.T) {
+	for _, v := range parseLocaleTests {
+		result, err := ParseLocale(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+
+		name := ""
+		if result != nil {
+			name = result.Name
+		}
+		if name != v.Expect {
+			t.Errorf("result = %q, want %q for %q", name, v.Expect, v.Input)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -12,6 +16,9 @@ var (
 
 	random  *rand.Rand
 	getRand sync.Once
+
+	locations   = make(map[string]*time.Location)
+	locationsMu sync.Mutex
 )
 
 func GetRand() *rand.Rand {
@@ -25,6 +32,73 @@ func GetLocation() *time.Location {
 	return location
 }
 
+// LoadLocation returns the timezone specified by a name such as "Local", "UTC" and "Europe/Berlin",
+// or by an offset from UTC such as "+09:00", "-0530" and "Z".
+// Loaded timezones are cached.
+func LoadLocation(s string) (*time.Location, error) {
+	s = TrimSpace(s)
+	if len(s) < 1 || strings.EqualFold(s, "Local") {
+		return time.Local, nil
+	}
+	if strings.EqualFold(s, "UTC") || s == "Z" {
+		return time.UTC, nil
+	}
+
+	locationsMu.Lock()
+	defer locationsMu.Unlock()
+
+	if loc, ok := locations[s]; ok {
+		return loc, nil
+	}
+
+	loc, ok := parseOffsetLocation(s)
+	if !ok {
+		var err error
+		if loc, err = time.LoadLocation(s); err != nil {
+			return nil, errors.New(fmt.Sprintf("timezone %q does not exist", s))
+		}
+	}
+	locations[s] = loc
+	return loc, nil
+}
+
+func parseOffsetLocation(s string) (*time.Location, bool) {
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return nil, false
+	}
+
+	digits := strings.Replace(s[1:], ":", "", 1)
+	if len(digits) != 2 && len(digits) != 4 {
+		return nil, false
+	}
+	if len(digits) == 4 && strings.IndexByte(s, ':') != -1 && strings.IndexByte(s, ':') != 3 {
+		return nil, false
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || '9' < digits[i] {
+			return nil, false
+		}
+	}
+	if len(digits) == 2 {
+		digits = digits + "00"
+	}
+
+	h, err := strconv.Atoi(digits[:2])
+	if err != nil || 23 < h {
+		return nil, false
+	}
+	m, err := strconv.Atoi(digits[2:])
+	if err != nil || 59 < m {
+		return nil, false
+	}
+
+	offset := h*3600 + m*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(s[:3]+":"+digits[2:], offset), true
+}
+
 func Now() time.Time {
 	if !TestTime.IsZero() {
 		return TestTime
@@ -23,6 +23,72 @@ func TestGetLocation(t *testing.T) {
 	}
 }
 
+var loadLocationTests = []struct {
+	Input        string
+	ExpectName   string
+	ExpectOffset int
+	Error        string
+}{
+	{
+		Input:        "utc",
+		ExpectName:   "UTC",
+		ExpectOffset: 0,
+	},
+	{
+		Input:        "Asia/Tokyo",
+		ExpectName:   "Asia/Tokyo",
+		ExpectOffset: 9 * 3600,
+	},
+	{
+		Input:        "+09:30",
+		ExpectName:   "+09:30",
+		ExpectOffset: 9*3600 + 30*60,
+	},
+	{
+		Input:        "-0500",
+		ExpectName:   "-05:00",
+		ExpectOffset: -5 * 3600,
+	},
+	{
+		Input:        "+02",
+		ExpectName:   "+02:00",
+		ExpectOffset: 2 * 3600,
+	},
+	{
+		Input: "+24:00",
+		Error: "timezone \"+24:00\" does not exist",
+	},
+	{
+		Input: "Mars/Base",
+		Error: "timezone \"Mars/Base\" does not exist",
+	},
+}
+
+func TestLoadLocation(t *testing.T) {
+	for _, v := range loadLocationTests {
+		loc, err := LoadLocation(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+
+		if loc.String() != v.ExpectName {
+			t.Errorf("name = %q, want %q for %q", loc.String(), v.ExpectName, v.Input)
+		}
+		if _, offset := time.Date(2012, 1, 1, 0, 0, 0, 0, loc).Zone(); offset != v.ExpectOffset {
+			t.Errorf("offset = %d, want %d for %q", offset, v.ExpectOffset, v.Input)
+		}
+	}
+}
+
 func TestNow(t *testing.T) {
 	TestTime, _ = time.ParseInLocation("2006-01-02 15:04:05.999999999", "2012-02-01 12:03:23", GetLocation())
 
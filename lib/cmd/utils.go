@@ -9,6 +9,10 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/mithrandie/csvq/lib/charset"
+	"github.com/mithrandie/csvq/lib/sql"
+	"github.com/mithrandie/csvq/lib/texttable"
+
 	"github.com/mithrandie/go-text"
 	txjson "github.com/mithrandie/go-text/json"
 )
@@ -288,9 +292,9 @@ func FormatNumber(f float64, precision int, decimalPoint string, thousandsSepara
 }
 
 func ParseEncoding(s string) (text.Encoding, error) {
-	encoding, err := text.ParseEncoding(s)
+	encoding, err := charset.ParseEncoding(s)
 	if err != nil {
-		err = errors.New("encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+		err = errors.New("encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258")
 	}
 	return encoding, err
 }
@@ -310,6 +314,26 @@ func ParseDelimiter(s string) (rune, error) {
 	return r[0], nil
 }
 
+// ParseImportDelimiter parses the delimiter for importing, which accepts "AUTO" in addition to a character.
+func ParseImportDelimiter(s string) (rune, error) {
+	if strings.EqualFold(UnescapeString(s, '\''), DetectDelimiterAutomatically) {
+		return AutoDelimiter, nil
+	}
+	delimiter, err := ParseDelimiter(s)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("delimiter must be one character or %q", DetectDelimiterAutomatically))
+	}
+	return delimiter, err
+}
+
+// ImportDelimiterToString returns the string representation of the delimiter for importing.
+func ImportDelimiterToString(r rune) string {
+	if r == AutoDelimiter {
+		return DetectDelimiterAutomatically
+	}
+	return string(r)
+}
+
 func ParseDelimiterPositions(s string) ([]int, bool, error) {
 	s = UnescapeString(s, '\'')
 	var delimiterPositions []int = nil
@@ -347,6 +371,14 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = ORG
 	case "TEXT":
 		fm = TEXT
+	case "XML":
+		fm = XML
+	case "YAML":
+		fm = YAML
+	case "HTML":
+		fm = HTML
+	case "SQL":
+		fm = SQL
 	case "JSONH":
 		fm = JSON
 		et = txjson.HexDigits
@@ -354,7 +386,7 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XML|YAML|HTML|SQL")
 	}
 	return fm, et, nil
 }
@@ -374,6 +406,74 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseSqlDialect(s string) (sql.Dialect, error) {
+	var dialect sql.Dialect
+	switch strings.ToUpper(s) {
+	case "POSTGRES", "POSTGRESQL":
+		dialect = sql.PostgreSQL
+	case "MYSQL":
+		dialect = sql.MySQL
+	case "SQLITE":
+		dialect = sql.SQLite
+	default:
+		return dialect, errors.New("sql dialect must be one of POSTGRES|MYSQL|SQLITE")
+	}
+	return dialect, nil
+}
+
+func ParseBorderStyle(s string) (texttable.BorderStyle, error) {
+	var style texttable.BorderStyle
+	switch strings.ToUpper(s) {
+	case "ASCII":
+		style = texttable.ASCII
+	case "SINGLE":
+		style = texttable.SingleLine
+	case "DOUBLE":
+		style = texttable.DoubleLine
+	case "MINIMAL":
+		style = texttable.Minimal
+	default:
+		return style, errors.New("border style must be one of ASCII|SINGLE|DOUBLE|MINIMAL")
+	}
+	return style, nil
+}
+
+func ParseQuote(s string) (rune, error) {
+	r := []rune(UnescapeString(s, 0))
+	if len(r) != 1 {
+		return 0, errors.New("quote must be one character")
+	}
+	return r[0], nil
+}
+
+func ParseQuoteEscape(s string) (QuoteEscape, error) {
+	var escape QuoteEscape
+	switch strings.ToUpper(s) {
+	case "DOUBLE":
+		escape = QuoteEscapeDouble
+	case "BACKSLASH":
+		escape = QuoteEscapeBackslash
+	default:
+		return escape, errors.New("quote escape must be one of DOUBLE|BACKSLASH")
+	}
+	return escape, nil
+}
+
+func ParseExpandedDisplay(s string) (ExpandedDisplay, error) {
+	var expanded ExpandedDisplay
+	switch strings.ToUpper(s) {
+	case "OFF":
+		expanded = ExpandedOff
+	case "ON":
+		expanded = ExpandedOn
+	case "AUTO":
+		expanded = ExpandedAuto
+	default:
+		return expanded, errors.New("expanded must be one of OFF|ON|AUTO")
+	}
+	return expanded, nil
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
@@ -215,7 +215,7 @@ func TestParseEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", e, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS"
+	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258"
 	_, err = ParseEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -0,0 +1,199 @@
+package html
+
+import (
+	"fmt"
+	"html"
+	"reflect"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/color"
+)
+
+const (
+	LabelClass = "label"
+)
+
+// CellClasses is the list of classes that are given to cells and styled in documents.
+var CellClasses = []string{
+	"string",
+	"number",
+	"boolean",
+	"ternary",
+	"datetime",
+	"null",
+}
+
+var color8Values = map[string]string{
+	"BLACK":         "#000000",
+	"RED":           "#cd0000",
+	"GREEN":         "#00cd00",
+	"YELLOW":        "#cdcd00",
+	"BLUE":          "#0000ee",
+	"MAGENTA":       "#cd00cd",
+	"CYAN":          "#00cdcd",
+	"WHITE":         "#e5e5e5",
+	"BRIGHTBLACK":   "#7f7f7f",
+	"BRIGHTRED":     "#ff0000",
+	"BRIGHTGREEN":   "#00ff00",
+	"BRIGHTYELLOW":  "#ffff00",
+	"BRIGHTBLUE":    "#5c5cff",
+	"BRIGHTMAGENTA": "#ff00ff",
+	"BRIGHTCYAN":    "#00ffff",
+	"BRIGHTWHITE":   "#ffffff",
+}
+
+var color8Order = []string{
+	"BLACK", "RED", "GREEN", "YELLOW", "BLUE", "MAGENTA", "CYAN", "WHITE",
+	"BRIGHTBLACK", "BRIGHTRED", "BRIGHTGREEN", "BRIGHTYELLOW", "BRIGHTBLUE", "BRIGHTMAGENTA", "BRIGHTCYAN", "BRIGHTWHITE",
+}
+
+var color256Levels = []int{0, 95, 135, 175, 215, 255}
+
+// DocumentHeader returns the beginning of a self-contained HTML document up to the start tag of the body element.
+// Table headers and cells are styled by the effectors in the palette configuration.
+func DocumentHeader(title string, enc text.Encoding, palette color.PaletteConfig, lineBreak text.LineBreak) string {
+	lb := lineBreak.Value()
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>" + lb)
+	b.WriteString("<html>" + lb)
+	b.WriteString("<head>" + lb)
+	b.WriteString("<meta charset=\"" + CharsetName(enc) + "\">" + lb)
+	b.WriteString("<title>" + html.EscapeString(title) + "</title>" + lb)
+	b.WriteString("<style>" + lb)
+	b.WriteString("table." + TableClass + " { border-collapse: collapse; margin: 0 0 1em 0; }" + lb)
+	b.WriteString("table." + TableClass + " th, table." + TableClass + " td { border: 1px solid #c0c0c0; padding: 0.2em 0.6em; white-space: pre-wrap; }" + lb)
+	if s := Style(palette.Effectors[LabelClass]); 0 < len(s) {
+		b.WriteString("table." + TableClass + " th { " + s + " }" + lb)
+	}
+	for _, class := range CellClasses {
+		if s := Style(palette.Effectors[class]); 0 < len(s) {
+			b.WriteString("table." + TableClass + " td." + class + " { " + s + " }" + lb)
+		}
+	}
+	b.WriteString("</style>" + lb)
+	b.WriteString("</head>" + lb)
+	b.WriteString("<body>" + lb)
+	return b.String()
+}
+
+// DocumentFooter returns the end of a document that begins with DocumentHeader.
+func DocumentFooter(lineBreak text.LineBreak) string {
+	return "</body>" + lineBreak.Value() + "</html>" + lineBreak.Value()
+}
+
+// Style converts an effector configuration to CSS declarations.
+func Style(config color.EffectorConfig) string {
+	declarations := make([]string, 0, 4)
+
+	if c, ok := cssColor(config.Foreground); ok {
+		declarations = append(declarations, "color: "+c+";")
+	}
+	if c, ok := cssColor(config.Background); ok {
+		declarations = append(declarations, "background-color: "+c+";")
+	}
+
+	decorations := make([]string, 0, 2)
+	for _, effect := range config.Effects {
+		code, err := color.ParseEffectCode(effect)
+		if err != nil {
+			continue
+		}
+
+		switch code {
+		case color.Bold:
+			declarations = append(declarations, "font-weight: bold;")
+		case color.Faint:
+			declarations = append(declarations, "opacity: 0.6;")
+		case color.Italic:
+			declarations = append(declarations, "font-style: italic;")
+		case color.Underline:
+			decorations = append(decorations, "underline")
+		case color.CrossedOut:
+			decorations = append(decorations, "line-through")
+		}
+	}
+	if 0 < len(decorations) {
+		declarations = append(declarations, "text-decoration: "+strings.Join(decorations, " ")+";")
+	}
+
+	return strings.Join(declarations, " ")
+}
+
+func cssColor(i interface{}) (string, bool) {
+	if i == nil {
+		return "", false
+	}
+
+	switch reflect.TypeOf(i).Kind() {
+	case reflect.String:
+		c, ok := color8Values[strings.ToUpper(i.(string))]
+		return c, ok
+	case reflect.Slice:
+		s := reflect.ValueOf(i)
+		if s.Len() != 3 {
+			return "", false
+		}
+		rgb := make([]int, 3)
+		for j := 0; j < 3; j++ {
+			n, ok := toInt(s.Index(j).Interface())
+			if !ok {
+				return "", false
+			}
+			rgb[j] = n
+		}
+		return fmt.Sprintf("rgb(%d, %d, %d)", rgb[0], rgb[1], rgb[2]), true
+	default:
+		n, ok := toInt(i)
+		if !ok || n < 0 || 255 < n {
+			return "", false
+		}
+		return color256Value(n), true
+	}
+}
+
+func color256Value(n int) string {
+	switch {
+	case n < 16:
+		return color8Values[color8Order[n]]
+	case n < 232:
+		n = n - 16
+		return fmt.Sprintf("#%02x%02x%02x", color256Levels[n/36], color256Levels[(n/6)%6], color256Levels[n%6])
+	default:
+		gray := 8 + (n-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+	}
+}
+
+func toInt(i interface{}) (int, bool) {
+	switch i.(type) {
+	case int:
+		return i.(int), true
+	case int64:
+		return int(i.(int64)), true
+	case float64:
+		return int(i.(float64)), true
+	default:
+		return 0, false
+	}
+}
+
+// CharsetName returns the name of the encoding used in meta elements.
+func CharsetName(enc text.Encoding) string {
+	switch enc {
+	case text.UTF16, text.UTF16BE, text.UTF16BEM:
+		return "UTF-16BE"
+	case text.UTF16LE, text.UTF16LEM:
+		return "UTF-16LE"
+	case text.SJIS:
+		return "Shift_JIS"
+	default:
+		if name := charset.IANAName(enc); 0 < len(name) {
+			return name
+		}
+		return "UTF-8"
+	}
+}
@@ -0,0 +1,82 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/color"
+)
+
+var styleTests = []struct {
+	Config color.EffectorConfig
+	Expect string
+}{
+	{
+		Config: color.EffectorConfig{
+			Effects:    []string{"Bold", "Underline", "CrossedOut"},
+			Foreground: "Blue",
+			Background: nil,
+		},
+		Expect: "color: #0000ee; font-weight: bold; text-decoration: underline line-through;",
+	},
+	{
+		Config: color.EffectorConfig{
+			Effects:    []string{"Italic", "Unknown"},
+			Foreground: 196,
+			Background: []int{10, 20, 30},
+		},
+		Expect: "color: #ff0000; background-color: rgb(10, 20, 30); font-style: italic;",
+	},
+	{
+		Config: color.EffectorConfig{
+			Foreground: float64(244),
+			Background: float64(9),
+		},
+		Expect: "color: #808080; background-color: #ff0000;",
+	},
+	{
+		Config: color.EffectorConfig{
+			Foreground: "NotColor",
+			Background: []int{1, 2},
+		},
+		Expect: "",
+	},
+}
+
+func TestStyle(t *testing.T) {
+	for _, v := range styleTests {
+		result := Style(v.Config)
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %v", result, v.Expect, v.Config)
+		}
+	}
+}
+
+func TestDocumentHeader(t *testing.T) {
+	palette := color.PaletteConfig{
+		Effectors: map[string]color.EffectorConfig{
+			"label":  {Effects: []string{"Bold"}},
+			"number": {Foreground: "Magenta"},
+			"prompt": {Foreground: "Blue"},
+		},
+	}
+
+	expect := "<!DOCTYPE html>\r\n" +
+		"<html>\r\n" +
+		"<head>\r\n" +
+		"<meta charset=\"Shift_JIS\">\r\n" +
+		"<title>a&amp;b</title>\r\n" +
+		"<style>\r\n" +
+		"table.csvq { border-collapse: collapse; margin: 0 0 1em 0; }\r\n" +
+		"table.csvq th, table.csvq td { border: 1px solid #c0c0c0; padding: 0.2em 0.6em; white-space: pre-wrap; }\r\n" +
+		"table.csvq th { font-weight: bold; }\r\n" +
+		"table.csvq td.number { color: #cd00cd; }\r\n" +
+		"</style>\r\n" +
+		"</head>\r\n" +
+		"<body>\r\n"
+
+	result := DocumentHeader("a&b", text.SJIS, palette, text.CRLF)
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
@@ -0,0 +1,136 @@
+package html
+
+import (
+	"bufio"
+	"errors"
+	"html"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+)
+
+const TableClass = "csvq"
+
+type Field struct {
+	Contents  string
+	Class     string
+	Alignment text.FieldAlignment
+}
+
+func NewField(s string, class string, alignment text.FieldAlignment) Field {
+	return Field{
+		Contents:  s,
+		Class:     class,
+		Alignment: alignment,
+	}
+}
+
+// Writer writes records as rows of a table element.
+type Writer struct {
+	header    []string
+	lineBreak string
+
+	writer  *bufio.Writer
+	started bool
+}
+
+// NewWriter returns a writer that writes a table element.
+// If the header is nil, then the thead element is not written.
+func NewWriter(w io.Writer, header []string, lineBreak text.LineBreak, enc text.Encoding) (*Writer, error) {
+	writer, err := charset.GetTransformWriter(w, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		header:    header,
+		lineBreak: lineBreak.Value(),
+		writer:    bufio.NewWriter(writer),
+	}, nil
+}
+
+func (e *Writer) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	if _, err := e.writer.WriteString("<table class=\"" + TableClass + "\">" + e.lineBreak); err != nil {
+		return err
+	}
+
+	if e.header != nil {
+		if _, err := e.writer.WriteString("<thead>" + e.lineBreak + "<tr>"); err != nil {
+			return err
+		}
+		for _, label := range e.header {
+			if _, err := e.writer.WriteString("<th>" + html.EscapeString(label) + "</th>"); err != nil {
+				return err
+			}
+		}
+		if _, err := e.writer.WriteString("</tr>" + e.lineBreak + "</thead>" + e.lineBreak); err != nil {
+			return err
+		}
+	}
+
+	_, err := e.writer.WriteString("<tbody>" + e.lineBreak)
+	return err
+}
+
+func (e *Writer) Write(record []Field) error {
+	if e.header != nil && len(record) != len(e.header) {
+		return errors.New("field length does not match")
+	}
+
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	if _, err := e.writer.WriteString("<tr>"); err != nil {
+		return err
+	}
+	for _, f := range record {
+		if _, err := e.writer.WriteString("<td"); err != nil {
+			return err
+		}
+		if 0 < len(f.Class) {
+			if _, err := e.writer.WriteString(" class=\"" + html.EscapeString(f.Class) + "\""); err != nil {
+				return err
+			}
+		}
+		if s := alignmentStyle(f.Alignment); 0 < len(s) {
+			if _, err := e.writer.WriteString(" style=\"" + s + "\""); err != nil {
+				return err
+			}
+		}
+		if _, err := e.writer.WriteString(">" + html.EscapeString(f.Contents) + "</td>"); err != nil {
+			return err
+		}
+	}
+	_, err := e.writer.WriteString("</tr>" + e.lineBreak)
+	return err
+}
+
+// Flush writes the end tags of the table element and flushes the buffered data.
+func (e *Writer) Flush() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if _, err := e.writer.WriteString("</tbody>" + e.lineBreak + "</table>"); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+func alignmentStyle(alignment text.FieldAlignment) string {
+	switch alignment {
+	case text.RightAligned:
+		return "text-align: right"
+	case text.Centering:
+		return "text-align: center"
+	default:
+		return ""
+	}
+}
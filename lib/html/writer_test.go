@@ -0,0 +1,102 @@
+package html
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var writerTests = []struct {
+	Name      string
+	Header    []string
+	Records   [][]Field
+	LineBreak text.LineBreak
+	Expect    string
+	Error     string
+}{
+	{
+		Name:   "Write",
+		Header: []string{"c1", "c<2>"},
+		Records: [][]Field{
+			{NewField("1", "number", text.RightAligned), NewField("a&b", "string", text.NotAligned)},
+			{NewField("NULL", "null", text.Centering), NewField("", "", text.NotAligned)},
+		},
+		LineBreak: text.LF,
+		Expect: "<table class=\"csvq\">\n" +
+			"<thead>\n" +
+			"<tr><th>c1</th><th>c&lt;2&gt;</th></tr>\n" +
+			"</thead>\n" +
+			"<tbody>\n" +
+			"<tr><td class=\"number\" style=\"text-align: right\">1</td><td class=\"string\">a&amp;b</td></tr>\n" +
+			"<tr><td class=\"null\" style=\"text-align: center\">NULL</td><td></td></tr>\n" +
+			"</tbody>\n" +
+			"</table>",
+	},
+	{
+		Name: "Write Without Header",
+		Records: [][]Field{
+			{NewField("a", "string", text.NotAligned)},
+		},
+		LineBreak: text.CRLF,
+		Expect: "<table class=\"csvq\">\r\n" +
+			"<tbody>\r\n" +
+			"<tr><td class=\"string\">a</td></tr>\r\n" +
+			"</tbody>\r\n" +
+			"</table>",
+	},
+	{
+		Name:      "Write Empty Records",
+		Header:    []string{"c1"},
+		LineBreak: text.LF,
+		Expect: "<table class=\"csvq\">\n" +
+			"<thead>\n" +
+			"<tr><th>c1</th></tr>\n" +
+			"</thead>\n" +
+			"<tbody>\n" +
+			"</tbody>\n" +
+			"</table>",
+	},
+	{
+		Name:   "Write Field Length Error",
+		Header: []string{"c1", "c2"},
+		Records: [][]Field{
+			{NewField("a", "string", text.NotAligned)},
+		},
+		LineBreak: text.LF,
+		Error:     "field length does not match",
+	},
+}
+
+func TestWriter_Write(t *testing.T) {
+	for _, v := range writerTests {
+		buf := &bytes.Buffer{}
+		w, _ := NewWriter(buf, v.Header, v.LineBreak, text.UTF8)
+
+		var err error
+		for _, r := range v.Records {
+			if err = w.Write(r); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = w.Flush()
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Expect)
+		}
+	}
+}
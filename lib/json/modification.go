@@ -0,0 +1,184 @@
+package json
+
+import (
+	"errors"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+// ParseModificationPath parses a path that points to a single value to be modified.
+// The path must consist of object members and array indices.
+func ParseModificationPath(s string) (QueryExpression, error) {
+	path, err := Query.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for e := path; e != nil; {
+		switch e.(type) {
+		case Element:
+			e = e.(Element).Child
+		case ArrayItem:
+			e = e.(ArrayItem).Child
+		default:
+			return nil, errors.New("path must consist of object members and array indices")
+		}
+	}
+	return path, nil
+}
+
+// LookupValue returns the value that the path points to, and whether the value exists.
+func LookupValue(data json.Structure, path QueryExpression) (json.Structure, bool) {
+	values := selectValues(path, data, nil)
+	if len(values) < 1 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// SetValue sets a value to the position that the path points to.
+//
+// If replace is true, an existing value is replaced.
+// If insert is true, a value that does not exist is added. Missing objects on the way to the position are created,
+// and an index beyond the end of an array appends the value to the array.
+func SetValue(data json.Structure, path QueryExpression, val json.Structure, replace bool, insert bool) json.Structure {
+	if path == nil {
+		if replace {
+			return val
+		}
+		return data
+	}
+
+	switch path.(type) {
+	case Element:
+		element := path.(Element)
+		obj, ok := data.(json.Object)
+		if !ok {
+			break
+		}
+
+		if obj.Exists(element.Label) {
+			obj.Update(element.Label, SetValue(obj.Value(element.Label), element.Child, val, replace, insert))
+		} else if insert {
+			if element.Child == nil {
+				obj.Add(element.Label, val)
+			} else if _, ok := element.Child.(Element); ok {
+				obj.Add(element.Label, SetValue(json.NewObject(1), element.Child, val, replace, insert))
+			}
+		}
+		return obj
+	case ArrayItem:
+		arrayItem := path.(ArrayItem)
+		ar, ok := data.(json.Array)
+		if !ok {
+			break
+		}
+
+		if idx, ok := arrayIndex(arrayItem.Index, len(ar)); ok {
+			ar[idx] = SetValue(ar[idx], arrayItem.Child, val, replace, insert)
+		} else if insert && arrayItem.Child == nil && len(ar) <= arrayItem.Index {
+			ar = append(ar, val)
+		}
+		return ar
+	}
+
+	return data
+}
+
+// RemoveValue removes the value that the path points to.
+func RemoveValue(data json.Structure, path QueryExpression) (json.Structure, error) {
+	if path == nil {
+		return nil, errors.New("root value cannot be removed")
+	}
+	return removeValue(data, path), nil
+}
+
+func removeValue(data json.Structure, path QueryExpression) json.Structure {
+	switch path.(type) {
+	case Element:
+		element := path.(Element)
+		obj, ok := data.(json.Object)
+		if !ok || !obj.Exists(element.Label) {
+			break
+		}
+
+		if element.Child == nil {
+			return removeMember(obj, element.Label)
+		}
+		obj.Update(element.Label, removeValue(obj.Value(element.Label), element.Child))
+		return obj
+	case ArrayItem:
+		arrayItem := path.(ArrayItem)
+		ar, ok := data.(json.Array)
+		if !ok {
+			break
+		}
+
+		idx, ok := arrayIndex(arrayItem.Index, len(ar))
+		if !ok {
+			break
+		}
+
+		if arrayItem.Child == nil {
+			return append(ar[:idx:idx], ar[idx+1:]...)
+		}
+		ar[idx] = removeValue(ar[idx], arrayItem.Child)
+		return ar
+	}
+
+	return data
+}
+
+func removeMember(obj json.Object, key string) json.Object {
+	members := make([]json.ObjectMember, 0, obj.Len())
+	for _, m := range obj.Members {
+		if m.Key != key {
+			members = append(members, m)
+		}
+	}
+	obj.Members = members
+	return obj
+}
+
+// MergePatch applies a patch to a value as described in RFC 7396.
+func MergePatch(target json.Structure, patch json.Structure) json.Structure {
+	patchObj, ok := patch.(json.Object)
+	if !ok {
+		return patch
+	}
+
+	obj, ok := target.(json.Object)
+	if !ok {
+		obj = json.NewObject(patchObj.Len())
+	}
+
+	for _, m := range patchObj.Members {
+		if _, ok := m.Value.(json.Null); ok {
+			if obj.Exists(m.Key) {
+				obj = removeMember(obj, m.Key)
+			}
+		} else if obj.Exists(m.Key) {
+			obj.Update(m.Key, MergePatch(obj.Value(m.Key), m.Value))
+		} else {
+			obj.Add(m.Key, MergePatch(nil, m.Value))
+		}
+	}
+	return obj
+}
+
+// TypeName returns the name of the type of a value.
+func TypeName(data json.Structure) string {
+	switch data.(type) {
+	case json.Object:
+		return "object"
+	case json.Array:
+		return "array"
+	case json.String:
+		return "string"
+	case json.Integer, json.Float, json.Number:
+		return "number"
+	case json.Boolean:
+		return "boolean"
+	}
+	return "null"
+}
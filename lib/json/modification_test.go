@@ -0,0 +1,232 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+var parseModificationPathTests = []struct {
+	Path  string
+	Error string
+}{
+	{
+		Path: "a.b[1]",
+	},
+	{
+		Path: "",
+	},
+	{
+		Path:  "a[*]",
+		Error: "path must consist of object members and array indices",
+	},
+	{
+		Path:  "'a",
+		Error: "column 2: string not terminated",
+	},
+}
+
+func TestParseModificationPath(t *testing.T) {
+	for _, v := range parseModificationPathTests {
+		_, err := ParseModificationPath(v.Path)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err.Error(), v.Path)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err, v.Error, v.Path)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Path)
+		}
+	}
+}
+
+var setValueTests = []struct {
+	Json    string
+	Path    string
+	Value   json.Structure
+	Replace bool
+	Insert  bool
+	Expect  string
+}{
+	{
+		Json:    "{\"a\":1,\"b\":{\"c\":2}}",
+		Path:    "b.c",
+		Value:   json.Integer(3),
+		Replace: true,
+		Insert:  true,
+		Expect:  "{\"a\":1,\"b\":{\"c\":3}}",
+	},
+	{
+		Json:    "{\"a\":1}",
+		Path:    "b.c",
+		Value:   json.String("x"),
+		Replace: true,
+		Insert:  true,
+		Expect:  "{\"a\":1,\"b\":{\"c\":\"x\"}}",
+	},
+	{
+		Json:    "{\"a\":1}",
+		Path:    "a",
+		Value:   json.Integer(2),
+		Replace: false,
+		Insert:  true,
+		Expect:  "{\"a\":1}",
+	},
+	{
+		Json:    "{\"a\":[1,2]}",
+		Path:    "a[5]",
+		Value:   json.Integer(3),
+		Replace: true,
+		Insert:  true,
+		Expect:  "{\"a\":[1,2,3]}",
+	},
+	{
+		Json:    "{\"a\":[1,2]}",
+		Path:    "a[-1]",
+		Value:   json.Null{},
+		Replace: true,
+		Insert:  false,
+		Expect:  "{\"a\":[1,null]}",
+	},
+	{
+		Json:    "{\"a\":1}",
+		Path:    "a.b",
+		Value:   json.Integer(2),
+		Replace: true,
+		Insert:  true,
+		Expect:  "{\"a\":1}",
+	},
+	{
+		Json:    "{\"a\":1}",
+		Path:    "",
+		Value:   json.Boolean(true),
+		Replace: true,
+		Insert:  true,
+		Expect:  "true",
+	},
+}
+
+func TestSetValue(t *testing.T) {
+	for _, v := range setValueTests {
+		data, _, _ := Decode(v.Json)
+		path, _ := ParseModificationPath(v.Path)
+		result := SetValue(data, path, v.Value, v.Replace, v.Insert)
+		if result.Encode() != v.Expect {
+			t.Errorf("result = %s, want %s for %q, %q", result.Encode(), v.Expect, v.Path, v.Json)
+		}
+	}
+}
+
+var removeValueTests = []struct {
+	Json   string
+	Path   string
+	Expect string
+	Error  string
+}{
+	{
+		Json:   "{\"a\":1,\"b\":{\"c\":2,\"d\":3}}",
+		Path:   "b.c",
+		Expect: "{\"a\":1,\"b\":{\"d\":3}}",
+	},
+	{
+		Json:   "[1,2,3]",
+		Path:   "[1]",
+		Expect: "[1,3]",
+	},
+	{
+		Json:   "{\"a\":1}",
+		Path:   "b",
+		Expect: "{\"a\":1}",
+	},
+	{
+		Json:  "{\"a\":1}",
+		Path:  "",
+		Error: "root value cannot be removed",
+	},
+}
+
+func TestRemoveValue(t *testing.T) {
+	for _, v := range removeValueTests {
+		data, _, _ := Decode(v.Json)
+		path, _ := ParseModificationPath(v.Path)
+		result, err := RemoveValue(data, path)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q, %q", err.Error(), v.Path, v.Json)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q, %q", err, v.Error, v.Path, v.Json)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q, %q", v.Error, v.Path, v.Json)
+			continue
+		}
+		if result.Encode() != v.Expect {
+			t.Errorf("result = %s, want %s for %q, %q", result.Encode(), v.Expect, v.Path, v.Json)
+		}
+	}
+}
+
+var mergePatchTests = []struct {
+	Target string
+	Patch  string
+	Expect string
+}{
+	{
+		Target: "{\"a\":\"b\",\"c\":{\"d\":\"e\",\"f\":\"g\"}}",
+		Patch:  "{\"a\":\"z\",\"c\":{\"f\":null}}",
+		Expect: "{\"a\":\"z\",\"c\":{\"d\":\"e\"}}",
+	},
+	{
+		Target: "{\"a\":[1,2]}",
+		Patch:  "{\"a\":[3],\"b\":{\"c\":null}}",
+		Expect: "{\"a\":[3],\"b\":{}}",
+	},
+	{
+		Target: "[1,2]",
+		Patch:  "{\"a\":1}",
+		Expect: "{\"a\":1}",
+	},
+	{
+		Target: "{\"a\":1}",
+		Patch:  "[\"b\"]",
+		Expect: "[\"b\"]",
+	},
+}
+
+func TestMergePatch(t *testing.T) {
+	for _, v := range mergePatchTests {
+		target, _, _ := Decode(v.Target)
+		patch, _, _ := Decode(v.Patch)
+		result := MergePatch(target, patch)
+		if result.Encode() != v.Expect {
+			t.Errorf("result = %s, want %s for %q, %q", result.Encode(), v.Expect, v.Target, v.Patch)
+		}
+	}
+}
+
+var typeNameTests = []struct {
+	Value  json.Structure
+	Expect string
+}{
+	{Value: json.NewObject(0), Expect: "object"},
+	{Value: json.Array{}, Expect: "array"},
+	{Value: json.String("a"), Expect: "string"},
+	{Value: json.Integer(1), Expect: "number"},
+	{Value: json.Float(1.5), Expect: "number"},
+	{Value: json.Boolean(false), Expect: "boolean"},
+	{Value: json.Null{}, Expect: "null"},
+}
+
+func TestTypeName(t *testing.T) {
+	for _, v := range typeNameTests {
+		result := TypeName(v.Value)
+		if result != v.Expect {
+			t.Errorf("result = %s, want %s for %#v", result, v.Expect, v.Value)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package json
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -53,9 +54,7 @@ func load(queryString string, jsontext string) (json.Structure, json.EscapeType,
 		return nil, 0, err
 	}
 
-	d := json.NewDecoder()
-	d.UseInteger = true
-	data, et, err := d.Decode(jsontext)
+	data, et, err := Decode(jsontext)
 	if err != nil {
 		return nil, et, err
 	}
@@ -64,6 +63,31 @@ func load(queryString string, jsontext string) (json.Structure, json.EscapeType,
 	return st, et, err
 }
 
+// Decode decodes a JSON text. Numbers without fractions are decoded as integers.
+//
+// A text consisting of a single scalar value such as "1" or "null" is also accepted as defined in RFC 8259.
+func Decode(jsontext string) (json.Structure, json.EscapeType, error) {
+	d := json.NewDecoder()
+	d.UseInteger = true
+	data, et, err := d.Decode(jsontext)
+	if err == nil {
+		return data, et, nil
+	}
+
+	wrapped, wet, werr := d.Decode("[" + jsontext + "]")
+	if werr != nil {
+		return data, et, err
+	}
+	if array, ok := wrapped.(json.Array); ok && len(array) == 1 {
+		switch array[0].(type) {
+		case json.Object, json.Array:
+		default:
+			return array[0], wet, nil
+		}
+	}
+	return data, et, err
+}
+
 func Extract(query QueryExpression, data json.Structure) (json.Structure, error) {
 	var extracted json.Structure
 	var err error
@@ -72,6 +96,15 @@ func Extract(query QueryExpression, data json.Structure) (json.Structure, error)
 		return data, nil
 	}
 
+	if !IsDefinite(query) {
+		path, conversion := splitConversion(query)
+		extracted = selectValues(path, data, make(json.Array, 0, 10))
+		if conversion != nil {
+			return Extract(conversion, extracted)
+		}
+		return extracted, nil
+	}
+
 	switch query.(type) {
 	case Element:
 		switch data.(type) {
@@ -97,11 +130,11 @@ func Extract(query QueryExpression, data json.Structure) (json.Structure, error)
 			arrayItem := query.(ArrayItem)
 
 			ar := data.(json.Array)
-			if arrayItem.Index < len(ar) {
+			if idx, ok := arrayIndex(arrayItem.Index, len(ar)); ok {
 				if arrayItem.Child == nil {
-					extracted = ar[arrayItem.Index]
+					extracted = ar[idx]
 				} else {
-					extracted, err = Extract(arrayItem.Child, ar[arrayItem.Index])
+					extracted, err = Extract(arrayItem.Child, ar[idx])
 				}
 			} else {
 				extracted = json.Null{}
@@ -207,3 +240,260 @@ func existsKeyInFields(key string, list []FieldExpr) bool {
 	}
 	return false
 }
+
+func arrayIndex(index int, length int) (int, bool) {
+	if index < 0 {
+		index = length + index
+	}
+	return index, 0 <= index && index < length
+}
+
+// splitConversion splits an expression into the path and the conversion to a row value or a table at the end of the path.
+func splitConversion(expr QueryExpression) (QueryExpression, QueryExpression) {
+	var conversion QueryExpression
+
+	switch expr.(type) {
+	case Element:
+		e := expr.(Element)
+		e.Child, conversion = splitConversion(e.Child)
+		expr = e
+	case ArrayItem:
+		e := expr.(ArrayItem)
+		e.Child, conversion = splitConversion(e.Child)
+		expr = e
+	case Wildcard:
+		e := expr.(Wildcard)
+		e.Child, conversion = splitConversion(e.Child)
+		expr = e
+	case Slice:
+		e := expr.(Slice)
+		e.Child, conversion = splitConversion(e.Child)
+		expr = e
+	case Filter:
+		e := expr.(Filter)
+		e.Child, conversion = splitConversion(e.Child)
+		expr = e
+	case RecursiveDescent:
+		e := expr.(RecursiveDescent)
+		e.Child, conversion = splitConversion(e.Child)
+		expr = e
+	case RowValueExpr, TableExpr:
+		return nil, expr
+	}
+
+	return expr, conversion
+}
+
+// selectValues appends all the values that the path points to to the list.
+// Values that do not exist are not appended.
+func selectValues(path QueryExpression, data json.Structure, list json.Array) json.Array {
+	if path == nil {
+		return append(list, data)
+	}
+
+	switch path.(type) {
+	case Element:
+		element := path.(Element)
+		if obj, ok := data.(json.Object); ok && obj.Exists(element.Label) {
+			list = selectValues(element.Child, obj.Value(element.Label), list)
+		}
+	case ArrayItem:
+		arrayItem := path.(ArrayItem)
+		if ar, ok := data.(json.Array); ok {
+			if idx, ok := arrayIndex(arrayItem.Index, len(ar)); ok {
+				list = selectValues(arrayItem.Child, ar[idx], list)
+			}
+		}
+	case Wildcard:
+		wildcard := path.(Wildcard)
+		for _, v := range childValues(data) {
+			list = selectValues(wildcard.Child, v, list)
+		}
+	case Slice:
+		slice := path.(Slice)
+		if ar, ok := data.(json.Array); ok {
+			for _, idx := range sliceIndices(slice, len(ar)) {
+				list = selectValues(slice.Child, ar[idx], list)
+			}
+		}
+	case Filter:
+		filter := path.(Filter)
+		for _, v := range childValues(data) {
+			if evalFilter(filter.Condition, v) {
+				list = selectValues(filter.Child, v, list)
+			}
+		}
+	case RecursiveDescent:
+		descent := path.(RecursiveDescent)
+		list = selectValues(descent.Child, data, list)
+		for _, v := range childValues(data) {
+			list = selectValues(path, v, list)
+		}
+	}
+
+	return list
+}
+
+func childValues(data json.Structure) []json.Structure {
+	switch data.(type) {
+	case json.Object:
+		obj := data.(json.Object)
+		values := make([]json.Structure, 0, obj.Len())
+		for _, m := range obj.Members {
+			values = append(values, m.Value)
+		}
+		return values
+	case json.Array:
+		return data.(json.Array)
+	}
+	return nil
+}
+
+func sliceIndices(slice Slice, length int) []int {
+	step := slice.Step
+	if step == 0 {
+		step = 1
+	}
+
+	normalize := func(i int, min int, max int) int {
+		if i < 0 {
+			i = length + i
+		}
+		if i < min {
+			return min
+		}
+		if max < i {
+			return max
+		}
+		return i
+	}
+
+	indices := make([]int, 0, length)
+	if 0 < step {
+		start, end := 0, length
+		if slice.HasStart {
+			start = normalize(slice.Start, 0, length)
+		}
+		if slice.HasEnd {
+			end = normalize(slice.End, 0, length)
+		}
+		for i := start; i < end; i = i + step {
+			indices = append(indices, i)
+		}
+	} else {
+		start, end := length-1, -1
+		if slice.HasStart {
+			start = normalize(slice.Start, -1, length-1)
+		}
+		if slice.HasEnd {
+			end = normalize(slice.End, -1, length-1)
+		}
+		for i := start; end < i; i = i + step {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func evalFilter(expr FilterExpression, data json.Structure) bool {
+	switch expr.(type) {
+	case FilterParentheses:
+		return evalFilter(expr.(FilterParentheses).Expr, data)
+	case FilterNot:
+		return !evalFilter(expr.(FilterNot).Expr, data)
+	case FilterLogic:
+		logic := expr.(FilterLogic)
+		if logic.Operator == "&&" {
+			return evalFilter(logic.LHS, data) && evalFilter(logic.RHS, data)
+		}
+		return evalFilter(logic.LHS, data) || evalFilter(logic.RHS, data)
+	case FilterComparison:
+		comparison := expr.(FilterComparison)
+		lhs, ok := filterOperandValue(comparison.LHS, data)
+		if !ok {
+			return false
+		}
+		rhs, ok := filterOperandValue(comparison.RHS, data)
+		if !ok {
+			return false
+		}
+		return compareFilterValues(lhs, rhs, comparison.Operator)
+	case FilterPath:
+		path := expr.(FilterPath)
+		return 0 < len(selectValues(path.Path, data, nil))
+	case FilterValue:
+		b, ok := expr.(FilterValue).Value.(json.Boolean)
+		return ok && b.Raw()
+	}
+	return false
+}
+
+func filterOperandValue(expr FilterExpression, data json.Structure) (json.Structure, bool) {
+	switch expr.(type) {
+	case FilterPath:
+		values := selectValues(expr.(FilterPath).Path, data, nil)
+		if len(values) == 1 {
+			return values[0], true
+		}
+	case FilterValue:
+		return expr.(FilterValue).Value, true
+	}
+	return nil, false
+}
+
+func compareFilterValues(lhs json.Structure, rhs json.Structure, operator string) bool {
+	var result int
+
+	switch lhs.(type) {
+	case json.Integer, json.Float, json.Number:
+		f1 := numberValue(lhs)
+		switch rhs.(type) {
+		case json.Integer, json.Float, json.Number:
+			f2 := numberValue(rhs)
+			switch {
+			case f1 < f2:
+				result = -1
+			case f2 < f1:
+				result = 1
+			}
+		default:
+			return operator == "!="
+		}
+	case json.String:
+		s2, ok := rhs.(json.String)
+		if !ok {
+			return operator == "!="
+		}
+		result = strings.Compare(lhs.(json.String).Raw(), s2.Raw())
+	default:
+		if lhs.Encode() != rhs.Encode() {
+			return operator == "!="
+		}
+		return operator == "==" || operator == "<=" || operator == ">="
+	}
+
+	switch operator {
+	case "==":
+		return result == 0
+	case "!=":
+		return result != 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return 0 < result
+	default:
+		return 0 <= result
+	}
+}
+
+func numberValue(n json.Structure) float64 {
+	switch n.(type) {
+	case json.Integer:
+		return float64(n.(json.Integer).Raw())
+	case json.Float:
+		return n.(json.Float).Raw()
+	}
+	return n.(json.Number).Raw()
+}
@@ -7,43 +7,72 @@ import __yyfmt__ "fmt"
 
 //line query_parser.y:2
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
 
-//line query_parser.y:7
+	"github.com/mithrandie/go-text/json"
+)
+
+//line query_parser.y:12
 type jqSymType struct {
 	yys        int
 	expression QueryExpression
 	element    Element
 	field      FieldExpr
 	fields     []FieldExpr
+	index      int
+	slice      Slice
+	filter     FilterExpression
 	token      QueryToken
 }
 
 const PATH_IDENTIFIER = 57346
-const PATH_INDEX = 57347
-const AS = 57348
+const PATH_STRING = 57347
+const PATH_INDEX = 57348
+const PATH_NUMBER = 57349
+const AS = 57350
+const DESCENT = 57351
+const COMPARISON_OP = 57352
+const AND = 57353
+const OR = 57354
 
 var jqToknames = [...]string{
 	"$end",
 	"error",
 	"$unk",
 	"PATH_IDENTIFIER",
+	"PATH_STRING",
 	"PATH_INDEX",
+	"PATH_NUMBER",
 	"AS",
+	"DESCENT",
+	"COMPARISON_OP",
+	"AND",
+	"OR",
+	"'!'",
 	"'.'",
+	"'*'",
 	"'['",
 	"']'",
+	"'?'",
+	"'('",
+	"')'",
+	"'-'",
+	"':'",
 	"'{'",
 	"'}'",
+	"'@'",
 	"','",
 }
+
 var jqStatenames = [...]string{}
 
 const jqEofCode = 1
 const jqErrCode = 2
 const jqInitialStackSize = 16
 
-//line query_parser.y:185
+//line query_parser.y:427
 
 func ParseQuery(src string) (QueryExpression, error) {
 	l := new(QueryLexer)
@@ -53,7 +82,7 @@ func ParseQuery(src string) (QueryExpression, error) {
 }
 
 //line yacctab:1
-var jqExca = [...]int{
+var jqExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
@@ -61,80 +90,117 @@ var jqExca = [...]int{
 
 const jqPrivate = 57344
 
-const jqLast = 50
-
-var jqAct = [...]int{
-
-	18, 3, 23, 16, 26, 6, 5, 30, 8, 4,
-	9, 40, 20, 13, 12, 10, 8, 11, 9, 27,
-	7, 25, 29, 34, 8, 35, 9, 33, 32, 38,
-	36, 31, 39, 14, 41, 24, 19, 15, 28, 24,
-	22, 24, 43, 42, 21, 7, 37, 17, 2, 1,
+const jqLast = 138
+
+var jqAct = [...]int8{
+	43, 15, 32, 59, 60, 35, 8, 21, 34, 11,
+	71, 20, 52, 23, 16, 51, 10, 8, 69, 65,
+	66, 68, 8, 12, 49, 95, 26, 61, 69, 65,
+	66, 68, 42, 62, 107, 67, 54, 50, 96, 63,
+	55, 27, 57, 58, 94, 67, 92, 56, 93, 63,
+	41, 39, 40, 70, 73, 80, 77, 79, 78, 81,
+	75, 83, 84, 85, 88, 86, 87, 13, 14, 38,
+	106, 83, 11, 91, 39, 83, 84, 48, 9, 10,
+	83, 84, 89, 90, 100, 53, 12, 97, 98, 82,
+	99, 26, 4, 101, 102, 103, 13, 14, 105, 104,
+	22, 26, 25, 24, 31, 33, 27, 29, 108, 37,
+	74, 3, 64, 76, 47, 28, 27, 29, 45, 44,
+	2, 46, 1, 30, 13, 14, 13, 14, 36, 19,
+	7, 18, 6, 17, 5, 72, 0, 9,
 }
-var jqPact = [...]int{
 
-	16, -1000, -1000, -1000, -1000, -1000, -1000, 8, 28, 32,
-	41, -1000, -1000, -1000, 35, 33, 10, -8, 13, 31,
-	-1000, 0, 32, -1000, 20, -1000, 32, 42, 32, -1000,
-	41, -1000, -1000, -1000, -1000, 2, -1000, -1000, -1000, -1000,
-	27, 32, -1000, -1000,
+var jqPact = [...]int16{
+	63, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 0, 0,
+	85, 122, 92, -1000, -1000, -1000, 122, -1000, -1000, -1000,
+	-1000, 52, 35, 33, 13, 105, -1000, 71, 2, 20,
+	-1000, -1000, -9, -14, 77, 105, -1000, -1000, 0, 20,
+	0, 0, 14, -1000, 120, -1000, 95, 120, -1000, 20,
+	-1000, -1000, 92, 92, -1000, -1000, -1000, -1000, -1000, 69,
+	53, 14, 14, 105, -1000, -1000, -1000, 76, -1000, -1000,
+	-1000, -1000, 105, 29, 31, 27, 6, -1000, -1000, -1000,
+	-1000, -1000, 21, 14, 14, 24, -1000, 64, -1000, -1000,
+	-1000, -1000, 105, 105, 105, 14, 0, -1000, 60, -1000,
+	-1000, -1000, -1000, -1000, 50, -1000, 17, 105, -1000,
 }
-var jqPgo = [...]int{
 
-	0, 49, 48, 1, 0, 9, 2, 6, 5, 47,
-	3,
+var jqPgo = [...]uint8{
+	0, 122, 120, 5, 111, 8, 1, 0, 92, 10,
+	133, 118, 131, 129, 7, 13, 115, 3, 4, 112,
+	105, 2,
 }
-var jqR1 = [...]int{
 
-	0, 1, 1, 2, 2, 2, 2, 3, 3, 3,
-	3, 3, 4, 4, 4, 5, 5, 5, 5, 5,
-	6, 6, 6, 7, 7, 7, 8, 9, 9, 10,
-	10, 10,
+var jqR1 = [...]int8{
+	0, 1, 1, 2, 2, 2, 2, 2, 3, 3,
+	4, 4, 5, 5, 6, 6, 6, 6, 6, 7,
+	7, 7, 8, 8, 9, 9, 10, 10, 10, 10,
+	10, 10, 10, 10, 10, 10, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 14, 14, 15, 15,
+	15, 16, 16, 16, 16, 12, 12, 13, 17, 17,
+	17, 17, 17, 17, 18, 18, 18, 19, 19, 19,
+	19, 19, 19, 20, 20, 21, 21, 21,
 }
-var jqR2 = [...]int{
 
-	0, 0, 1, 1, 1, 1, 1, 1, 3, 2,
-	2, 2, 1, 3, 2, 3, 5, 4, 4, 4,
-	3, 5, 4, 2, 4, 3, 3, 1, 3, 0,
-	1, 3,
+var jqR2 = [...]int8{
+	0, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 1, 2, 2, 2, 1, 1, 1, 2,
+	2, 1, 1, 2, 1, 2, 3, 4, 3, 4,
+	3, 4, 6, 7, 2, 2, 3, 4, 3, 4,
+	3, 4, 6, 7, 2, 2, 1, 2, 1, 2,
+	3, 1, 2, 2, 3, 2, 3, 3, 1, 3,
+	3, 3, 2, 3, 1, 2, 1, 1, 1, 2,
+	1, 2, 1, 1, 3, 0, 1, 3,
 }
-var jqChk = [...]int{
 
-	-1000, -1, -2, -3, -5, -7, -8, 4, 8, 10,
-	7, -5, -7, -8, 5, 9, -10, -9, -4, 4,
-	-3, 9, 7, -6, 8, 11, 12, 6, 7, -6,
-	7, -5, -7, -8, -4, 5, -10, 4, -4, -3,
-	9, 7, -6, -4,
+var jqChk = [...]int16{
+	-1000, -1, -2, -4, -8, -10, -12, -13, -3, 15,
+	16, 9, 23, 4, 5, -6, 14, -10, -12, -13,
+	-6, -14, 15, -15, 18, 17, 6, 21, -16, 22,
+	-4, -8, -21, -20, -5, -3, -4, -8, 17, 22,
+	17, 17, 19, -7, 14, -11, 16, 9, 6, 22,
+	-14, 24, 26, 8, -7, -6, -14, -6, -6, -17,
+	-18, 13, 19, 25, -19, 5, 6, 21, 7, 4,
+	-5, -9, 15, -14, 15, -15, 18, -5, -9, -14,
+	-21, -3, 20, 11, 12, 10, -17, -17, -7, 6,
+	7, -7, 17, 17, 17, 19, 17, -17, -17, -18,
+	20, -7, -7, -7, -17, -6, 20, 17, -7,
 }
-var jqDef = [...]int{
 
-	1, -2, 2, 3, 4, 5, 6, 7, 0, 29,
-	0, 9, 10, 11, 0, 23, 0, 30, 27, 12,
-	8, 15, 0, 25, 0, 26, 29, 0, 0, 14,
-	0, 17, 18, 19, 24, 0, 31, 28, 13, 16,
-	20, 0, 22, 21,
+var jqDef = [...]int8{
+	1, -2, 2, 3, 4, 5, 6, 7, 10, 22,
+	0, 0, 75, 8, 9, 11, 0, 16, 17, 18,
+	23, 0, 0, 0, 0, 55, 46, 0, 48, 51,
+	34, 35, 0, 76, 73, 12, 14, 15, 26, 52,
+	28, 30, 0, 56, 0, 21, 0, 0, 47, 49,
+	53, 57, 75, 0, 13, 27, 54, 29, 31, 0,
+	58, 0, 0, 64, 66, 67, 68, 0, 70, 72,
+	19, 20, 24, 0, 0, 0, 0, 44, 45, 50,
+	77, 74, 0, 0, 0, 0, 62, 0, 65, 69,
+	71, 25, 36, 38, 40, 0, 32, 60, 61, 59,
+	63, 37, 39, 41, 0, 33, 0, 42, 43,
 }
-var jqTok1 = [...]int{
 
+var jqTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 12, 3, 7, 3, 3, 3,
+	3, 3, 3, 13, 3, 3, 3, 3, 3, 3,
+	19, 20, 15, 3, 26, 21, 14, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 22, 3,
+	3, 3, 3, 18, 25, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 16, 3, 17, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 8, 3, 9, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 10, 3, 11,
+	3, 3, 3, 23, 3, 24,
 }
-var jqTok2 = [...]int{
 
-	2, 3, 4, 5, 6,
+var jqTok2 = [...]int8{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12,
 }
-var jqTok3 = [...]int{
+
+var jqTok3 = [...]int8{
 	0,
 }
 
@@ -216,9 +282,9 @@ func jqErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := jqPact[state]
+	base := int(jqPact[state])
 	for tok := TOKSTART; tok-1 < len(jqToknames); tok++ {
-		if n := base + tok; n >= 0 && n < jqLast && jqChk[jqAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < jqLast && int(jqChk[int(jqAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -228,13 +294,13 @@ func jqErrorMessage(state, lookAhead int) string {
 
 	if jqDef[state] == -2 {
 		i := 0
-		for jqExca[i] != -1 || jqExca[i+1] != state {
+		for jqExca[i] != -1 || int(jqExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; jqExca[i] >= 0; i += 2 {
-			tok := jqExca[i]
+			tok := int(jqExca[i])
 			if tok < TOKSTART || jqExca[i+1] == 0 {
 				continue
 			}
@@ -265,30 +331,30 @@ func jqlex1(lex jqLexer, lval *jqSymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = jqTok1[0]
+		token = int(jqTok1[0])
 		goto out
 	}
 	if char < len(jqTok1) {
-		token = jqTok1[char]
+		token = int(jqTok1[char])
 		goto out
 	}
 	if char >= jqPrivate {
 		if char < jqPrivate+len(jqTok2) {
-			token = jqTok2[char-jqPrivate]
+			token = int(jqTok2[char-jqPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(jqTok3); i += 2 {
-		token = jqTok3[i+0]
+		token = int(jqTok3[i+0])
 		if token == char {
-			token = jqTok3[i+1]
+			token = int(jqTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = jqTok2[1] /* unknown char */
+		token = int(jqTok2[1]) /* unknown char */
 	}
 	if jqDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", jqTokname(token), uint(char))
@@ -343,7 +409,7 @@ jqstack:
 	jqS[jqp].yys = jqstate
 
 jqnewstate:
-	jqn = jqPact[jqstate]
+	jqn = int(jqPact[jqstate])
 	if jqn <= jqFlag {
 		goto jqdefault /* simple state */
 	}
@@ -354,8 +420,8 @@ jqnewstate:
 	if jqn < 0 || jqn >= jqLast {
 		goto jqdefault
 	}
-	jqn = jqAct[jqn]
-	if jqChk[jqn] == jqtoken { /* valid shift */
+	jqn = int(jqAct[jqn])
+	if int(jqChk[jqn]) == jqtoken { /* valid shift */
 		jqrcvr.char = -1
 		jqtoken = -1
 		jqVAL = jqrcvr.lval
@@ -368,7 +434,7 @@ jqnewstate:
 
 jqdefault:
 	/* default state action */
-	jqn = jqDef[jqstate]
+	jqn = int(jqDef[jqstate])
 	if jqn == -2 {
 		if jqrcvr.char < 0 {
 			jqrcvr.char, jqtoken = jqlex1(jqlex, &jqrcvr.lval)
@@ -377,18 +443,18 @@ jqdefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if jqExca[xi+0] == -1 && jqExca[xi+1] == jqstate {
+			if jqExca[xi+0] == -1 && int(jqExca[xi+1]) == jqstate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			jqn = jqExca[xi+0]
+			jqn = int(jqExca[xi+0])
 			if jqn < 0 || jqn == jqtoken {
 				break
 			}
 		}
-		jqn = jqExca[xi+1]
+		jqn = int(jqExca[xi+1])
 		if jqn < 0 {
 			goto ret0
 		}
@@ -410,10 +476,10 @@ jqdefault:
 
 			/* find a state where "error" is a legal shift action */
 			for jqp >= 0 {
-				jqn = jqPact[jqS[jqp].yys] + jqErrCode
+				jqn = int(jqPact[jqS[jqp].yys]) + jqErrCode
 				if jqn >= 0 && jqn < jqLast {
-					jqstate = jqAct[jqn] /* simulate a shift of "error" */
-					if jqChk[jqstate] == jqErrCode {
+					jqstate = int(jqAct[jqn]) /* simulate a shift of "error" */
+					if int(jqChk[jqstate]) == jqErrCode {
 						goto jqstack
 					}
 				}
@@ -449,7 +515,7 @@ jqdefault:
 	jqpt := jqp
 	_ = jqpt // guard against "declared and not used"
 
-	jqp -= jqR2[jqn]
+	jqp -= int(jqR2[jqn])
 	// jqp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if jqp+1 >= len(jqS) {
@@ -460,16 +526,16 @@ jqdefault:
 	jqVAL = jqS[jqp+1]
 
 	/* consult goto table to find next state */
-	jqn = jqR1[jqn]
-	jqg := jqPgo[jqn]
+	jqn = int(jqR1[jqn])
+	jqg := int(jqPgo[jqn])
 	jqj := jqg + jqS[jqp].yys + 1
 
 	if jqj >= jqLast {
-		jqstate = jqAct[jqg]
+		jqstate = int(jqAct[jqg])
 	} else {
-		jqstate = jqAct[jqj]
-		if jqChk[jqstate] != -jqn {
-			jqstate = jqAct[jqg]
+		jqstate = int(jqAct[jqj])
+		if int(jqChk[jqstate]) != -jqn {
+			jqstate = int(jqAct[jqg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -477,197 +543,485 @@ jqdefault:
 
 	case 1:
 		jqDollar = jqS[jqpt-0 : jqpt+1]
-		//line query_parser.y:33
+//line query_parser.y:57
 		{
 			jqVAL.expression = nil
 			jqlex.(*QueryLexer).query = jqVAL.expression
 		}
 	case 2:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:38
+//line query_parser.y:62
 		{
 			jqVAL.expression = jqDollar[1].expression
 			jqlex.(*QueryLexer).query = jqVAL.expression
 		}
 	case 3:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:45
+//line query_parser.y:69
 		{
 			jqVAL.expression = jqDollar[1].element
 		}
 	case 4:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:49
+//line query_parser.y:73
 		{
 			jqVAL.expression = jqDollar[1].expression
 		}
 	case 5:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:53
+//line query_parser.y:77
 		{
 			jqVAL.expression = jqDollar[1].expression
 		}
 	case 6:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:57
+//line query_parser.y:81
 		{
 			jqVAL.expression = jqDollar[1].expression
 		}
 	case 7:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:63
+//line query_parser.y:85
 		{
-			jqVAL.element = Element{Label: jqDollar[1].token.Literal}
+			jqVAL.expression = jqDollar[1].expression
 		}
 	case 8:
-		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:67
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:91
 		{
-			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[3].element}
+			jqVAL.token = jqDollar[1].token
 		}
 	case 9:
-		jqDollar = jqS[jqpt-2 : jqpt+1]
-		//line query_parser.y:71
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:95
 		{
-			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[2].expression}
+			jqVAL.token = jqDollar[1].token
 		}
 	case 10:
-		jqDollar = jqS[jqpt-2 : jqpt+1]
-		//line query_parser.y:75
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:101
 		{
-			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[2].expression}
+			jqVAL.element = Element{Label: jqDollar[1].token.Literal}
 		}
 	case 11:
 		jqDollar = jqS[jqpt-2 : jqpt+1]
-		//line query_parser.y:79
+//line query_parser.y:105
 		{
 			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[2].expression}
 		}
 	case 12:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:85
+//line query_parser.y:111
 		{
 			jqVAL.element = Element{Label: jqDollar[1].token.Literal}
 		}
 	case 13:
-		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:89
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:115
 		{
-			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[3].element}
+			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[2].expression}
 		}
 	case 14:
 		jqDollar = jqS[jqpt-2 : jqpt+1]
-		//line query_parser.y:93
+//line query_parser.y:121
 		{
-			jqVAL.element = Element{Label: jqDollar[1].token.Literal, Child: jqDollar[2].expression}
+			jqVAL.expression = jqDollar[2].element
 		}
 	case 15:
-		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:99
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:125
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i}
+			jqVAL.expression = jqDollar[2].expression
 		}
 	case 16:
-		jqDollar = jqS[jqpt-5 : jqpt+1]
-		//line query_parser.y:104
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:129
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i, Child: jqDollar[5].element}
+			jqVAL.expression = jqDollar[1].expression
 		}
 	case 17:
-		jqDollar = jqS[jqpt-4 : jqpt+1]
-		//line query_parser.y:109
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:133
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i, Child: jqDollar[4].expression}
+			jqVAL.expression = jqDollar[1].expression
 		}
 	case 18:
-		jqDollar = jqS[jqpt-4 : jqpt+1]
-		//line query_parser.y:114
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:137
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i, Child: jqDollar[4].expression}
+			jqVAL.expression = jqDollar[1].expression
 		}
 	case 19:
-		jqDollar = jqS[jqpt-4 : jqpt+1]
-		//line query_parser.y:119
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:143
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i, Child: jqDollar[4].expression}
+			jqVAL.expression = jqDollar[2].element
 		}
 	case 20:
-		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:126
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:147
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i}
+			jqVAL.expression = jqDollar[2].expression
 		}
 	case 21:
-		jqDollar = jqS[jqpt-5 : jqpt+1]
-		//line query_parser.y:131
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:151
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i, Child: jqDollar[5].element}
+			jqVAL.expression = jqDollar[1].expression
 		}
 	case 22:
-		jqDollar = jqS[jqpt-4 : jqpt+1]
-		//line query_parser.y:136
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:157
 		{
-			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
-			jqVAL.expression = ArrayItem{Index: i, Child: jqDollar[4].expression}
+			jqVAL.expression = Wildcard{}
 		}
 	case 23:
 		jqDollar = jqS[jqpt-2 : jqpt+1]
-		//line query_parser.y:143
+//line query_parser.y:161
 		{
-			jqVAL.expression = RowValueExpr{}
+			jqVAL.expression = Wildcard{Child: jqDollar[2].expression}
 		}
 	case 24:
-		jqDollar = jqS[jqpt-4 : jqpt+1]
-		//line query_parser.y:147
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:167
 		{
-			jqVAL.expression = RowValueExpr{Child: jqDollar[4].element}
+			jqVAL.expression = Wildcard{}
 		}
 	case 25:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:171
+		{
+			jqVAL.expression = Wildcard{Child: jqDollar[2].expression}
+		}
+	case 26:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:177
+		{
+			jqVAL.expression = ArrayItem{Index: jqDollar[2].index}
+		}
+	case 27:
+		jqDollar = jqS[jqpt-4 : jqpt+1]
+//line query_parser.y:181
+		{
+			jqVAL.expression = ArrayItem{Index: jqDollar[2].index, Child: jqDollar[4].expression}
+		}
+	case 28:
 		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:151
+//line query_parser.y:185
+		{
+			jqVAL.expression = Wildcard{}
+		}
+	case 29:
+		jqDollar = jqS[jqpt-4 : jqpt+1]
+//line query_parser.y:189
+		{
+			jqVAL.expression = Wildcard{Child: jqDollar[4].expression}
+		}
+	case 30:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:193
+		{
+			jqVAL.expression = jqDollar[2].slice
+		}
+	case 31:
+		jqDollar = jqS[jqpt-4 : jqpt+1]
+//line query_parser.y:197
+		{
+			jqDollar[2].slice.Child = jqDollar[4].expression
+			jqVAL.expression = jqDollar[2].slice
+		}
+	case 32:
+		jqDollar = jqS[jqpt-6 : jqpt+1]
+//line query_parser.y:202
+		{
+			jqVAL.expression = Filter{Condition: jqDollar[4].filter}
+		}
+	case 33:
+		jqDollar = jqS[jqpt-7 : jqpt+1]
+//line query_parser.y:206
+		{
+			jqVAL.expression = Filter{Condition: jqDollar[4].filter, Child: jqDollar[7].expression}
+		}
+	case 34:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:210
+		{
+			jqVAL.expression = RecursiveDescent{Child: jqDollar[2].element}
+		}
+	case 35:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:214
+		{
+			jqVAL.expression = RecursiveDescent{Child: jqDollar[2].expression}
+		}
+	case 36:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:220
+		{
+			jqVAL.expression = ArrayItem{Index: jqDollar[2].index}
+		}
+	case 37:
+		jqDollar = jqS[jqpt-4 : jqpt+1]
+//line query_parser.y:224
+		{
+			jqVAL.expression = ArrayItem{Index: jqDollar[2].index, Child: jqDollar[4].expression}
+		}
+	case 38:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:228
+		{
+			jqVAL.expression = Wildcard{}
+		}
+	case 39:
+		jqDollar = jqS[jqpt-4 : jqpt+1]
+//line query_parser.y:232
+		{
+			jqVAL.expression = Wildcard{Child: jqDollar[4].expression}
+		}
+	case 40:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:236
+		{
+			jqVAL.expression = jqDollar[2].slice
+		}
+	case 41:
+		jqDollar = jqS[jqpt-4 : jqpt+1]
+//line query_parser.y:240
+		{
+			jqDollar[2].slice.Child = jqDollar[4].expression
+			jqVAL.expression = jqDollar[2].slice
+		}
+	case 42:
+		jqDollar = jqS[jqpt-6 : jqpt+1]
+//line query_parser.y:245
+		{
+			jqVAL.expression = Filter{Condition: jqDollar[4].filter}
+		}
+	case 43:
+		jqDollar = jqS[jqpt-7 : jqpt+1]
+//line query_parser.y:249
+		{
+			jqVAL.expression = Filter{Condition: jqDollar[4].filter, Child: jqDollar[7].expression}
+		}
+	case 44:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:253
+		{
+			jqVAL.expression = RecursiveDescent{Child: jqDollar[2].element}
+		}
+	case 45:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:257
+		{
+			jqVAL.expression = RecursiveDescent{Child: jqDollar[2].expression}
+		}
+	case 46:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:263
+		{
+			jqVAL.index, _ = strconv.Atoi(jqDollar[1].token.Literal)
+		}
+	case 47:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:267
+		{
+			i, _ := strconv.Atoi(jqDollar[2].token.Literal)
+			jqVAL.index = -i
+		}
+	case 48:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:274
+		{
+			jqVAL.slice = jqDollar[1].slice
+		}
+	case 49:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:278
+		{
+			jqVAL.slice = jqDollar[1].slice
+		}
+	case 50:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:282
+		{
+			if jqDollar[3].index == 0 {
+				jqlex.(*QueryLexer).err = NewQuerySyntaxError(fmt.Sprintf("column %d: slice step cannot be zero", jqDollar[2].token.Column), jqDollar[2].token)
+			}
+			jqDollar[1].slice.Step = jqDollar[3].index
+			jqVAL.slice = jqDollar[1].slice
+		}
+	case 51:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:292
+		{
+			jqVAL.slice = Slice{}
+		}
+	case 52:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:296
+		{
+			jqVAL.slice = Slice{Start: jqDollar[1].index, HasStart: true}
+		}
+	case 53:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:300
+		{
+			jqVAL.slice = Slice{End: jqDollar[2].index, HasEnd: true}
+		}
+	case 54:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:304
+		{
+			jqVAL.slice = Slice{Start: jqDollar[1].index, End: jqDollar[3].index, HasStart: true, HasEnd: true}
+		}
+	case 55:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:310
+		{
+			jqVAL.expression = RowValueExpr{}
+		}
+	case 56:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:314
 		{
 			jqVAL.expression = RowValueExpr{Child: jqDollar[3].expression}
 		}
-	case 26:
+	case 57:
 		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:157
+//line query_parser.y:320
 		{
 			jqVAL.expression = TableExpr{Fields: jqDollar[2].fields}
 		}
-	case 27:
+	case 58:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:326
+		{
+			jqVAL.filter = jqDollar[1].filter
+		}
+	case 59:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:330
+		{
+			jqVAL.filter = FilterComparison{LHS: jqDollar[1].filter, Operator: jqDollar[2].token.Literal, RHS: jqDollar[3].filter}
+		}
+	case 60:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:334
+		{
+			jqVAL.filter = FilterLogic{LHS: jqDollar[1].filter, Operator: jqDollar[2].token.Literal, RHS: jqDollar[3].filter}
+		}
+	case 61:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:338
+		{
+			jqVAL.filter = FilterLogic{LHS: jqDollar[1].filter, Operator: jqDollar[2].token.Literal, RHS: jqDollar[3].filter}
+		}
+	case 62:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:342
+		{
+			jqVAL.filter = FilterNot{Expr: jqDollar[2].filter}
+		}
+	case 63:
+		jqDollar = jqS[jqpt-3 : jqpt+1]
+//line query_parser.y:346
+		{
+			jqVAL.filter = FilterParentheses{Expr: jqDollar[2].filter}
+		}
+	case 64:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:163
+//line query_parser.y:352
+		{
+			jqVAL.filter = FilterPath{}
+		}
+	case 65:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:356
+		{
+			jqVAL.filter = FilterPath{Path: jqDollar[2].expression}
+		}
+	case 66:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:360
+		{
+			jqVAL.filter = jqDollar[1].filter
+		}
+	case 67:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:366
+		{
+			jqVAL.filter = FilterValue{Value: json.String(jqDollar[1].token.Literal)}
+		}
+	case 68:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:370
+		{
+			i, _ := strconv.ParseInt(jqDollar[1].token.Literal, 10, 64)
+			jqVAL.filter = FilterValue{Value: json.Integer(i)}
+		}
+	case 69:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:375
+		{
+			i, _ := strconv.ParseInt(jqDollar[2].token.Literal, 10, 64)
+			jqVAL.filter = FilterValue{Value: json.Integer(-i)}
+		}
+	case 70:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:380
+		{
+			f, _ := strconv.ParseFloat(jqDollar[1].token.Literal, 64)
+			jqVAL.filter = FilterValue{Value: json.Float(f)}
+		}
+	case 71:
+		jqDollar = jqS[jqpt-2 : jqpt+1]
+//line query_parser.y:385
+		{
+			f, _ := strconv.ParseFloat(jqDollar[2].token.Literal, 64)
+			jqVAL.filter = FilterValue{Value: json.Float(-f)}
+		}
+	case 72:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:390
+		{
+			switch jqDollar[1].token.Literal {
+			case "true":
+				jqVAL.filter = FilterValue{Value: json.Boolean(true)}
+			case "false":
+				jqVAL.filter = FilterValue{Value: json.Boolean(false)}
+			case "null":
+				jqVAL.filter = FilterValue{Value: json.Null{}}
+			default:
+				jqlex.(*QueryLexer).err = NewQuerySyntaxError(fmt.Sprintf("column %d: unexpected token %q", jqDollar[1].token.Column, jqDollar[1].token.Literal), jqDollar[1].token)
+			}
+		}
+	case 73:
+		jqDollar = jqS[jqpt-1 : jqpt+1]
+//line query_parser.y:405
 		{
 			jqVAL.field = FieldExpr{Element: jqDollar[1].element}
 		}
-	case 28:
+	case 74:
 		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:167
+//line query_parser.y:409
 		{
 			jqVAL.field = FieldExpr{Element: jqDollar[1].element, Alias: jqDollar[3].token.Literal}
 		}
-	case 29:
+	case 75:
 		jqDollar = jqS[jqpt-0 : jqpt+1]
-		//line query_parser.y:173
+//line query_parser.y:415
 		{
 			jqVAL.fields = nil
 		}
-	case 30:
+	case 76:
 		jqDollar = jqS[jqpt-1 : jqpt+1]
-		//line query_parser.y:177
+//line query_parser.y:419
 		{
 			jqVAL.fields = []FieldExpr{jqDollar[1].field}
 		}
-	case 31:
+	case 77:
 		jqDollar = jqS[jqpt-3 : jqpt+1]
-		//line query_parser.y:181
+//line query_parser.y:423
 		{
 			jqVAL.fields = append([]FieldExpr{jqDollar[1].field}, jqDollar[3].fields...)
 		}
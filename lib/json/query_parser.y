@@ -1,7 +1,12 @@
 %{
 package json
 
-import "strconv"
+import (
+    "fmt"
+    "strconv"
+
+    "github.com/mithrandie/go-text/json"
+)
 %}
 
 %union{
@@ -9,22 +14,41 @@ import "strconv"
     element    Element
     field      FieldExpr
     fields     []FieldExpr
+    index      int
+    slice      Slice
+    filter     FilterExpression
     token      QueryToken
 }
 
 %type<expression> query
 %type<expression> expression
+%type<token>      label
 %type<element>    element
 %type<element>    single_value_element
-%type<expression> array_item
-%type<expression> single_value_array_item
+%type<expression> child
+%type<expression> single_value_child
+%type<expression> wildcard
+%type<expression> single_value_wildcard
+%type<expression> accessor
+%type<expression> single_value_accessor
 %type<expression> row_value
 %type<expression> table
+%type<index>      index
+%type<slice>      slice
+%type<slice>      slice_range
+%type<filter>     filter_expression
+%type<filter>     filter_operand
+%type<filter>     filter_value
 %type<field>      field
 %type<fields>     fields
 
-%token<token> PATH_IDENTIFIER PATH_INDEX
+%token<token> PATH_IDENTIFIER PATH_STRING PATH_INDEX PATH_NUMBER
 %token<token> AS
+%token<token> DESCENT COMPARISON_OP AND OR
+
+%left OR
+%left AND
+%right '!'
 
 %%
 
@@ -45,7 +69,11 @@ expression
     {
         $$ = $1
     }
-    |  array_item
+    |  wildcard
+    {
+        $$ = $1
+    }
+    |  accessor
     {
         $$ = $1
     }
@@ -58,84 +86,223 @@ expression
         $$ = $1
     }
 
-element
+label
     : PATH_IDENTIFIER
     {
-        $$ = Element{Label: $1.Literal}
+        $$ = $1
+    }
+    | PATH_STRING
+    {
+        $$ = $1
     }
-    | PATH_IDENTIFIER '.' element
+
+element
+    : label
     {
-        $$ = Element{Label: $1.Literal, Child: $3}
+        $$ = Element{Label: $1.Literal}
     }
-    | PATH_IDENTIFIER array_item
+    | label child
     {
         $$ = Element{Label: $1.Literal, Child: $2}
     }
-    | PATH_IDENTIFIER row_value
+
+single_value_element
+    : label
     {
-        $$ = Element{Label: $1.Literal, Child: $2}
+        $$ = Element{Label: $1.Literal}
     }
-    | PATH_IDENTIFIER table
+    | label single_value_child
     {
         $$ = Element{Label: $1.Literal, Child: $2}
     }
 
-single_value_element
-    : PATH_IDENTIFIER
+child
+    : '.' element
     {
-        $$ = Element{Label: $1.Literal}
+        $$ = $2
     }
-    | PATH_IDENTIFIER '.' single_value_element
+    | '.' wildcard
     {
-        $$ = Element{Label: $1.Literal, Child: $3}
+        $$ = $2
     }
-    | PATH_IDENTIFIER single_value_array_item
+    | accessor
     {
-        $$ = Element{Label: $1.Literal, Child: $2}
+        $$ = $1
+    }
+    | row_value
+    {
+        $$ = $1
+    }
+    | table
+    {
+        $$ = $1
     }
 
-array_item
-    : '[' PATH_INDEX ']'
+single_value_child
+    : '.' single_value_element
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i}
+        $$ = $2
     }
-    | '[' PATH_INDEX ']' '.' element
+    | '.' single_value_wildcard
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i, Child: $5}
+        $$ = $2
     }
-    | '[' PATH_INDEX ']' array_item
+    | single_value_accessor
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i, Child: $4}
+        $$ = $1
     }
-    | '[' PATH_INDEX ']' row_value
+
+wildcard
+    : '*'
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i, Child: $4}
+        $$ = Wildcard{}
     }
-    | '[' PATH_INDEX ']' table
+    | '*' child
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i, Child: $4}
+        $$ = Wildcard{Child: $2}
     }
 
-single_value_array_item
-    : '[' PATH_INDEX ']'
+single_value_wildcard
+    : '*'
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i}
+        $$ = Wildcard{}
     }
-    | '[' PATH_INDEX ']' '.' single_value_element
+    | '*' single_value_child
     {
-        i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i, Child: $5}
+        $$ = Wildcard{Child: $2}
+    }
+
+accessor
+    : '[' index ']'
+    {
+        $$ = ArrayItem{Index: $2}
+    }
+    | '[' index ']' child
+    {
+        $$ = ArrayItem{Index: $2, Child: $4}
+    }
+    | '[' '*' ']'
+    {
+        $$ = Wildcard{}
+    }
+    | '[' '*' ']' child
+    {
+        $$ = Wildcard{Child: $4}
+    }
+    | '[' slice ']'
+    {
+        $$ = $2
+    }
+    | '[' slice ']' child
+    {
+        $2.Child = $4
+        $$ = $2
+    }
+    | '[' '?' '(' filter_expression ')' ']'
+    {
+        $$ = Filter{Condition: $4}
+    }
+    | '[' '?' '(' filter_expression ')' ']' child
+    {
+        $$ = Filter{Condition: $4, Child: $7}
+    }
+    | DESCENT element
+    {
+        $$ = RecursiveDescent{Child: $2}
+    }
+    | DESCENT wildcard
+    {
+        $$ = RecursiveDescent{Child: $2}
+    }
+
+single_value_accessor
+    : '[' index ']'
+    {
+        $$ = ArrayItem{Index: $2}
+    }
+    | '[' index ']' single_value_child
+    {
+        $$ = ArrayItem{Index: $2, Child: $4}
+    }
+    | '[' '*' ']'
+    {
+        $$ = Wildcard{}
+    }
+    | '[' '*' ']' single_value_child
+    {
+        $$ = Wildcard{Child: $4}
+    }
+    | '[' slice ']'
+    {
+        $$ = $2
     }
-    | '[' PATH_INDEX ']' single_value_array_item
+    | '[' slice ']' single_value_child
+    {
+        $2.Child = $4
+        $$ = $2
+    }
+    | '[' '?' '(' filter_expression ')' ']'
+    {
+        $$ = Filter{Condition: $4}
+    }
+    | '[' '?' '(' filter_expression ')' ']' single_value_child
+    {
+        $$ = Filter{Condition: $4, Child: $7}
+    }
+    | DESCENT single_value_element
+    {
+        $$ = RecursiveDescent{Child: $2}
+    }
+    | DESCENT single_value_wildcard
+    {
+        $$ = RecursiveDescent{Child: $2}
+    }
+
+index
+    : PATH_INDEX
+    {
+        $$, _ = strconv.Atoi($1.Literal)
+    }
+    | '-' PATH_INDEX
     {
         i, _ := strconv.Atoi($2.Literal)
-        $$ = ArrayItem{Index: i, Child: $4}
+        $$ = -i
+    }
+
+slice
+    : slice_range
+    {
+        $$ = $1
+    }
+    | slice_range ':'
+    {
+        $$ = $1
+    }
+    | slice_range ':' index
+    {
+        if $3 == 0 {
+            jqlex.(*QueryLexer).err = NewQuerySyntaxError(fmt.Sprintf("column %d: slice step cannot be zero", $<token>2.Column), $<token>2)
+        }
+        $1.Step = $3
+        $$ = $1
+    }
+
+slice_range
+    : ':'
+    {
+        $$ = Slice{}
+    }
+    | index ':'
+    {
+        $$ = Slice{Start: $1, HasStart: true}
+    }
+    | ':' index
+    {
+        $$ = Slice{End: $2, HasEnd: true}
+    }
+    | index ':' index
+    {
+        $$ = Slice{Start: $1, End: $3, HasStart: true, HasEnd: true}
     }
 
 row_value
@@ -143,11 +310,7 @@ row_value
     {
         $$ = RowValueExpr{}
     }
-    | '[' ']' '.' single_value_element
-    {
-        $$ = RowValueExpr{Child: $4}
-    }
-    | '[' ']' single_value_array_item
+    | '[' ']' single_value_child
     {
         $$ = RowValueExpr{Child: $3}
     }
@@ -158,12 +321,91 @@ table
         $$ = TableExpr{Fields: $2}
     }
 
+filter_expression
+    : filter_operand
+    {
+        $$ = $1
+    }
+    | filter_operand COMPARISON_OP filter_operand
+    {
+        $$ = FilterComparison{LHS: $1, Operator: $2.Literal, RHS: $3}
+    }
+    | filter_expression AND filter_expression
+    {
+        $$ = FilterLogic{LHS: $1, Operator: $2.Literal, RHS: $3}
+    }
+    | filter_expression OR filter_expression
+    {
+        $$ = FilterLogic{LHS: $1, Operator: $2.Literal, RHS: $3}
+    }
+    | '!' filter_expression
+    {
+        $$ = FilterNot{Expr: $2}
+    }
+    | '(' filter_expression ')'
+    {
+        $$ = FilterParentheses{Expr: $2}
+    }
+
+filter_operand
+    : '@'
+    {
+        $$ = FilterPath{}
+    }
+    | '@' single_value_child
+    {
+        $$ = FilterPath{Path: $2}
+    }
+    | filter_value
+    {
+        $$ = $1
+    }
+
+filter_value
+    : PATH_STRING
+    {
+        $$ = FilterValue{Value: json.String($1.Literal)}
+    }
+    | PATH_INDEX
+    {
+        i, _ := strconv.ParseInt($1.Literal, 10, 64)
+        $$ = FilterValue{Value: json.Integer(i)}
+    }
+    | '-' PATH_INDEX
+    {
+        i, _ := strconv.ParseInt($2.Literal, 10, 64)
+        $$ = FilterValue{Value: json.Integer(-i)}
+    }
+    | PATH_NUMBER
+    {
+        f, _ := strconv.ParseFloat($1.Literal, 64)
+        $$ = FilterValue{Value: json.Float(f)}
+    }
+    | '-' PATH_NUMBER
+    {
+        f, _ := strconv.ParseFloat($2.Literal, 64)
+        $$ = FilterValue{Value: json.Float(-f)}
+    }
+    | PATH_IDENTIFIER
+    {
+        switch $1.Literal {
+        case "true":
+            $$ = FilterValue{Value: json.Boolean(true)}
+        case "false":
+            $$ = FilterValue{Value: json.Boolean(false)}
+        case "null":
+            $$ = FilterValue{Value: json.Null{}}
+        default:
+            jqlex.(*QueryLexer).err = NewQuerySyntaxError(fmt.Sprintf("column %d: unexpected token %q", $1.Column, $1.Literal), $1)
+        }
+    }
+
 field
     : single_value_element
     {
         $$ = FieldExpr{Element: $1}
     }
-    | single_value_element AS PATH_IDENTIFIER
+    | single_value_element AS label
     {
         $$ = FieldExpr{Element: $1, Alias: $3.Literal}
     }
@@ -189,4 +431,4 @@ func ParseQuery(src string) (QueryExpression, error) {
 	l.Init(src)
 	jqParse(l)
 	return l.query, l.err
-}
\ No newline at end of file
+}
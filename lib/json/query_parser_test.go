@@ -3,6 +3,8 @@ package json
 import (
 	"reflect"
 	"testing"
+
+	"github.com/mithrandie/go-text/json"
 )
 
 var parseQueryTests = []struct {
@@ -195,6 +197,128 @@ var parseQueryTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "abc[*].def",
+		Expect: Element{
+			Label: "abc",
+			Child: Wildcard{
+				Child: Element{
+					Label: "def",
+				},
+			},
+		},
+	},
+	{
+		Input: "abc.*",
+		Expect: Element{
+			Label: "abc",
+			Child: Wildcard{},
+		},
+	},
+	{
+		Input: "..id",
+		Expect: RecursiveDescent{
+			Child: Element{
+				Label: "id",
+			},
+		},
+	},
+	{
+		Input: "abc[-1]",
+		Expect: Element{
+			Label: "abc",
+			Child: ArrayItem{
+				Index: -1,
+			},
+		},
+	},
+	{
+		Input: "abc[1:-1]{}",
+		Expect: Element{
+			Label: "abc",
+			Child: Slice{
+				Start:    1,
+				End:      -1,
+				HasStart: true,
+				HasEnd:   true,
+				Child:    TableExpr{},
+			},
+		},
+	},
+	{
+		Input: "[::-2]",
+		Expect: Slice{
+			Step: -2,
+		},
+	},
+	{
+		Input: "abc[?(@.status == 'open' && !(@.total < -1.5) || @.tags)].id",
+		Expect: Element{
+			Label: "abc",
+			Child: Filter{
+				Condition: FilterLogic{
+					LHS: FilterLogic{
+						LHS: FilterComparison{
+							LHS:      FilterPath{Path: Element{Label: "status"}},
+							Operator: "==",
+							RHS:      FilterValue{Value: json.String("open")},
+						},
+						Operator: "&&",
+						RHS: FilterNot{
+							Expr: FilterParentheses{
+								Expr: FilterComparison{
+									LHS:      FilterPath{Path: Element{Label: "total"}},
+									Operator: "<",
+									RHS:      FilterValue{Value: json.Float(-1.5)},
+								},
+							},
+						},
+					},
+					Operator: "||",
+					RHS:      FilterPath{Path: Element{Label: "tags"}},
+				},
+				Child: Element{
+					Label: "id",
+				},
+			},
+		},
+	},
+	{
+		Input: "[?(@ <> null)]",
+		Expect: Filter{
+			Condition: FilterComparison{
+				LHS:      FilterPath{},
+				Operator: "!=",
+				RHS:      FilterValue{Value: json.Null{}},
+			},
+		},
+	},
+	{
+		Input: "{abc[*].def as \"d e f\"}",
+		Expect: TableExpr{
+			Fields: []FieldExpr{
+				{
+					Element: Element{
+						Label: "abc",
+						Child: Wildcard{
+							Child: Element{
+								Label: "def",
+							},
+						},
+					},
+					Alias: "d e f",
+				},
+			},
+		},
+	},
+	{
+		Input: "abc[?(@.def == open)]",
+		Error: "column 16: unexpected token \"open\"",
+	},
+	{
+		Input: "abc[?(@.def = 1 & @.ghi = 2)]",
+		Error: "column 17: unexpected token \"&\"",
+	},
 	{
 		Input: "abc def",
 		Error: "column 5: unexpected token \"def\"",
@@ -207,6 +331,14 @@ var parseQueryTests = []struct {
 		Input: "abc[].def[]",
 		Error: "column 11: unexpected token \"]\"",
 	},
+	{
+		Input: "a[0:2:0]",
+		Error: "column 6: slice step cannot be zero",
+	},
+	{
+		Input: "a[::-0]",
+		Error: "column 4: slice step cannot be zero",
+	},
 	{
 		Input: "abc{}.def",
 		Error: "column 6: unexpected token \".\"",
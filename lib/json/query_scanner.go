@@ -36,6 +36,13 @@ func (s *QueryScanner) peek() rune {
 	return s.src[s.srcPos]
 }
 
+func (s *QueryScanner) peekNext() rune {
+	if len(s.src) <= s.srcPos+1 {
+		return EOF
+	}
+	return s.src[s.srcPos+1]
+}
+
 func (s *QueryScanner) next() rune {
 	ch := s.peek()
 	if ch == EOF {
@@ -81,8 +88,13 @@ func (s *QueryScanner) Scan() (QueryToken, error) {
 	switch {
 	case s.isDecimal(ch):
 		s.scanDecimal()
-		literal = s.literal()
 		token = PATH_INDEX
+		if s.peek() == '.' && s.isDecimal(s.peekNext()) {
+			s.next()
+			s.scanDecimal()
+			token = PATH_NUMBER
+		}
+		literal = s.literal()
 	case s.isIdentRune(ch):
 		s.scanIdentifier()
 		literal = s.literal()
@@ -99,7 +111,52 @@ func (s *QueryScanner) Scan() (QueryToken, error) {
 		case '"', '\'', '`':
 			s.scanString(ch)
 			literal, _ = json.Unescape(s.trimQuotes())
-			token = PATH_IDENTIFIER
+			token = PATH_STRING
+		case '.':
+			if s.peek() == '.' {
+				s.next()
+				literal = s.literal()
+				token = DESCENT
+			}
+		case '=':
+			if s.peek() == '=' {
+				s.next()
+			}
+			literal = "=="
+			token = COMPARISON_OP
+		case '!':
+			if s.peek() == '=' {
+				s.next()
+				literal = s.literal()
+				token = COMPARISON_OP
+			}
+		case '<':
+			if s.peek() == '=' || s.peek() == '>' {
+				s.next()
+			}
+			literal = s.literal()
+			if literal == "<>" {
+				literal = "!="
+			}
+			token = COMPARISON_OP
+		case '>':
+			if s.peek() == '=' {
+				s.next()
+			}
+			literal = s.literal()
+			token = COMPARISON_OP
+		case '&':
+			if s.peek() == '&' {
+				s.next()
+				literal = s.literal()
+				token = AND
+			}
+		case '|':
+			if s.peek() == '|' {
+				s.next()
+				literal = s.literal()
+				token = OR
+			}
 		}
 	}
 
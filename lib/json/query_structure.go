@@ -2,6 +2,8 @@ package json
 
 import (
 	"strconv"
+
+	"github.com/mithrandie/go-text/json"
 )
 
 type QueryExpression interface{}
@@ -12,17 +14,7 @@ type Element struct {
 }
 
 func (e Element) FieldLabel() string {
-	label := EscapeIdentifier(e.Label)
-
-	if e.Child != nil {
-		switch e.Child.(type) {
-		case Element:
-			label = label + string(PathSeparator) + e.Child.(Element).FieldLabel()
-		case ArrayItem:
-			label = label + e.Child.(ArrayItem).FieldLabel()
-		}
-	}
-	return label
+	return EscapeIdentifier(e.Label) + childFieldLabel(e.Child)
 }
 
 type ArrayItem struct {
@@ -31,16 +23,153 @@ type ArrayItem struct {
 }
 
 func (e ArrayItem) FieldLabel() string {
-	label := "[" + strconv.Itoa(e.Index) + "]"
-	if e.Child != nil {
-		switch e.Child.(type) {
-		case Element:
-			label = label + string(PathSeparator) + e.Child.(Element).FieldLabel()
-		case ArrayItem:
-			label = label + e.Child.(ArrayItem).FieldLabel()
-		}
+	return "[" + strconv.Itoa(e.Index) + "]" + childFieldLabel(e.Child)
+}
+
+// Wildcard selects all the elements of an array or all the member values of an object.
+type Wildcard struct {
+	Child QueryExpression
+}
+
+func (e Wildcard) FieldLabel() string {
+	return "[*]" + childFieldLabel(e.Child)
+}
+
+// Slice selects the elements of an array in a range.
+// Negative indices count from the end of the array.
+type Slice struct {
+	Start    int
+	End      int
+	Step     int
+	HasStart bool
+	HasEnd   bool
+	Child    QueryExpression
+}
+
+func (e Slice) FieldLabel() string {
+	label := "["
+	if e.HasStart {
+		label = label + strconv.Itoa(e.Start)
 	}
-	return label
+	label = label + ":"
+	if e.HasEnd {
+		label = label + strconv.Itoa(e.End)
+	}
+	if e.Step != 0 {
+		label = label + ":" + strconv.Itoa(e.Step)
+	}
+	return label + "]" + childFieldLabel(e.Child)
+}
+
+// Filter selects the elements of an array or the member values of an object that satisfy the condition.
+type Filter struct {
+	Condition FilterExpression
+	Child     QueryExpression
+}
+
+func (e Filter) FieldLabel() string {
+	return "[?(" + e.Condition.String() + ")]" + childFieldLabel(e.Child)
+}
+
+// RecursiveDescent applies the child expression to a value and all of its descendants.
+type RecursiveDescent struct {
+	Child QueryExpression
+}
+
+func (e RecursiveDescent) FieldLabel() string {
+	return string(PathSeparator) + childFieldLabel(e.Child)
+}
+
+func childFieldLabel(child QueryExpression) string {
+	switch child.(type) {
+	case Element:
+		return string(PathSeparator) + child.(Element).FieldLabel()
+	case ArrayItem:
+		return child.(ArrayItem).FieldLabel()
+	case Wildcard:
+		return child.(Wildcard).FieldLabel()
+	case Slice:
+		return child.(Slice).FieldLabel()
+	case Filter:
+		return child.(Filter).FieldLabel()
+	case RecursiveDescent:
+		return child.(RecursiveDescent).FieldLabel()
+	}
+	return ""
+}
+
+// IsDefinite returns whether the expression selects at most one value.
+func IsDefinite(expr QueryExpression) bool {
+	switch expr.(type) {
+	case Element:
+		return IsDefinite(expr.(Element).Child)
+	case ArrayItem:
+		return IsDefinite(expr.(ArrayItem).Child)
+	case Wildcard, Slice, Filter, RecursiveDescent:
+		return false
+	}
+	return true
+}
+
+type FilterExpression interface {
+	String() string
+}
+
+// FilterPath refers to the current value in a filter.
+// If Path is nil, the current value itself is referred.
+type FilterPath struct {
+	Path QueryExpression
+}
+
+func (e FilterPath) String() string {
+	if e.Path == nil {
+		return "@"
+	}
+	return "@" + childFieldLabel(e.Path)
+}
+
+type FilterValue struct {
+	Value json.Structure
+}
+
+func (e FilterValue) String() string {
+	return e.Value.Encode()
+}
+
+type FilterComparison struct {
+	LHS      FilterExpression
+	Operator string
+	RHS      FilterExpression
+}
+
+func (e FilterComparison) String() string {
+	return e.LHS.String() + " " + e.Operator + " " + e.RHS.String()
+}
+
+type FilterLogic struct {
+	LHS      FilterExpression
+	Operator string
+	RHS      FilterExpression
+}
+
+func (e FilterLogic) String() string {
+	return e.LHS.String() + " " + e.Operator + " " + e.RHS.String()
+}
+
+type FilterNot struct {
+	Expr FilterExpression
+}
+
+func (e FilterNot) String() string {
+	return "!" + e.Expr.String()
+}
+
+type FilterParentheses struct {
+	Expr FilterExpression
+}
+
+func (e FilterParentheses) String() string {
+	return "(" + e.Expr.String() + ")"
 }
 
 type RowValueExpr struct {
@@ -2,6 +2,8 @@ package json
 
 import (
 	"testing"
+
+	"github.com/mithrandie/go-text/json"
 )
 
 var elementFieldLabelTests = []struct {
@@ -38,6 +40,41 @@ var elementFieldLabelTests = []struct {
 		},
 		Expect: "key[2]",
 	},
+	{
+		Element: Element{
+			Label: "key",
+			Child: Wildcard{
+				Child: Slice{
+					Start:    1,
+					HasStart: true,
+					Step:     2,
+					Child: RecursiveDescent{
+						Child: Element{
+							Label: "id",
+						},
+					},
+				},
+			},
+		},
+		Expect: "key[*][1::2]..id",
+	},
+	{
+		Element: Element{
+			Label: "key",
+			Child: Filter{
+				Condition: FilterLogic{
+					LHS: FilterComparison{
+						LHS:      FilterPath{Path: Element{Label: "a"}},
+						Operator: "==",
+						RHS:      FilterValue{Value: json.String("x")},
+					},
+					Operator: "||",
+					RHS:      FilterNot{Expr: FilterPath{Path: ArrayItem{Index: 0}}},
+				},
+			},
+		},
+		Expect: "key[?(@.a == \"x\" || !@[0])]",
+	},
 }
 
 func TestElement_FieldLabel(t *testing.T) {
@@ -25,6 +25,41 @@ var loadValueTests = []struct {
 		Json:  "{\"key\":\"value\"}",
 		Error: "column 4: string not terminated",
 	},
+	{
+		Query:  "items[*].sku",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("[\"a\",\"b\",\"c\"]"),
+	},
+	{
+		Query:  "..sku",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("[\"a\",\"b\",\"s\",\"c\"]"),
+	},
+	{
+		Query:  "items[-1].sku",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("c"),
+	},
+	{
+		Query:  "items[::-2].qty",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("[1,2]"),
+	},
+	{
+		Query:  "items[?(@.qty >= 2 && @.sku != 'a')].sku",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("[\"b\"]"),
+	},
+	{
+		Query:  "items[?(@.sub)].sub.sku",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("[\"s\"]"),
+	},
+	{
+		Query:  "items[?(@.qty > 10)]",
+		Json:   "{\"items\":[{\"sku\":\"a\",\"qty\":2},{\"sku\":\"b\",\"qty\":5,\"sub\":{\"sku\":\"s\"}},{\"sku\":\"c\",\"qty\":1}]}",
+		Expect: value.NewString("[]"),
+	},
 	{
 		Query: "key",
 		Json:  "{\"key\":\"valu",
@@ -124,6 +159,30 @@ var loadTableTests = []struct {
 			},
 		},
 	},
+	{
+		Query:        "key[?(@.key2 > 2)]",
+		Json:         "{\"key\":[{\"key2\":2, \"key3\": 3}, {\"key2\":4, \"key3\": 5}]}",
+		ExpectHeader: []string{"key2", "key3"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewInteger(4),
+				value.NewInteger(5),
+			},
+		},
+	},
+	{
+		Query:        "key[*]{key3}",
+		Json:         "{\"key\":[{\"key2\":2, \"key3\": 3}, {\"key2\":4, \"key3\": 5}]}",
+		ExpectHeader: []string{"key3"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewInteger(3),
+			},
+			{
+				value.NewInteger(5),
+			},
+		},
+	},
 	{
 		Query: "notexist{}",
 		Json:  "{\"key\":[{\"key2\":2, \"key3\": 3}]}",
@@ -163,6 +222,58 @@ func TestLoadTable(t *testing.T) {
 	}
 }
 
+var decodeTests = []struct {
+	Json   string
+	Expect json.Structure
+	Error  string
+}{
+	{
+		Json:   "{\"key\":1}",
+		Expect: json.Object{Members: []json.ObjectMember{{Key: "key", Value: json.Integer(1)}}},
+	},
+	{
+		Json:   "1",
+		Expect: json.Integer(1),
+	},
+	{
+		Json:   " 1.5 ",
+		Expect: json.Float(1.5),
+	},
+	{
+		Json:   "\"str\"",
+		Expect: json.String("str"),
+	},
+	{
+		Json:   "null",
+		Expect: json.Null{},
+	},
+	{
+		Json:  "1, 2",
+		Error: "line 1, column 1: unexpected token \"1\"",
+	},
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range decodeTests {
+		result, _, err := Decode(v.Json)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err.Error(), v.Json)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err, v.Error, v.Json)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Json)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %q", result, v.Expect, v.Json)
+		}
+	}
+}
+
 var extractTests = []struct {
 	Query  QueryExpression
 	Data   json.Structure
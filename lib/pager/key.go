@@ -0,0 +1,127 @@
+package pager
+
+import (
+	"bufio"
+)
+
+type KeyCode int
+
+const (
+	KeyRune KeyCode = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyPageUp
+	KeyPageDown
+	KeyHome
+	KeyEnd
+	KeyTab
+	KeyBacktab
+	KeyEnter
+	KeyEscape
+	KeyBackspace
+	KeyInterrupt
+	KeyUnknown
+)
+
+const (
+	charInterrupt = 3
+	charCtrlB     = 2
+	charCtrlF     = 6
+	charTab       = 9
+	charLF        = 10
+	charCR        = 13
+	charEscape    = 27
+	charBackspace = 8
+	charDelete    = 127
+)
+
+type Key struct {
+	Code KeyCode
+	Rune rune
+}
+
+// ReadKey reads one key stroke from the reader that receives the input of a terminal in raw mode.
+//
+// An escape character that is not followed by any buffered bytes is regarded as the escape key,
+// otherwise it is parsed as the beginning of an escape sequence.
+func ReadKey(r *bufio.Reader) (Key, error) {
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch c {
+	case charInterrupt:
+		return Key{Code: KeyInterrupt}, nil
+	case charCtrlB:
+		return Key{Code: KeyPageUp}, nil
+	case charCtrlF:
+		return Key{Code: KeyPageDown}, nil
+	case charTab:
+		return Key{Code: KeyTab}, nil
+	case charCR, charLF:
+		return Key{Code: KeyEnter}, nil
+	case charBackspace, charDelete:
+		return Key{Code: KeyBackspace}, nil
+	case charEscape:
+		if r.Buffered() < 1 {
+			return Key{Code: KeyEscape}, nil
+		}
+		return readEscapeSequence(r)
+	}
+	return Key{Code: KeyRune, Rune: c}, nil
+}
+
+func readEscapeSequence(r *bufio.Reader) (Key, error) {
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+	if c != '[' && c != 'O' {
+		return Key{Code: KeyUnknown}, nil
+	}
+
+	params := make([]rune, 0, 4)
+	for {
+		c, _, err = r.ReadRune()
+		if err != nil {
+			return Key{}, err
+		}
+		if ('0' <= c && c <= '9') || c == ';' {
+			params = append(params, c)
+			continue
+		}
+		break
+	}
+
+	switch c {
+	case 'A':
+		return Key{Code: KeyUp}, nil
+	case 'B':
+		return Key{Code: KeyDown}, nil
+	case 'C':
+		return Key{Code: KeyRight}, nil
+	case 'D':
+		return Key{Code: KeyLeft}, nil
+	case 'H':
+		return Key{Code: KeyHome}, nil
+	case 'F':
+		return Key{Code: KeyEnd}, nil
+	case 'Z':
+		return Key{Code: KeyBacktab}, nil
+	case '~':
+		switch string(params) {
+		case "1", "7":
+			return Key{Code: KeyHome}, nil
+		case "4", "8":
+			return Key{Code: KeyEnd}, nil
+		case "5":
+			return Key{Code: KeyPageUp}, nil
+		case "6":
+			return Key{Code: KeyPageDown}, nil
+		}
+	}
+	return Key{Code: KeyUnknown}, nil
+}
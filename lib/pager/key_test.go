@@ -0,0 +1,91 @@
+package pager
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+)
+
+var readKeyTests = []struct {
+	Input  string
+	Expect []Key
+}{
+	{
+		Input: "aあ",
+		Expect: []Key{
+			{Code: KeyRune, Rune: 'a'},
+			{Code: KeyRune, Rune: 'あ'},
+		},
+	},
+	{
+		Input: "\x03\x02\x06\t\r\n\x7f",
+		Expect: []Key{
+			{Code: KeyInterrupt},
+			{Code: KeyPageUp},
+			{Code: KeyPageDown},
+			{Code: KeyTab},
+			{Code: KeyEnter},
+			{Code: KeyEnter},
+			{Code: KeyBackspace},
+		},
+	},
+	{
+		Input: "\033[A\033[B\033[C\033[D\033OH\033OF\033[Z",
+		Expect: []Key{
+			{Code: KeyUp},
+			{Code: KeyDown},
+			{Code: KeyRight},
+			{Code: KeyLeft},
+			{Code: KeyHome},
+			{Code: KeyEnd},
+			{Code: KeyBacktab},
+		},
+	},
+	{
+		Input: "\033[1~\033[4~\033[5~\033[6~\033[2~\033[1;5A",
+		Expect: []Key{
+			{Code: KeyHome},
+			{Code: KeyEnd},
+			{Code: KeyPageUp},
+			{Code: KeyPageDown},
+			{Code: KeyUnknown},
+			{Code: KeyUp},
+		},
+	},
+	{
+		Input: "\033",
+		Expect: []Key{
+			{Code: KeyEscape},
+		},
+	},
+}
+
+func TestReadKey(t *testing.T) {
+	for _, v := range readKeyTests {
+		r := bufio.NewReader(strings.NewReader(v.Input))
+
+		result := make([]Key, 0, len(v.Expect))
+		for {
+			key, err := ReadKey(r)
+			if err != nil {
+				if err != io.EOF {
+					t.Errorf("%q: unexpected error %q", v.Input, err)
+				}
+				break
+			}
+			result = append(result, key)
+		}
+
+		if len(result) != len(v.Expect) {
+			t.Errorf("%q: result = %v, want %v", v.Input, result, v.Expect)
+			continue
+		}
+		for i := range result {
+			if result[i] != v.Expect[i] {
+				t.Errorf("%q: result = %v, want %v", v.Input, result, v.Expect)
+				break
+			}
+		}
+	}
+}
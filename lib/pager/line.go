@@ -0,0 +1,87 @@
+package pager
+
+import (
+	"strings"
+	"unicode"
+)
+
+const resetSequence = "\033[0m"
+
+// StripEscapeSequences removes ANSI escape sequences from a string.
+func StripEscapeSequences(s string) string {
+	if strings.IndexByte(s, charEscape) < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	inEscSeq := false
+	for _, r := range s {
+		if inEscSeq {
+			if unicode.IsLetter(r) {
+				inEscSeq = false
+			}
+			continue
+		}
+		if r == charEscape {
+			inEscSeq = true
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Slice returns the part of a line that is displayed from the column offset with the width.
+//
+// ANSI escape sequences are kept regardless of the displayed range so that the effects of the characters are preserved.
+// Wide characters that straddle the edges of the range are replaced with spaces.
+func Slice(s string, offset int, width int, runeWidth func(rune) int) string {
+	var b strings.Builder
+
+	pos := 0
+	inEscSeq := false
+	hasEscSeq := false
+	for _, r := range s {
+		if inEscSeq {
+			b.WriteRune(r)
+			if unicode.IsLetter(r) {
+				inEscSeq = false
+			}
+			continue
+		}
+		if r == charEscape {
+			b.WriteRune(r)
+			inEscSeq = true
+			hasEscSeq = true
+			continue
+		}
+
+		w := runeWidth(r)
+		switch {
+		case pos+w <= offset:
+		case offset+width <= pos:
+		case pos < offset || offset+width < pos+w:
+			from := pos
+			if from < offset {
+				from = offset
+			}
+			to := pos + w
+			if offset+width < to {
+				to = offset + width
+			}
+			b.WriteString(strings.Repeat(" ", to-from))
+		default:
+			b.WriteRune(r)
+		}
+		pos = pos + w
+
+		if offset+width <= pos && !hasEscSeq {
+			break
+		}
+	}
+
+	if hasEscSeq {
+		b.WriteString(resetSequence)
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package pager
+
+import (
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+func testRuneWidth(r rune) int {
+	return text.RuneWidth(r, false, false, false)
+}
+
+var stripEscapeSequencesTests = []struct {
+	Input  string
+	Expect string
+}{
+	{
+		Input:  "abc",
+		Expect: "abc",
+	},
+	{
+		Input:  "\033[34;1mabc\033[0m def",
+		Expect: "abc def",
+	},
+}
+
+func TestStripEscapeSequences(t *testing.T) {
+	for _, v := range stripEscapeSequencesTests {
+		result := StripEscapeSequences(v.Input)
+		if result != v.Expect {
+			t.Errorf("%q: result = %q, want %q", v.Input, result, v.Expect)
+		}
+	}
+}
+
+var sliceTests = []struct {
+	Input  string
+	Offset int
+	Width  int
+	Expect string
+}{
+	{
+		Input:  "abcdefg",
+		Offset: 2,
+		Width:  3,
+		Expect: "cde",
+	},
+	{
+		Input:  "abc",
+		Offset: 5,
+		Width:  3,
+		Expect: "",
+	},
+	{
+		Input:  "aあいう",
+		Offset: 2,
+		Width:  4,
+		Expect: " い ",
+	},
+	{
+		Input:  "\033[34mabc\033[0mdef",
+		Offset: 1,
+		Width:  3,
+		Expect: "\033[34mbc\033[0md\033[0m",
+	},
+}
+
+func TestSlice(t *testing.T) {
+	for _, v := range sliceTests {
+		result := Slice(v.Input, v.Offset, v.Width, testRuneWidth)
+		if result != v.Expect {
+			t.Errorf("%q offset %d width %d: result = %q, want %q", v.Input, v.Offset, v.Width, result, v.Expect)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package pager
+
+import (
+	"bufio"
+	"io"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	enterAlternateScreen = "\033[?1049h\033[?25l"
+	leaveAlternateScreen = "\033[?25h\033[?1049l"
+)
+
+// Run displays the viewer on the terminal referred to by fd until the user quits.
+//
+// The terminal is put into raw mode while the viewer is displayed, and restored before returning.
+func Run(v *Viewer, in io.Reader, out io.Writer, fd int) (err error) {
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := terminal.Restore(fd, state); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	if _, err = io.WriteString(out, enterAlternateScreen); err != nil {
+		return err
+	}
+	defer func() {
+		if _, e := io.WriteString(out, leaveAlternateScreen); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	r := bufio.NewReader(in)
+	for {
+		if w, h, e := terminal.GetSize(fd); e == nil {
+			v.SetSize(w, h)
+		}
+		if _, err = io.WriteString(out, v.Render()); err != nil {
+			return err
+		}
+
+		key, e := ReadKey(r)
+		if e != nil {
+			if e == io.EOF {
+				return nil
+			}
+			return e
+		}
+		if v.HandleKey(key) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,433 @@
+package pager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mithrandie/go-text"
+)
+
+const (
+	maxHeaderLines      = 20
+	horizontalScrollLen = 4
+	expandedRecordTitle = "[ RECORD "
+)
+
+// Viewer holds the state of a scrollable view of lines.
+//
+// The first lines specified as the header stay at the top of the screen while the other lines are scrolled.
+type Viewer struct {
+	EastAsianEncoding    bool
+	CountDiacriticalSign bool
+	CountFormatCode      bool
+
+	lines       []string
+	plainLines  []string
+	headerLines int
+	columnStops []int
+	lineWidth   int
+
+	width  int
+	height int
+	row    int
+	col    int
+
+	searching  bool
+	query      []rune
+	lastQuery  string
+	matchLine  int
+	originRow  int
+	originCol  int
+	statusText string
+}
+
+func NewViewer(lines []string, headerLines int) *Viewer {
+	if len(lines) < headerLines {
+		headerLines = len(lines)
+	}
+
+	v := &Viewer{
+		lines:       lines,
+		plainLines:  make([]string, len(lines)),
+		headerLines: headerLines,
+		width:       80,
+		height:      24,
+		row:         headerLines,
+		matchLine:   -1,
+	}
+
+	for i := range lines {
+		v.plainLines[i] = StripEscapeSequences(lines[i])
+	}
+	return v
+}
+
+func (v *Viewer) runeWidth(r rune) int {
+	return text.RuneWidth(r, v.EastAsianEncoding, v.CountDiacriticalSign, v.CountFormatCode)
+}
+
+func (v *Viewer) textWidth(s string) int {
+	return text.Width(s, v.EastAsianEncoding, v.CountDiacriticalSign, v.CountFormatCode)
+}
+
+// SetSize sets the size of the screen and adjusts the scroll positions.
+func (v *Viewer) SetSize(width int, height int) {
+	if width < 1 {
+		width = 1
+	}
+	if height < 2 {
+		height = 2
+	}
+	v.width = width
+	v.height = height
+
+	if v.lineWidth < 1 {
+		for _, s := range v.plainLines {
+			if w := v.textWidth(s); v.lineWidth < w {
+				v.lineWidth = w
+			}
+		}
+		if 0 < v.headerLines {
+			v.columnStops = v.detectColumnStops(v.plainLines[v.headerLines-1])
+		}
+	}
+
+	v.row = v.clampRow(v.row)
+	v.col = v.clampCol(v.col)
+}
+
+func (v *Viewer) frozenLines() int {
+	if v.height-2 <= v.headerLines {
+		return 0
+	}
+	return v.headerLines
+}
+
+func (v *Viewer) bodyHeight() int {
+	return v.height - 1 - v.frozenLines()
+}
+
+func (v *Viewer) clampRow(row int) int {
+	max := len(v.lines) - v.bodyHeight()
+	if max < v.frozenLines() {
+		max = v.frozenLines()
+	}
+	if max < row {
+		row = max
+	}
+	if row < v.frozenLines() {
+		row = v.frozenLines()
+	}
+	return row
+}
+
+func (v *Viewer) clampCol(col int) int {
+	max := v.lineWidth - v.width
+	if max < 0 {
+		max = 0
+	}
+	if max < col {
+		col = max
+	}
+	if col < 0 {
+		col = 0
+	}
+	return col
+}
+
+// NeedsPaging returns whether the lines do not fit on the screen.
+func NeedsPaging(lines []string, height int) bool {
+	return height-1 < len(lines)
+}
+
+// HandleKey changes the state of the viewer by a key stroke.
+// The return value is true if the key quits the viewer.
+func (v *Viewer) HandleKey(key Key) bool {
+	if v.searching {
+		v.handleSearchKey(key)
+		return false
+	}
+
+	v.statusText = ""
+
+	switch key.Code {
+	case KeyInterrupt, KeyEscape:
+		return true
+	case KeyUp:
+		v.row = v.clampRow(v.row - 1)
+	case KeyDown, KeyEnter:
+		v.row = v.clampRow(v.row + 1)
+	case KeyPageUp:
+		v.row = v.clampRow(v.row - v.bodyHeight())
+	case KeyPageDown:
+		v.row = v.clampRow(v.row + v.bodyHeight())
+	case KeyHome:
+		v.row = v.clampRow(0)
+	case KeyEnd:
+		v.row = v.clampRow(len(v.lines))
+	case KeyLeft:
+		v.col = v.clampCol(v.col - horizontalScrollLen)
+	case KeyRight:
+		v.col = v.clampCol(v.col + horizontalScrollLen)
+	case KeyTab:
+		v.col = v.clampCol(v.nextColumnStop())
+	case KeyBacktab:
+		v.col = v.clampCol(v.previousColumnStop())
+	case KeyRune:
+		switch key.Rune {
+		case 'q', 'Q':
+			return true
+		case 'k':
+			return v.HandleKey(Key{Code: KeyUp})
+		case 'j':
+			return v.HandleKey(Key{Code: KeyDown})
+		case 'b':
+			return v.HandleKey(Key{Code: KeyPageUp})
+		case 'f', ' ':
+			return v.HandleKey(Key{Code: KeyPageDown})
+		case 'g':
+			return v.HandleKey(Key{Code: KeyHome})
+		case 'G':
+			return v.HandleKey(Key{Code: KeyEnd})
+		case 'h':
+			return v.HandleKey(Key{Code: KeyLeft})
+		case 'l':
+			return v.HandleKey(Key{Code: KeyRight})
+		case '>':
+			return v.HandleKey(Key{Code: KeyTab})
+		case '<':
+			return v.HandleKey(Key{Code: KeyBacktab})
+		case '0', '^':
+			v.col = 0
+		case '$':
+			v.col = v.clampCol(v.lineWidth)
+		case '/':
+			v.searching = true
+			v.query = v.query[:0]
+			v.originRow = v.row
+			v.originCol = v.col
+		case 'n':
+			v.searchNext(true)
+		case 'N':
+			v.searchNext(false)
+		}
+	}
+	return false
+}
+
+func (v *Viewer) handleSearchKey(key Key) {
+	switch key.Code {
+	case KeyEnter:
+		v.searching = false
+		if 0 < len(v.query) {
+			v.lastQuery = string(v.query)
+		}
+		return
+	case KeyInterrupt, KeyEscape:
+		v.cancelSearch()
+		return
+	case KeyBackspace:
+		if len(v.query) < 1 {
+			v.cancelSearch()
+			return
+		}
+		v.query = v.query[:len(v.query)-1]
+	case KeyRune:
+		v.query = append(v.query, key.Rune)
+	default:
+		return
+	}
+
+	v.row = v.originRow
+	v.col = v.originCol
+	v.matchLine = -1
+	if 0 < len(v.query) {
+		v.search(string(v.query), v.originRow, true)
+	}
+}
+
+func (v *Viewer) cancelSearch() {
+	v.searching = false
+	v.row = v.originRow
+	v.col = v.originCol
+}
+
+func (v *Viewer) searchNext(forward bool) {
+	if len(v.lastQuery) < 1 {
+		return
+	}
+
+	start := v.row
+	if 0 <= v.matchLine {
+		if forward {
+			start = v.matchLine + 1
+		} else {
+			start = v.matchLine - 1
+		}
+	}
+	v.search(v.lastQuery, start, forward)
+}
+
+func (v *Viewer) search(query string, start int, forward bool) {
+	query = strings.ToLower(query)
+
+	for i := start; v.frozenLines() <= i && i < len(v.lines); {
+		s := strings.ToLower(v.plainLines[i])
+		if idx := strings.Index(s, query); 0 <= idx {
+			v.matchLine = i
+			v.row = v.clampRow(i)
+
+			matchCol := v.textWidth(s[:idx])
+			if matchCol < v.col || v.col+v.width < matchCol+v.textWidth(query) {
+				v.col = v.clampCol(matchCol - v.width/2)
+			}
+			return
+		}
+
+		if forward {
+			i++
+		} else {
+			i--
+		}
+	}
+	v.statusText = fmt.Sprintf("Pattern not found: %s", query)
+}
+
+func (v *Viewer) nextColumnStop() int {
+	for _, stop := range v.columnStops {
+		if v.col < stop {
+			return stop
+		}
+	}
+	if len(v.columnStops) < 1 {
+		return v.col + v.width/2
+	}
+	return v.lineWidth
+}
+
+func (v *Viewer) previousColumnStop() int {
+	for i := len(v.columnStops) - 1; 0 <= i; i-- {
+		if v.columnStops[i] < v.col {
+			return v.columnStops[i]
+		}
+	}
+	if len(v.columnStops) < 1 {
+		return v.col - v.width/2
+	}
+	return 0
+}
+
+func (v *Viewer) detectColumnStops(rule string) []int {
+	if !isRule(rule) {
+		return nil
+	}
+
+	stops := make([]int, 0, 10)
+	pos := 0
+	prevIsFill := false
+	for _, r := range rule {
+		isFill := isFillChar(r)
+		if isFill && !prevIsFill {
+			stop := pos - 1
+			if stop < 0 {
+				stop = 0
+			}
+			stops = append(stops, stop)
+		}
+		prevIsFill = isFill
+		pos = pos + v.runeWidth(r)
+	}
+	return stops
+}
+
+// Render returns the sequence that draws the whole screen.
+func (v *Viewer) Render() string {
+	var b strings.Builder
+	b.WriteString("\033[H")
+
+	frozen := v.frozenLines()
+	for i := 0; i < frozen; i++ {
+		v.writeLine(&b, v.lines[i])
+	}
+	for i := 0; i < v.bodyHeight(); i++ {
+		if idx := v.row + i; idx < len(v.lines) {
+			v.writeLine(&b, v.lines[idx])
+		} else {
+			v.writeLine(&b, "")
+		}
+	}
+
+	b.WriteString("\033[7m")
+	b.WriteString(Slice(v.status(), 0, v.width, v.runeWidth))
+	b.WriteString(resetSequence)
+	b.WriteString("\033[K")
+	return b.String()
+}
+
+func (v *Viewer) writeLine(b *strings.Builder, s string) {
+	b.WriteString(Slice(s, v.col, v.width, v.runeWidth))
+	b.WriteString("\033[K\r\n")
+}
+
+func (v *Viewer) status() string {
+	if v.searching {
+		return "/" + string(v.query)
+	}
+	if 0 < len(v.statusText) {
+		return v.statusText
+	}
+
+	last := v.row + v.bodyHeight()
+	if len(v.lines) < last {
+		last = len(v.lines)
+	}
+	return fmt.Sprintf("lines %d-%d/%d  column %d/%d  (q:quit  /:search  n/N:next/prev match  Tab/Shift+Tab:next/prev column)", v.row+1, last, len(v.lines), v.col+1, v.lineWidth)
+}
+
+// DetectHeaderLines returns the number of lines that compose the header of a text table.
+// The header is regarded as ending with the first ruled line that follows some other lines.
+func DetectHeaderLines(lines []string) int {
+	if 0 < len(lines) {
+		if s := StripEscapeSequences(lines[0]); 0 < len(s) && strings.HasPrefix(strings.TrimLeftFunc(s, isFillChar), expandedRecordTitle) {
+			return 0
+		}
+	}
+
+	hasContents := false
+	for i := 0; i < len(lines) && i < maxHeaderLines; i++ {
+		if isRule(StripEscapeSequences(lines[i])) {
+			if hasContents {
+				return i + 1
+			}
+		} else {
+			hasContents = true
+		}
+	}
+	return 0
+}
+
+func isFillChar(r rune) bool {
+	switch r {
+	case '-', '─', '═':
+		return true
+	}
+	return false
+}
+
+func isRule(s string) bool {
+	hasFill := false
+	for _, r := range s {
+		if isFillChar(r) {
+			hasFill = true
+			continue
+		}
+		switch r {
+		case ' ', '+', '|', ':',
+			'│', '┌', '┬', '┐', '├', '┼', '┤', '└', '┴', '┘',
+			'║', '╔', '╦', '╗', '╠', '╬', '╣', '╚', '╩', '╝':
+		default:
+			return false
+		}
+	}
+	return hasFill
+}
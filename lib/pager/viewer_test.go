@@ -0,0 +1,233 @@
+package pager
+
+import (
+	"fmt"
+	"testing"
+)
+
+func viewerTestLines() []string {
+	lines := []string{
+		"+----+----------------------+",
+		"| c1 |          c2          |",
+		"+----+----------------------+",
+	}
+	for i := 1; i <= 20; i++ {
+		lines = append(lines, fmt.Sprintf("| %2d | %-20s |", i, fmt.Sprintf("value%d", i)))
+	}
+	return append(lines, "+----+----------------------+")
+}
+
+var detectHeaderLinesTests = []struct {
+	Name   string
+	Lines  []string
+	Expect int
+}{
+	{
+		Name:   "Text Table",
+		Lines:  viewerTestLines(),
+		Expect: 3,
+	},
+	{
+		Name: "Minimal Style",
+		Lines: []string{
+			"c1  c2",
+			"--  --",
+			" 1  a",
+		},
+		Expect: 2,
+	},
+	{
+		Name: "Single Line Border with Escape Sequences",
+		Lines: []string{
+			"┌────┐",
+			"│ \033[34mc1\033[0m │",
+			"├────┤",
+			"│  1 │",
+			"└────┘",
+		},
+		Expect: 3,
+	},
+	{
+		Name: "Expanded Format",
+		Lines: []string{
+			"-[ RECORD 1 ]",
+			"c1 | 1",
+			"   | ---",
+		},
+		Expect: 0,
+	},
+	{
+		Name: "No Rule",
+		Lines: []string{
+			"c1,c2",
+			"1,a",
+		},
+		Expect: 0,
+	},
+}
+
+func TestDetectHeaderLines(t *testing.T) {
+	for _, v := range detectHeaderLinesTests {
+		result := DetectHeaderLines(v.Lines)
+		if result != v.Expect {
+			t.Errorf("%s: result = %d, want %d", v.Name, result, v.Expect)
+		}
+	}
+}
+
+var viewerHandleKeyTests = []struct {
+	Name       string
+	Keys       []Key
+	ExpectRow  int
+	ExpectCol  int
+	ExpectQuit bool
+}{
+	{
+		Name:      "Initial Position",
+		ExpectRow: 3,
+	},
+	{
+		Name:      "Scroll Down",
+		Keys:      []Key{{Code: KeyDown}, {Code: KeyRune, Rune: 'j'}, {Code: KeyEnter}},
+		ExpectRow: 6,
+	},
+	{
+		Name:      "Scroll Up at the Top",
+		Keys:      []Key{{Code: KeyUp}},
+		ExpectRow: 3,
+	},
+	{
+		Name:      "Page Down",
+		Keys:      []Key{{Code: KeyRune, Rune: ' '}},
+		ExpectRow: 9,
+	},
+	{
+		Name:      "Page Up",
+		Keys:      []Key{{Code: KeyPageDown}, {Code: KeyPageDown}, {Code: KeyRune, Rune: 'b'}},
+		ExpectRow: 9,
+	},
+	{
+		Name:      "Go to the Last Line",
+		Keys:      []Key{{Code: KeyRune, Rune: 'G'}},
+		ExpectRow: 18,
+	},
+	{
+		Name:      "Go to the First Line",
+		Keys:      []Key{{Code: KeyEnd}, {Code: KeyRune, Rune: 'g'}},
+		ExpectRow: 3,
+	},
+	{
+		Name:      "Scroll Right",
+		Keys:      []Key{{Code: KeyRight}, {Code: KeyRune, Rune: 'l'}},
+		ExpectRow: 3,
+		ExpectCol: 8,
+	},
+	{
+		Name:      "Scroll Right at the Right Edge",
+		Keys:      []Key{{Code: KeyRune, Rune: '$'}, {Code: KeyRight}},
+		ExpectRow: 3,
+		ExpectCol: 9,
+	},
+	{
+		Name:      "Next Column",
+		Keys:      []Key{{Code: KeyTab}},
+		ExpectRow: 3,
+		ExpectCol: 5,
+	},
+	{
+		Name:      "Previous Column",
+		Keys:      []Key{{Code: KeyRune, Rune: '$'}, {Code: KeyBacktab}},
+		ExpectRow: 3,
+		ExpectCol: 5,
+	},
+	{
+		Name: "Incremental Search",
+		Keys: []Key{
+			{Code: KeyRune, Rune: '/'},
+			{Code: KeyRune, Rune: 'V'},
+			{Code: KeyRune, Rune: 'a'},
+			{Code: KeyRune, Rune: 'l'},
+			{Code: KeyRune, Rune: 'u'},
+			{Code: KeyRune, Rune: 'e'},
+			{Code: KeyRune, Rune: '1'},
+			{Code: KeyRune, Rune: '2'},
+			{Code: KeyEnter},
+		},
+		ExpectRow: 14,
+	},
+	{
+		Name: "Cancel Search",
+		Keys: []Key{
+			{Code: KeyRune, Rune: '/'},
+			{Code: KeyRune, Rune: '1'},
+			{Code: KeyRune, Rune: '5'},
+			{Code: KeyEscape},
+		},
+		ExpectRow: 3,
+	},
+	{
+		Name: "Next and Previous Match",
+		Keys: []Key{
+			{Code: KeyRune, Rune: '/'},
+			{Code: KeyRune, Rune: '1'},
+			{Code: KeyEnter},
+			{Code: KeyRune, Rune: 'n'},
+			{Code: KeyRune, Rune: 'n'},
+			{Code: KeyRune, Rune: 'N'},
+		},
+		ExpectRow: 12,
+	},
+	{
+		Name:       "Quit",
+		Keys:       []Key{{Code: KeyRune, Rune: 'q'}},
+		ExpectRow:  3,
+		ExpectQuit: true,
+	},
+	{
+		Name:      "Quit Key in Search",
+		Keys:      []Key{{Code: KeyRune, Rune: '/'}, {Code: KeyRune, Rune: 'q'}},
+		ExpectRow: 3,
+	},
+}
+
+func TestViewer_HandleKey(t *testing.T) {
+	for _, v := range viewerHandleKeyTests {
+		viewer := NewViewer(viewerTestLines(), 3)
+		viewer.SetSize(20, 10)
+
+		quit := false
+		for _, key := range v.Keys {
+			quit = viewer.HandleKey(key)
+		}
+
+		if quit != v.ExpectQuit {
+			t.Errorf("%s: quit = %t, want %t", v.Name, quit, v.ExpectQuit)
+		}
+		if viewer.row != v.ExpectRow {
+			t.Errorf("%s: row = %d, want %d", v.Name, viewer.row, v.ExpectRow)
+		}
+		if viewer.col != v.ExpectCol {
+			t.Errorf("%s: col = %d, want %d", v.Name, viewer.col, v.ExpectCol)
+		}
+	}
+}
+
+func TestViewer_Render(t *testing.T) {
+	viewer := NewViewer(viewerTestLines(), 3)
+	viewer.SetSize(12, 6)
+	viewer.HandleKey(Key{Code: KeyTab})
+	viewer.HandleKey(Key{Code: KeyDown})
+
+	expect := "\033[H" +
+		"+-----------\033[K\r\n" +
+		"|          c\033[K\r\n" +
+		"+-----------\033[K\r\n" +
+		"| value2    \033[K\r\n" +
+		"| value3    \033[K\r\n" +
+		"\033[7mlines 5-6/24\033[0m\033[K"
+
+	result := viewer.Render()
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
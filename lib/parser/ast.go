@@ -556,6 +556,16 @@ func (e JsonQuery) String() string {
 	return e.JsonQuery.String() + putParentheses(e.Query.String()+", "+e.JsonText.String())
 }
 
+type TableFunction struct {
+	*BaseExpr
+	Name string
+	Args []QueryExpression
+}
+
+func (e TableFunction) String() string {
+	return strings.ToUpper(e.Name) + "(" + listQueryExpressions(e.Args) + ")"
+}
+
 type Comparison struct {
 	*BaseExpr
 	LHS      QueryExpression
@@ -744,6 +754,16 @@ func (c Concat) String() string {
 	return strings.Join(s, " || ")
 }
 
+type AtTimeZone struct {
+	*BaseExpr
+	Expr     QueryExpression
+	TimeZone QueryExpression
+}
+
+func (e AtTimeZone) String() string {
+	return joinWithSpace([]string{e.Expr.String(), "AT TIME ZONE", e.TimeZone.String()})
+}
+
 type Function struct {
 	*BaseExpr
 	Name string
@@ -822,7 +842,7 @@ func tableName(expr QueryExpression) Identifier {
 	case TableObject:
 		obj, _ := expr.(TableObject)
 		return tableName(obj.Path)
-	case JsonQuery, Subquery:
+	case JsonQuery, TableFunction, Subquery:
 		return Identifier{
 			BaseExpr: expr.GetBaseExpr(),
 		}
@@ -1034,6 +1054,7 @@ type AnalyticFunction struct {
 	Name           string
 	Distinct       Token
 	Args           []QueryExpression
+	OrderBy        QueryExpression
 	IgnoreType     Token
 	AnalyticClause AnalyticClause
 }
@@ -1050,11 +1071,11 @@ func (e AnalyticFunction) String() string {
 		option = append(option, keyword(IGNORE), e.IgnoreType.String())
 	}
 
-	s := []string{
-		strings.ToUpper(e.Name) + "(" + joinWithSpace(option) + ")",
-		keyword(OVER),
-		"(" + e.AnalyticClause.String() + ")",
+	s := []string{strings.ToUpper(e.Name) + "(" + joinWithSpace(option) + ")"}
+	if e.OrderBy != nil {
+		s = append(s, keyword(WITHIN), keyword(GROUP), "("+e.OrderBy.String()+")")
 	}
+	s = append(s, keyword(OVER), "("+e.AnalyticClause.String()+")")
 	return joinWithSpace(s)
 }
 
@@ -1262,9 +1283,34 @@ type DeleteQuery struct {
 
 type CreateTable struct {
 	*BaseExpr
-	Table  Identifier
-	Fields []QueryExpression
-	Query  QueryExpression
+	Table       Identifier
+	Fields      []QueryExpression
+	Constraints []TableConstraint
+	Query       QueryExpression
+}
+
+type TableConstraint struct {
+	*BaseExpr
+	Name      Identifier
+	Type      Token
+	Columns   []QueryExpression
+	Condition QueryExpression
+}
+
+func (e TableConstraint) IsNamed() bool {
+	return 0 < len(e.Name.Literal)
+}
+
+type AddConstraint struct {
+	*BaseExpr
+	Table      QueryExpression
+	Constraint TableConstraint
+}
+
+type DropConstraint struct {
+	*BaseExpr
+	Table QueryExpression
+	Name  Identifier
 }
 
 type AddColumns struct {
@@ -1321,6 +1367,17 @@ type AggregateDeclaration struct {
 	Statements []Statement
 }
 
+type ExternalFunctionDeclaration struct {
+	*BaseExpr
+	Name    Identifier
+	Type    Token
+	Command QueryExpression
+}
+
+func (e ExternalFunctionDeclaration) IsAggregate() bool {
+	return e.Type.Token == AGGREGATE
+}
+
 type DisposeFunction struct {
 	*BaseExpr
 	Name Identifier
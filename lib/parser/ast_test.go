@@ -995,6 +995,17 @@ func TestConcat_String(t *testing.T) {
 	}
 }
 
+func TestAtTimeZone_String(t *testing.T) {
+	e := AtTimeZone{
+		Expr:     Identifier{Literal: "column"},
+		TimeZone: NewStringValue("UTC"),
+	}
+	expect := "column AT TIME ZONE 'UTC'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestFunction_String(t *testing.T) {
 	e := Function{
 		Name: "sum",
@@ -1467,6 +1478,29 @@ func TestAnalyticFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AnalyticFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValue(0.9),
+		},
+		OrderBy: OrderByClause{
+			Items: []QueryExpression{
+				OrderItem{Value: Identifier{Literal: "column3"}},
+			},
+		},
+		AnalyticClause: AnalyticClause{
+			PartitionClause: PartitionClause{
+				Values: []QueryExpression{
+					Identifier{Literal: "column1"},
+				},
+			},
+		},
+	}
+	expect = "PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY column3) OVER (PARTITION BY column1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAnalyticFunction_IsDistinct(t *testing.T) {
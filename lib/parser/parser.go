@@ -13,7 +13,12 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-//line parser.y:11
+type tableElements struct {
+	fields      []QueryExpression
+	constraints []TableConstraint
+}
+
+//line parser.y:16
 type yySymType struct {
 	yys         int
 	program     []Statement
@@ -34,6 +39,9 @@ type yySymType struct {
 	updatesets  []UpdateSet
 	columndef   ColumnDefault
 	columndefs  []ColumnDefault
+	constraint  TableConstraint
+	constraints []TableConstraint
+	tableelems  tableElements
 	elseif      []ElseIf
 	elseexpr    Else
 	casewhen    []CaseWhen
@@ -85,119 +93,128 @@ const DEFAULT = 57383
 const RENAME = 57384
 const TO = 57385
 const VIEW = 57386
-const ORDER = 57387
-const GROUP = 57388
-const HAVING = 57389
-const BY = 57390
-const ASC = 57391
-const DESC = 57392
-const LIMIT = 57393
-const OFFSET = 57394
-const PERCENT = 57395
-const JOIN = 57396
-const INNER = 57397
-const OUTER = 57398
-const LEFT = 57399
-const RIGHT = 57400
-const FULL = 57401
-const CROSS = 57402
-const ON = 57403
-const USING = 57404
-const NATURAL = 57405
-const LATERAL = 57406
-const UNION = 57407
-const INTERSECT = 57408
-const EXCEPT = 57409
-const ALL = 57410
-const ANY = 57411
-const EXISTS = 57412
-const IN = 57413
-const AND = 57414
-const OR = 57415
-const NOT = 57416
-const BETWEEN = 57417
-const LIKE = 57418
-const IS = 57419
-const NULL = 57420
-const DISTINCT = 57421
-const WITH = 57422
-const RANGE = 57423
-const UNBOUNDED = 57424
-const PRECEDING = 57425
-const FOLLOWING = 57426
-const CURRENT = 57427
-const ROW = 57428
-const CASE = 57429
-const IF = 57430
-const ELSEIF = 57431
-const WHILE = 57432
-const WHEN = 57433
-const THEN = 57434
-const ELSE = 57435
-const DO = 57436
-const END = 57437
-const DECLARE = 57438
-const CURSOR = 57439
-const FOR = 57440
-const FETCH = 57441
-const OPEN = 57442
-const CLOSE = 57443
-const DISPOSE = 57444
-const PREPARE = 57445
-const NEXT = 57446
-const PRIOR = 57447
-const ABSOLUTE = 57448
-const RELATIVE = 57449
-const SEPARATOR = 57450
-const PARTITION = 57451
-const OVER = 57452
-const COMMIT = 57453
-const ROLLBACK = 57454
-const CONTINUE = 57455
-const BREAK = 57456
-const EXIT = 57457
-const ECHO = 57458
-const PRINT = 57459
-const PRINTF = 57460
-const SOURCE = 57461
-const EXECUTE = 57462
-const CHDIR = 57463
-const PWD = 57464
-const RELOAD = 57465
-const REMOVE = 57466
-const SYNTAX = 57467
-const TRIGGER = 57468
-const FUNCTION = 57469
-const AGGREGATE = 57470
-const BEGIN = 57471
-const RETURN = 57472
-const IGNORE = 57473
-const WITHIN = 57474
-const VAR = 57475
-const SHOW = 57476
-const TIES = 57477
-const NULLS = 57478
-const ROWS = 57479
-const ONLY = 57480
-const CSV = 57481
-const JSON = 57482
-const FIXED = 57483
-const LTSV = 57484
-const JSON_ROW = 57485
-const JSON_TABLE = 57486
-const SUBSTRING = 57487
-const COUNT = 57488
-const JSON_OBJECT = 57489
-const AGGREGATE_FUNCTION = 57490
-const LIST_FUNCTION = 57491
-const ANALYTIC_FUNCTION = 57492
-const FUNCTION_NTH = 57493
-const FUNCTION_WITH_INS = 57494
-const COMPARISON_OP = 57495
-const STRING_OP = 57496
-const SUBSTITUTION_OP = 57497
-const UMINUS = 57498
-const UPLUS = 57499
+const CONSTRAINT = 57387
+const PRIMARY = 57388
+const KEY = 57389
+const UNIQUE = 57390
+const CHECK = 57391
+const ORDER = 57392
+const GROUP = 57393
+const HAVING = 57394
+const BY = 57395
+const ASC = 57396
+const DESC = 57397
+const LIMIT = 57398
+const OFFSET = 57399
+const PERCENT = 57400
+const JOIN = 57401
+const INNER = 57402
+const OUTER = 57403
+const LEFT = 57404
+const RIGHT = 57405
+const FULL = 57406
+const CROSS = 57407
+const ON = 57408
+const USING = 57409
+const NATURAL = 57410
+const LATERAL = 57411
+const UNION = 57412
+const INTERSECT = 57413
+const EXCEPT = 57414
+const ALL = 57415
+const ANY = 57416
+const EXISTS = 57417
+const IN = 57418
+const AND = 57419
+const OR = 57420
+const NOT = 57421
+const BETWEEN = 57422
+const LIKE = 57423
+const IS = 57424
+const NULL = 57425
+const DISTINCT = 57426
+const WITH = 57427
+const RANGE = 57428
+const UNBOUNDED = 57429
+const PRECEDING = 57430
+const FOLLOWING = 57431
+const CURRENT = 57432
+const ROW = 57433
+const CASE = 57434
+const IF = 57435
+const ELSEIF = 57436
+const WHILE = 57437
+const WHEN = 57438
+const THEN = 57439
+const ELSE = 57440
+const DO = 57441
+const END = 57442
+const DECLARE = 57443
+const CURSOR = 57444
+const FOR = 57445
+const FETCH = 57446
+const OPEN = 57447
+const CLOSE = 57448
+const DISPOSE = 57449
+const PREPARE = 57450
+const NEXT = 57451
+const PRIOR = 57452
+const ABSOLUTE = 57453
+const RELATIVE = 57454
+const SEPARATOR = 57455
+const PARTITION = 57456
+const OVER = 57457
+const COMMIT = 57458
+const ROLLBACK = 57459
+const CONTINUE = 57460
+const BREAK = 57461
+const EXIT = 57462
+const ECHO = 57463
+const PRINT = 57464
+const PRINTF = 57465
+const SOURCE = 57466
+const EXECUTE = 57467
+const CHDIR = 57468
+const PWD = 57469
+const RELOAD = 57470
+const REMOVE = 57471
+const SYNTAX = 57472
+const TRIGGER = 57473
+const FUNCTION = 57474
+const AGGREGATE = 57475
+const BEGIN = 57476
+const RETURN = 57477
+const EXTERNAL = 57478
+const IGNORE = 57479
+const WITHIN = 57480
+const VAR = 57481
+const SHOW = 57482
+const TIES = 57483
+const NULLS = 57484
+const ROWS = 57485
+const ONLY = 57486
+const CSV = 57487
+const JSON = 57488
+const FIXED = 57489
+const LTSV = 57490
+const XML = 57491
+const YAML = 57492
+const JSON_ROW = 57493
+const JSON_TABLE = 57494
+const SUBSTRING = 57495
+const COUNT = 57496
+const JSON_OBJECT = 57497
+const AGGREGATE_FUNCTION = 57498
+const LIST_FUNCTION = 57499
+const ANALYTIC_FUNCTION = 57500
+const FUNCTION_NTH = 57501
+const FUNCTION_WITH_INS = 57502
+const AT_TIME_ZONE = 57503
+const COMPARISON_OP = 57504
+const STRING_OP = 57505
+const SUBSTITUTION_OP = 57506
+const UMINUS = 57507
+const UPLUS = 57508
 
 var yyToknames = [...]string{
 	"$end",
@@ -244,6 +261,11 @@ var yyToknames = [...]string{
 	"RENAME",
 	"TO",
 	"VIEW",
+	"CONSTRAINT",
+	"PRIMARY",
+	"KEY",
+	"UNIQUE",
+	"CHECK",
 	"ORDER",
 	"GROUP",
 	"HAVING",
@@ -330,6 +352,7 @@ var yyToknames = [...]string{
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
+	"EXTERNAL",
 	"IGNORE",
 	"WITHIN",
 	"VAR",
@@ -342,6 +365,8 @@ var yyToknames = [...]string{
 	"JSON",
 	"FIXED",
 	"LTSV",
+	"XML",
+	"YAML",
 	"JSON_ROW",
 	"JSON_TABLE",
 	"SUBSTRING",
@@ -352,6 +377,7 @@ var yyToknames = [...]string{
 	"ANALYTIC_FUNCTION",
 	"FUNCTION_NTH",
 	"FUNCTION_WITH_INS",
+	"AT_TIME_ZONE",
 	"COMPARISON_OP",
 	"STRING_OP",
 	"SUBSTITUTION_OP",
@@ -370,13 +396,14 @@ var yyToknames = [...]string{
 	"','",
 	"'.'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2714
+//line parser.y:2905
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -391,1035 +418,1113 @@ func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bo
 }
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 0,
 	1, 1,
-	-2, 216,
+	-2, 239,
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 21,
 	1, 26,
-	89, 26,
-	91, 26,
-	93, 26,
-	95, 26,
-	158, 26,
-	-2, 236,
+	94, 26,
+	96, 26,
+	98, 26,
+	100, 26,
+	167, 26,
+	-2, 259,
 	-1, 33,
 	1, 78,
-	89, 78,
-	91, 78,
-	93, 78,
-	95, 78,
-	158, 78,
-	-2, 248,
-	-1, 113,
-	17, 216,
-	19, 216,
-	22, 216,
-	24, 216,
+	94, 78,
+	96, 78,
+	98, 78,
+	100, 78,
+	167, 78,
+	-2, 272,
+	-1, 117,
+	17, 239,
+	19, 239,
+	22, 239,
+	24, 239,
 	-2, 1,
-	-1, 115,
-	167, 307,
-	-2, 216,
-	-1, 124,
-	65, 184,
-	66, 184,
-	67, 184,
-	-2, 196,
-	-1, 162,
-	1, 122,
-	89, 122,
-	91, 122,
-	93, 122,
-	95, 122,
-	158, 122,
-	-2, 230,
-	-1, 163,
-	1, 163,
-	89, 163,
-	91, 163,
-	93, 163,
-	95, 163,
-	158, 163,
-	-2, 236,
-	-1, 168,
-	1, 156,
-	89, 156,
-	91, 156,
-	93, 156,
-	95, 156,
-	158, 156,
-	-2, 236,
-	-1, 169,
-	1, 157,
-	89, 157,
-	91, 157,
-	93, 157,
-	95, 157,
-	158, 157,
-	-2, 236,
-	-1, 170,
-	1, 158,
-	89, 158,
-	91, 158,
-	93, 158,
-	95, 158,
-	158, 158,
-	-2, 236,
-	-1, 171,
-	1, 161,
-	89, 161,
-	91, 161,
-	93, 161,
-	95, 161,
-	158, 161,
-	-2, 230,
+	-1, 119,
+	176, 332,
+	-2, 239,
+	-1, 128,
+	70, 207,
+	71, 207,
+	72, 207,
+	-2, 219,
+	-1, 166,
+	1, 143,
+	94, 143,
+	96, 143,
+	98, 143,
+	100, 143,
+	167, 143,
+	-2, 253,
+	-1, 167,
+	1, 186,
+	94, 186,
+	96, 186,
+	98, 186,
+	100, 186,
+	167, 186,
+	-2, 259,
 	-1, 172,
-	1, 162,
-	89, 162,
-	91, 162,
-	93, 162,
-	95, 162,
-	158, 162,
-	-2, 236,
+	1, 179,
+	94, 179,
+	96, 179,
+	98, 179,
+	100, 179,
+	167, 179,
+	-2, 259,
+	-1, 173,
+	1, 180,
+	94, 180,
+	96, 180,
+	98, 180,
+	100, 180,
+	167, 180,
+	-2, 259,
+	-1, 174,
+	1, 181,
+	94, 181,
+	96, 181,
+	98, 181,
+	100, 181,
+	167, 181,
+	-2, 259,
 	-1, 175,
-	1, 169,
-	89, 169,
-	91, 169,
-	93, 169,
-	95, 169,
-	158, 169,
-	-2, 230,
+	1, 184,
+	94, 184,
+	96, 184,
+	98, 184,
+	100, 184,
+	167, 184,
+	-2, 253,
 	-1, 176,
-	1, 170,
-	89, 170,
-	91, 170,
-	93, 170,
-	95, 170,
-	158, 170,
-	-2, 236,
-	-1, 233,
-	89, 1,
-	93, 1,
-	95, 1,
-	-2, 216,
-	-1, 255,
-	166, 356,
-	-2, 477,
-	-1, 256,
-	166, 357,
-	-2, 478,
-	-1, 257,
-	166, 358,
-	-2, 479,
-	-1, 258,
-	166, 359,
-	-2, 480,
-	-1, 290,
-	4, 144,
-	135, 144,
-	136, 144,
-	137, 144,
-	139, 144,
-	140, 144,
-	141, 144,
-	142, 144,
-	-2, 236,
-	-1, 291,
-	4, 145,
-	135, 145,
-	136, 145,
-	137, 145,
-	139, 145,
-	140, 145,
-	141, 145,
-	142, 145,
-	-2, 236,
-	-1, 301,
-	1, 174,
-	89, 174,
-	91, 174,
-	93, 174,
-	95, 174,
-	158, 174,
-	-2, 236,
-	-1, 309,
-	95, 4,
-	-2, 216,
-	-1, 318,
-	71, 0,
-	75, 0,
+	1, 185,
+	94, 185,
+	96, 185,
+	98, 185,
+	100, 185,
+	167, 185,
+	-2, 259,
+	-1, 179,
+	1, 192,
+	94, 192,
+	96, 192,
+	98, 192,
+	100, 192,
+	167, 192,
+	-2, 253,
+	-1, 180,
+	1, 193,
+	94, 193,
+	96, 193,
+	98, 193,
+	100, 193,
+	167, 193,
+	-2, 259,
+	-1, 238,
+	94, 1,
+	98, 1,
+	100, 1,
+	-2, 239,
+	-1, 260,
+	175, 384,
+	-2, 510,
+	-1, 261,
+	175, 385,
+	-2, 511,
+	-1, 262,
+	175, 386,
+	-2, 512,
+	-1, 263,
+	175, 387,
+	-2, 513,
+	-1, 264,
+	175, 388,
+	-2, 514,
+	-1, 265,
+	175, 389,
+	-2, 515,
+	-1, 297,
+	4, 167,
+	47, 167,
+	141, 167,
+	142, 167,
+	143, 167,
+	145, 167,
+	146, 167,
+	147, 167,
+	148, 167,
+	149, 167,
+	150, 167,
+	-2, 259,
+	-1, 298,
+	4, 168,
+	47, 168,
+	141, 168,
+	142, 168,
+	143, 168,
+	145, 168,
+	146, 168,
+	147, 168,
+	148, 168,
+	149, 168,
+	150, 168,
+	-2, 259,
+	-1, 308,
+	1, 197,
+	94, 197,
+	96, 197,
+	98, 197,
+	100, 197,
+	167, 197,
+	-2, 259,
+	-1, 316,
+	100, 4,
+	-2, 239,
+	-1, 325,
 	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 277,
-	-1, 319,
-	71, 0,
-	75, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	162, 0,
+	168, 0,
+	-2, 301,
+	-1, 326,
 	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 279,
-	-1, 328,
-	71, 0,
-	75, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	162, 0,
+	168, 0,
+	-2, 303,
+	-1, 335,
 	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 289,
-	-1, 378,
-	95, 1,
-	-2, 216,
-	-1, 394,
-	54, 496,
-	-2, 413,
-	-1, 434,
+	80, 0,
+	81, 0,
+	82, 0,
+	162, 0,
+	168, 0,
+	-2, 313,
+	-1, 386,
+	100, 1,
+	-2, 239,
+	-1, 402,
+	59, 532,
+	-2, 446,
+	-1, 447,
 	1, 80,
-	89, 80,
-	91, 80,
-	93, 80,
-	95, 80,
-	158, 80,
-	-2, 236,
-	-1, 435,
+	94, 80,
+	96, 80,
+	98, 80,
+	100, 80,
+	167, 80,
+	-2, 259,
+	-1, 448,
 	1, 81,
-	89, 81,
-	91, 81,
-	93, 81,
-	95, 81,
-	158, 81,
-	-2, 230,
-	-1, 436,
+	94, 81,
+	96, 81,
+	98, 81,
+	100, 81,
+	167, 81,
+	-2, 253,
+	-1, 449,
 	1, 82,
-	89, 82,
-	91, 82,
-	93, 82,
-	95, 82,
-	158, 82,
-	-2, 236,
-	-1, 437,
+	94, 82,
+	96, 82,
+	98, 82,
+	100, 82,
+	167, 82,
+	-2, 259,
+	-1, 450,
 	1, 83,
-	89, 83,
-	91, 83,
-	93, 83,
-	95, 83,
-	158, 83,
-	-2, 230,
-	-1, 438,
-	1, 149,
-	89, 149,
-	91, 149,
-	93, 149,
-	95, 149,
-	158, 149,
-	-2, 230,
-	-1, 439,
-	1, 150,
-	89, 150,
-	91, 150,
-	93, 150,
-	95, 150,
-	158, 150,
-	-2, 236,
-	-1, 440,
-	1, 151,
-	89, 151,
-	91, 151,
-	93, 151,
-	95, 151,
-	158, 151,
-	-2, 230,
-	-1, 441,
-	1, 152,
-	89, 152,
-	91, 152,
-	93, 152,
-	95, 152,
-	158, 152,
-	-2, 236,
-	-1, 444,
-	1, 117,
-	89, 117,
-	91, 117,
-	93, 117,
-	95, 117,
-	158, 117,
-	168, 117,
-	-2, 236,
-	-1, 449,
-	1, 411,
-	89, 411,
-	91, 411,
-	93, 411,
-	95, 411,
-	158, 411,
-	-2, 236,
-	-1, 456,
+	94, 83,
+	96, 83,
+	98, 83,
+	100, 83,
+	167, 83,
+	-2, 253,
+	-1, 451,
+	1, 172,
+	94, 172,
+	96, 172,
+	98, 172,
+	100, 172,
+	167, 172,
+	-2, 253,
+	-1, 452,
+	1, 173,
+	94, 173,
+	96, 173,
+	98, 173,
+	100, 173,
+	167, 173,
+	-2, 259,
+	-1, 453,
+	1, 174,
+	94, 174,
+	96, 174,
+	98, 174,
+	100, 174,
+	167, 174,
+	-2, 253,
+	-1, 454,
 	1, 175,
-	89, 175,
-	91, 175,
-	93, 175,
-	95, 175,
-	158, 175,
-	-2, 236,
-	-1, 481,
-	71, 0,
-	75, 0,
+	94, 175,
+	96, 175,
+	98, 175,
+	100, 175,
+	167, 175,
+	-2, 259,
+	-1, 457,
+	1, 138,
+	94, 138,
+	96, 138,
+	98, 138,
+	100, 138,
+	167, 138,
+	177, 138,
+	-2, 259,
+	-1, 462,
+	1, 444,
+	94, 444,
+	96, 444,
+	98, 444,
+	100, 444,
+	167, 444,
+	-2, 259,
+	-1, 469,
+	1, 198,
+	94, 198,
+	96, 198,
+	98, 198,
+	100, 198,
+	167, 198,
+	-2, 259,
+	-1, 494,
 	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 290,
-	-1, 514,
-	95, 1,
-	-2, 216,
-	-1, 521,
-	91, 1,
-	93, 1,
-	95, 1,
-	-2, 216,
-	-1, 524,
-	1, 206,
-	52, 206,
-	80, 206,
-	89, 206,
-	91, 206,
-	93, 206,
-	95, 206,
-	98, 206,
-	138, 206,
-	158, 206,
-	167, 206,
-	-2, 236,
-	-1, 525,
-	1, 211,
-	89, 211,
-	91, 211,
-	93, 211,
-	95, 211,
-	98, 211,
-	99, 211,
-	158, 211,
-	167, 211,
-	-2, 236,
-	-1, 560,
-	167, 354,
-	168, 354,
-	-2, 230,
-	-1, 602,
-	89, 4,
-	91, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 605,
-	95, 4,
-	-2, 216,
-	-1, 606,
-	95, 4,
-	-2, 216,
-	-1, 671,
-	54, 496,
-	-2, 372,
-	-1, 692,
-	17, 507,
-	80, 507,
-	166, 507,
+	80, 0,
+	81, 0,
+	82, 0,
+	162, 0,
+	168, 0,
+	-2, 314,
+	-1, 527,
+	100, 1,
+	-2, 239,
+	-1, 534,
+	96, 1,
+	98, 1,
+	100, 1,
+	-2, 239,
+	-1, 537,
+	1, 229,
+	57, 229,
+	85, 229,
+	94, 229,
+	96, 229,
+	98, 229,
+	100, 229,
+	103, 229,
+	144, 229,
+	167, 229,
+	176, 229,
+	-2, 259,
+	-1, 538,
+	1, 234,
+	94, 234,
+	96, 234,
+	98, 234,
+	100, 234,
+	103, 234,
+	104, 234,
+	167, 234,
+	176, 234,
+	-2, 259,
+	-1, 574,
+	176, 382,
+	177, 382,
+	-2, 253,
+	-1, 591,
+	176, 104,
+	177, 104,
+	-2, 116,
+	-1, 617,
+	1, 159,
+	94, 159,
+	96, 159,
+	98, 159,
+	100, 159,
+	167, 159,
+	-2, 259,
+	-1, 619,
+	1, 160,
+	94, 160,
+	96, 160,
+	98, 160,
+	100, 160,
+	167, 160,
+	-2, 259,
+	-1, 625,
+	94, 4,
+	96, 4,
+	98, 4,
+	100, 4,
+	-2, 239,
+	-1, 628,
+	100, 4,
+	-2, 239,
+	-1, 629,
+	100, 4,
+	-2, 239,
+	-1, 694,
+	59, 532,
+	-2, 405,
+	-1, 716,
+	17, 543,
+	85, 543,
+	175, 543,
 	-2, 87,
-	-1, 718,
-	89, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 723,
-	95, 4,
-	-2, 216,
-	-1, 724,
-	95, 4,
-	-2, 216,
-	-1, 749,
-	89, 1,
-	93, 1,
-	95, 1,
-	-2, 216,
-	-1, 792,
-	1, 95,
-	89, 95,
-	91, 95,
-	93, 95,
-	95, 95,
-	158, 95,
-	-2, 230,
-	-1, 793,
-	1, 96,
-	89, 96,
-	91, 96,
-	93, 96,
-	95, 96,
-	158, 96,
-	-2, 236,
-	-1, 795,
-	95, 6,
-	-2, 216,
-	-1, 801,
-	167, 128,
-	168, 128,
-	-2, 236,
-	-1, 806,
-	95, 4,
-	-2, 216,
-	-1, 877,
-	95, 6,
-	-2, 216,
-	-1, 878,
-	95, 6,
-	-2, 216,
-	-1, 882,
-	95, 4,
-	-2, 216,
-	-1, 886,
-	91, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 929,
-	89, 6,
-	91, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
+	-1, 719,
+	176, 104,
+	177, 104,
+	-2, 116,
+	-1, 752,
+	94, 4,
+	98, 4,
+	100, 4,
+	-2, 239,
+	-1, 757,
+	100, 4,
+	-2, 239,
+	-1, 758,
+	100, 4,
+	-2, 239,
+	-1, 784,
+	94, 1,
+	98, 1,
+	100, 1,
+	-2, 239,
+	-1, 840,
+	1, 97,
+	94, 97,
+	96, 97,
+	98, 97,
+	100, 97,
+	167, 97,
+	-2, 253,
+	-1, 841,
+	1, 98,
+	94, 98,
+	96, 98,
+	98, 98,
+	100, 98,
+	167, 98,
+	-2, 259,
+	-1, 844,
+	100, 6,
+	-2, 239,
+	-1, 850,
+	176, 149,
+	177, 149,
+	-2, 259,
+	-1, 855,
+	100, 4,
+	-2, 239,
+	-1, 935,
+	100, 6,
+	-2, 239,
 	-1, 936,
-	158, 62,
-	-2, 236,
-	-1, 976,
-	89, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
-	-1, 979,
-	95, 8,
-	-2, 216,
-	-1, 986,
-	95, 6,
-	-2, 216,
-	-1, 989,
-	89, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 1016,
-	95, 6,
-	-2, 216,
+	100, 6,
+	-2, 239,
+	-1, 940,
+	100, 4,
+	-2, 239,
+	-1, 944,
+	96, 4,
+	98, 4,
+	100, 4,
+	-2, 239,
+	-1, 996,
+	94, 6,
+	96, 6,
+	98, 6,
+	100, 6,
+	-2, 239,
+	-1, 1003,
+	167, 62,
+	-2, 259,
 	-1, 1049,
-	95, 6,
-	-2, 216,
-	-1, 1053,
-	91, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
-	-1, 1055,
-	89, 8,
-	91, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1058,
-	95, 8,
-	-2, 216,
+	94, 6,
+	98, 6,
+	100, 6,
+	-2, 239,
+	-1, 1052,
+	100, 8,
+	-2, 239,
 	-1, 1059,
-	95, 8,
-	-2, 216,
-	-1, 1076,
-	89, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1081,
-	95, 8,
-	-2, 216,
-	-1, 1082,
-	95, 8,
-	-2, 216,
-	-1, 1087,
-	89, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
+	100, 6,
+	-2, 239,
+	-1, 1062,
+	94, 4,
+	98, 4,
+	100, 4,
+	-2, 239,
 	-1, 1092,
-	95, 8,
-	-2, 216,
-	-1, 1107,
-	95, 8,
-	-2, 216,
-	-1, 1111,
-	91, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1140,
-	89, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
+	100, 6,
+	-2, 239,
+	-1, 1127,
+	100, 6,
+	-2, 239,
+	-1, 1131,
+	96, 6,
+	98, 6,
+	100, 6,
+	-2, 239,
+	-1, 1133,
+	94, 8,
+	96, 8,
+	98, 8,
+	100, 8,
+	-2, 239,
+	-1, 1136,
+	100, 8,
+	-2, 239,
+	-1, 1137,
+	100, 8,
+	-2, 239,
+	-1, 1156,
+	94, 8,
+	98, 8,
+	100, 8,
+	-2, 239,
+	-1, 1161,
+	100, 8,
+	-2, 239,
+	-1, 1162,
+	100, 8,
+	-2, 239,
+	-1, 1169,
+	94, 6,
+	98, 6,
+	100, 6,
+	-2, 239,
+	-1, 1174,
+	100, 8,
+	-2, 239,
+	-1, 1191,
+	100, 8,
+	-2, 239,
+	-1, 1195,
+	96, 8,
+	98, 8,
+	100, 8,
+	-2, 239,
+	-1, 1228,
+	94, 8,
+	98, 8,
+	100, 8,
+	-2, 239,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 4041
+const yyLast = 4365
 
-var yyAct = [...]int{
-
-	123, 21, 1106, 1105, 1118, 526, 1048, 350, 1077, 719,
-	977, 867, 881, 630, 116, 33, 949, 1047, 994, 951,
-	398, 27, 65, 121, 114, 880, 1025, 950, 754, 699,
-	188, 1024, 269, 574, 383, 670, 572, 840, 694, 464,
-	26, 649, 163, 513, 384, 164, 165, 590, 168, 169,
-	170, 172, 592, 176, 141, 141, 420, 144, 90, 463,
-	25, 389, 1, 553, 593, 250, 187, 666, 661, 239,
-	238, 181, 348, 185, 5, 459, 3, 448, 442, 244,
-	537, 532, 512, 101, 173, 536, 345, 700, 393, 465,
-	261, 248, 184, 503, 400, 186, 80, 192, 222, 266,
-	68, 78, 411, 182, 980, 130, 138, 568, 215, 919,
-	457, 214, 231, 131, 21, 127, 181, 1018, 129, 293,
-	126, 102, 215, 128, 1029, 214, 491, 202, 33, 214,
-	201, 200, 203, 199, 214, 124, 150, 184, 471, 142,
-	310, 856, 857, 241, 237, 183, 849, 166, 234, 711,
-	712, 683, 684, 26, 540, 184, 541, 542, 543, 535,
-	290, 291, 538, 540, 299, 541, 542, 543, 535, 788,
-	771, 538, 770, 25, 196, 742, 232, 709, 708, 301,
-	206, 205, 207, 208, 209, 693, 691, 685, 179, 3,
-	183, 681, 656, 600, 597, 262, 94, 550, 311, 489,
-	410, 311, 206, 205, 207, 208, 209, 405, 183, 197,
-	196, 313, 281, 315, 274, 198, 206, 205, 207, 208,
-	209, 311, 249, 215, 179, 1066, 214, 74, 1065, 1041,
-	270, 1040, 272, 1039, 21, 311, 131, 311, 1038, 273,
-	111, 382, 1037, 1036, 1011, 1010, 1008, 1006, 33, 1004,
-	1003, 74, 103, 104, 105, 314, 106, 107, 108, 109,
-	993, 325, 133, 326, 474, 992, 562, 539, 392, 417,
-	298, 974, 971, 26, 920, 675, 879, 858, 855, 362,
-	363, 821, 820, 578, 94, 434, 436, 439, 441, 444,
-	111, 819, 124, 25, 444, 449, 374, 141, 320, 449,
-	449, 818, 817, 456, 816, 812, 790, 787, 780, 3,
-	21, 779, 391, 326, 431, 341, 772, 741, 360, 361,
-	739, 738, 737, 388, 33, 730, 726, 707, 705, 370,
-	692, 690, 635, 455, 392, 628, 627, 551, 506, 626,
-	613, 584, 403, 488, 486, 484, 421, 416, 184, 408,
-	375, 306, 589, 415, 407, 307, 305, 1007, 135, 182,
-	1005, 504, 133, 958, 957, 956, 955, 413, 414, 954,
-	563, 953, 447, 418, 925, 911, 453, 454, 469, 21,
-	906, 427, 480, 903, 901, 133, 524, 525, 482, 483,
-	900, 893, 452, 33, 891, 530, 450, 451, 862, 686,
-	632, 183, 475, 609, 571, 547, 102, 559, 498, 497,
-	496, 495, 494, 473, 493, 492, 433, 477, 26, 432,
-	102, 184, 476, 502, 406, 184, 207, 208, 209, 139,
-	1055, 397, 253, 134, 501, 236, 230, 229, 25, 139,
-	219, 517, 184, 218, 217, 216, 112, 682, 224, 929,
-	531, 184, 430, 184, 3, 595, 287, 285, 602, 113,
-	507, 508, 603, 275, 587, 179, 672, 509, 392, 1084,
-	564, 368, 558, 654, 183, 904, 262, 756, 552, 902,
-	758, 834, 825, 745, 419, 650, 604, 986, 555, 899,
-	878, 877, 823, 795, 964, 576, 962, 557, 565, 134,
-	249, 566, 573, 826, 585, 102, 588, 580, 582, 610,
-	577, 745, 599, 824, 898, 21, 640, 567, 651, 569,
-	570, 102, 21, 967, 655, 897, 184, 220, 896, 33,
-	397, 253, 277, 221, 895, 755, 33, 103, 104, 105,
-	369, 255, 256, 257, 258, 549, 401, 394, 676, 894,
-	822, 103, 104, 105, 26, 106, 107, 108, 109, 815,
-	673, 26, 952, 631, 678, 917, 102, 646, 399, 652,
-	523, 522, 286, 284, 25, 429, 615, 639, 1139, 183,
-	1125, 25, 581, 634, 643, 276, 1115, 1114, 679, 1109,
-	3, 618, 619, 620, 621, 622, 638, 3, 444, 94,
-	687, 449, 1095, 21, 157, 158, 21, 21, 689, 631,
-	1094, 1086, 633, 660, 1068, 278, 279, 33, 702, 669,
-	33, 33, 1062, 1054, 668, 1107, 1051, 688, 988, 985,
-	984, 184, 146, 680, 647, 573, 103, 104, 105, 940,
-	255, 256, 257, 258, 928, 401, 753, 573, 890, 889,
-	884, 809, 103, 104, 105, 573, 106, 107, 108, 109,
-	808, 748, 757, 637, 530, 573, 601, 399, 518, 516,
-	1082, 155, 156, 159, 160, 1108, 713, 1081, 715, 1107,
-	1132, 1059, 1050, 1058, 725, 145, 1049, 1092, 740, 979,
-	724, 147, 723, 769, 735, 606, 761, 103, 104, 105,
-	605, 106, 107, 108, 109, 793, 883, 309, 102, 1049,
-	882, 801, 750, 717, 751, 148, 721, 722, 1016, 21,
-	882, 807, 260, 778, 21, 21, 515, 784, 782, 759,
-	514, 595, 800, 33, 253, 595, 806, 768, 33, 33,
-	514, 380, 773, 378, 1140, 774, 1111, 1087, 1076, 783,
-	21, 777, 1053, 382, 989, 976, 827, 886, 749, 718,
-	803, 798, 799, 521, 33, 555, 233, 1142, 1089, 1078,
-	573, 991, 852, 797, 978, 573, 752, 720, 838, 376,
-	240, 785, 786, 1131, 839, 1113, 843, 1112, 1074, 26,
-	947, 673, 946, 888, 184, 832, 21, 204, 887, 833,
-	716, 631, 184, 1108, 1050, 184, 850, 21, 883, 25,
-	33, 515, 831, 1146, 1138, 1103, 184, 1085, 865, 1032,
-	987, 33, 874, 830, 747, 3, 864, 873, 1129, 804,
-	1072, 944, 1119, 641, 810, 811, 1137, 1123, 1148, 103,
-	104, 105, 1134, 106, 107, 108, 109, 854, 1119, 1135,
-	1136, 1122, 1121, 1101, 744, 861, 74, 267, 863, 323,
-	1044, 908, 1012, 322, 324, 99, 914, 921, 915, 866,
-	673, 869, 930, 909, 926, 907, 932, 936, 21, 21,
-	184, 912, 913, 21, 943, 918, 923, 21, 223, 937,
-	938, 224, 33, 33, 860, 853, 931, 33, 933, 927,
-	1133, 33, 629, 1030, 874, 874, 935, 941, 1144, 873,
-	873, 1120, 631, 184, 74, 981, 74, 885, 961, 631,
-	960, 472, 1099, 960, 1117, 966, 312, 1120, 959, 1100,
-	21, 963, 1102, 924, 100, 365, 264, 972, 970, 364,
-	74, 975, 934, 412, 33, 968, 573, 546, 74, 74,
-	367, 366, 969, 869, 869, 990, 874, 859, 982, 330,
-	329, 873, 973, 263, 264, 265, 948, 841, 842, 235,
-	997, 998, 999, 1000, 1001, 781, 294, 21, 960, 1017,
-	21, 540, 631, 541, 542, 543, 1002, 21, 1014, 288,
-	21, 33, 807, 942, 33, 667, 184, 945, 1031, 848,
-	767, 33, 983, 874, 33, 869, 766, 665, 873, 573,
-	664, 386, 540, 874, 541, 542, 662, 21, 873, 385,
-	386, 1034, 1042, 1056, 658, 659, 1046, 960, 1052, 1035,
-	996, 33, 663, 184, 387, 1043, 829, 533, 242, 995,
-	1064, 704, 530, 874, 703, 295, 1063, 1057, 873, 1013,
-	21, 1071, 869, 81, 21, 1020, 21, 710, 1067, 21,
-	21, 1070, 869, 701, 33, 1073, 1069, 137, 33, 1026,
-	33, 631, 136, 33, 33, 195, 874, 21, 122, 1093,
-	874, 873, 21, 21, 1088, 873, 1045, 671, 21, 939,
-	1017, 33, 869, 21, 836, 837, 33, 33, 813, 1104,
-	1033, 802, 33, 631, 268, 174, 796, 33, 21, 1128,
-	1126, 1124, 21, 540, 874, 541, 542, 543, 535, 873,
-	794, 538, 33, 66, 180, 869, 33, 421, 706, 869,
-	598, 1020, 490, 308, 1020, 1020, 212, 213, 1145, 1141,
-	425, 21, 445, 1093, 246, 1026, 226, 227, 1026, 1026,
-	1149, 245, 1020, 422, 423, 33, 259, 1020, 1020, 149,
-	151, 125, 424, 869, 102, 247, 1026, 390, 1020, 180,
-	404, 1026, 1026, 1075, 122, 1009, 1079, 1080, 695, 696,
-	697, 698, 1026, 1020, 644, 340, 342, 1020, 174, 397,
-	253, 246, 409, 297, 1090, 296, 292, 1026, 95, 1096,
-	1097, 1026, 97, 95, 97, 94, 191, 194, 446, 67,
-	1110, 140, 762, 764, 102, 1091, 1020, 1015, 805, 377,
-	487, 10, 9, 554, 847, 1127, 8, 7, 379, 1130,
-	1026, 62, 346, 303, 347, 396, 102, 395, 251, 254,
-	112, 1143, 1116, 426, 1098, 1083, 89, 61, 60, 64,
-	317, 318, 319, 57, 321, 63, 58, 328, 1147, 331,
-	332, 333, 334, 335, 336, 337, 835, 102, 657, 174,
-	343, 349, 528, 202, 211, 210, 201, 200, 203, 199,
-	527, 56, 193, 653, 371, 648, 645, 243, 6, 20,
-	174, 19, 397, 253, 381, 103, 104, 105, 69, 255,
-	256, 257, 258, 154, 401, 17, 594, 591, 16, 443,
-	15, 485, 74, 14, 844, 846, 11, 102, 671, 18,
-	349, 13, 12, 1021, 870, 1019, 399, 174, 868, 428,
-	499, 500, 460, 458, 4, 2, 0, 0, 0, 0,
-	510, 0, 397, 253, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 0, 174, 197, 196, 0, 0, 0,
-	0, 198, 206, 205, 207, 208, 209, 103, 104, 105,
-	300, 106, 107, 108, 109, 0, 479, 845, 481, 0,
-	174, 202, 211, 210, 201, 200, 203, 199, 0, 0,
-	0, 0, 0, 102, 0, 174, 916, 671, 103, 104,
-	105, 0, 255, 256, 257, 258, 0, 401, 0, 0,
-	0, 0, 0, 0, 174, 174, 0, 0, 0, 253,
-	0, 0, 202, 211, 174, 201, 200, 203, 199, 399,
-	381, 0, 0, 0, 519, 0, 0, 0, 0, 0,
-	0, 529, 0, 0, 534, 0, 0, 84, 103, 104,
-	105, 0, 255, 256, 257, 258, 0, 401, 0, 0,
-	0, 617, 0, 197, 196, 0, 623, 624, 625, 198,
-	206, 205, 207, 208, 209, 0, 0, 304, 300, 399,
-	143, 0, 0, 0, 0, 152, 153, 0, 161, 162,
-	0, 0, 0, 0, 167, 0, 0, 0, 171, 0,
-	175, 0, 177, 178, 197, 196, 0, 0, 0, 0,
-	198, 206, 205, 207, 208, 209, 122, 202, 211, 210,
-	201, 200, 203, 199, 103, 104, 105, 0, 106, 107,
-	108, 109, 611, 202, 211, 210, 201, 200, 203, 199,
-	0, 614, 0, 349, 0, 174, 228, 0, 0, 0,
-	174, 174, 174, 0, 0, 0, 0, 0, 0, 0,
-	729, 0, 0, 0, 0, 636, 0, 202, 211, 210,
-	201, 200, 203, 199, 642, 252, 59, 252, 0, 0,
-	0, 0, 0, 252, 271, 252, 0, 0, 731, 732,
-	733, 734, 736, 280, 252, 282, 283, 0, 0, 197,
-	196, 0, 289, 0, 132, 198, 206, 205, 207, 208,
-	209, 102, 0, 0, 828, 197, 196, 0, 0, 0,
-	0, 198, 206, 205, 207, 208, 209, 0, 0, 728,
-	202, 211, 210, 201, 200, 203, 199, 253, 0, 0,
-	102, 0, 316, 0, 0, 0, 0, 0, 97, 197,
-	196, 0, 0, 0, 776, 198, 206, 205, 207, 208,
-	209, 0, 338, 0, 511, 352, 727, 0, 225, 0,
-	0, 0, 174, 174, 174, 174, 174, 0, 0, 372,
-	102, 0, 0, 0, 0, 540, 743, 541, 542, 543,
-	535, 841, 842, 538, 252, 252, 0, 0, 202, 211,
-	210, 201, 200, 203, 199, 397, 253, 252, 252, 0,
-	529, 0, 197, 196, 352, 0, 760, 174, 198, 206,
-	205, 207, 208, 209, 0, 0, 0, 300, 0, 0,
-	0, 0, 435, 437, 438, 440, 775, 0, 174, 0,
-	765, 0, 103, 104, 105, 252, 255, 256, 257, 258,
-	0, 0, 0, 0, 0, 789, 0, 0, 468, 0,
-	470, 132, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 104, 105, 381, 106, 107, 108, 109, 327,
-	197, 196, 0, 814, 0, 0, 198, 206, 205, 207,
-	208, 209, 0, 0, 965, 0, 102, 327, 327, 0,
-	0, 202, 211, 210, 201, 200, 203, 199, 0, 0,
-	0, 103, 104, 105, 102, 255, 256, 257, 258, 0,
-	401, 397, 253, 402, 102, 0, 373, 922, 0, 0,
-	0, 0, 0, 0, 102, 352, 0, 402, 545, 0,
-	0, 0, 399, 544, 0, 0, 102, 252, 339, 0,
-	548, 0, 556, 252, 560, 0, 763, 252, 252, 397,
-	253, 0, 0, 0, 0, 0, 556, 575, 0, 0,
-	579, 556, 556, 583, 0, 0, 0, 586, 575, 0,
-	0, 596, 905, 197, 196, 0, 0, 0, 0, 198,
-	206, 205, 207, 208, 209, 910, 0, 892, 0, 0,
-	327, 0, 0, 0, 0, 0, 327, 327, 0, 0,
-	74, 174, 202, 211, 210, 201, 200, 203, 199, 607,
-	608, 0, 0, 575, 0, 0, 122, 103, 104, 105,
-	0, 255, 256, 257, 258, 0, 401, 352, 616, 0,
-	0, 327, 505, 505, 505, 103, 104, 105, 0, 106,
-	107, 108, 109, 0, 0, 103, 104, 105, 399, 106,
-	107, 108, 109, 0, 0, 103, 104, 105, 0, 255,
-	256, 257, 258, 0, 401, 0, 402, 103, 104, 105,
-	0, 106, 107, 108, 109, 0, 402, 252, 132, 0,
-	132, 132, 0, 674, 197, 196, 399, 677, 0, 556,
-	198, 206, 205, 207, 208, 209, 0, 0, 746, 0,
-	0, 556, 0, 0, 0, 0, 0, 0, 0, 556,
-	0, 0, 0, 0, 0, 0, 579, 0, 0, 556,
-	0, 0, 381, 0, 0, 102, 75, 76, 77, 0,
-	99, 79, 94, 97, 95, 96, 714, 71, 0, 0,
-	174, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	0, 112, 0, 0, 0, 202, 211, 210, 201, 200,
-	203, 199, 0, 0, 0, 0, 0, 122, 0, 0,
-	0, 327, 0, 0, 0, 376, 0, 0, 529, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 352, 92, 0, 0, 0, 100,
-	0, 0, 252, 252, 0, 0, 402, 0, 120, 117,
-	0, 0, 0, 0, 0, 0, 0, 327, 98, 556,
-	0, 0, 381, 252, 556, 102, 0, 0, 0, 556,
-	0, 575, 94, 0, 0, 556, 556, 197, 196, 0,
-	0, 791, 792, 198, 206, 205, 207, 208, 209, 0,
-	0, 0, 0, 0, 354, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 111, 0, 85, 355, 86, 353,
-	356, 357, 358, 359, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 351, 0, 0, 93, 70, 344, 202,
-	211, 210, 201, 200, 203, 199, 327, 0, 0, 0,
-	0, 0, 0, 0, 252, 252, 0, 0, 252, 851,
-	520, 0, 0, 202, 211, 210, 201, 200, 203, 199,
-	0, 0, 0, 0, 0, 0, 579, 0, 0, 0,
-	0, 402, 402, 0, 0, 0, 0, 0, 0, 402,
-	0, 0, 102, 75, 76, 77, 0, 99, 79, 94,
-	97, 95, 96, 0, 71, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 0, 118, 0, 0, 112, 0,
-	0, 197, 196, 0, 0, 0, 0, 198, 206, 205,
-	207, 208, 209, 0, 0, 0, 252, 252, 0, 0,
-	0, 0, 0, 0, 0, 197, 196, 0, 0, 0,
-	556, 198, 206, 205, 207, 208, 209, 0, 91, 327,
-	0, 0, 92, 0, 0, 0, 100, 0, 0, 0,
-	0, 0, 0, 0, 0, 120, 117, 0, 0, 0,
-	402, 0, 402, 402, 402, 98, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 575,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 556, 0, 0, 0, 0, 0, 0,
-	0, 354, 0, 103, 104, 105, 0, 106, 107, 108,
-	109, 111, 0, 85, 355, 86, 353, 356, 357, 358,
-	359, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	351, 0, 0, 93, 70, 0, 0, 0, 0, 0,
-	0, 0, 402, 0, 402, 402, 402, 0, 1027, 1028,
-	327, 0, 0, 0, 0, 0, 0, 327, 102, 75,
-	76, 77, 0, 99, 79, 94, 97, 95, 96, 22,
-	71, 0, 0, 0, 35, 36, 0, 0, 0, 0,
-	0, 28, 0, 0, 112, 0, 29, 44, 0, 30,
-	0, 0, 0, 0, 0, 0, 0, 1060, 1061, 0,
-	0, 0, 352, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
-	327, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 74, 0, 0, 0, 0, 0,
-	0, 1023, 1022, 0, 875, 0, 0, 0, 0, 0,
-	32, 98, 0, 39, 37, 38, 34, 40, 0, 0,
-	0, 0, 0, 0, 0, 42, 43, 466, 467, 0,
-	47, 48, 49, 50, 41, 52, 53, 54, 45, 51,
-	55, 0, 0, 0, 876, 0, 0, 31, 46, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 22, 71, 0, 0, 0, 35, 36, 0,
-	0, 327, 0, 0, 28, 0, 0, 112, 0, 29,
-	44, 0, 30, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 0, 74, 0, 0,
-	0, 0, 0, 0, 462, 461, 0, 72, 0, 0,
-	0, 0, 0, 32, 98, 0, 39, 37, 38, 34,
-	40, 0, 0, 0, 0, 0, 0, 0, 42, 43,
-	466, 467, 73, 47, 48, 49, 50, 41, 52, 53,
-	54, 45, 51, 55, 0, 0, 0, 0, 0, 0,
-	31, 46, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 70, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 22, 71, 0, 0, 0,
-	35, 36, 0, 0, 0, 0, 0, 28, 0, 0,
-	112, 0, 29, 44, 0, 30, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	74, 0, 0, 0, 0, 0, 0, 872, 871, 0,
-	875, 0, 0, 0, 0, 0, 32, 98, 0, 39,
-	37, 38, 34, 40, 0, 0, 0, 0, 0, 0,
-	0, 42, 43, 0, 0, 0, 47, 48, 49, 50,
-	41, 52, 53, 54, 45, 51, 55, 0, 0, 0,
-	876, 0, 0, 31, 46, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 102, 75, 76,
-	77, 0, 99, 79, 94, 97, 95, 96, 22, 71,
-	0, 0, 0, 35, 36, 0, 0, 0, 0, 0,
-	28, 0, 0, 112, 0, 29, 44, 0, 30, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
-	0, 100, 0, 74, 0, 0, 0, 0, 0, 0,
-	24, 23, 0, 72, 0, 0, 0, 0, 0, 32,
-	98, 0, 39, 37, 38, 34, 40, 0, 0, 0,
-	0, 0, 0, 0, 42, 43, 0, 0, 73, 47,
+var yyAct = [...]int16{
+	127, 21, 1190, 1157, 1050, 1202, 1125, 358, 91, 1189,
+	539, 925, 1126, 653, 120, 33, 478, 939, 1017, 1016,
+	753, 276, 125, 27, 118, 470, 1067, 1018, 191, 192,
+	789, 586, 1101, 66, 938, 726, 391, 721, 526, 595,
+	693, 597, 167, 611, 890, 168, 169, 392, 172, 173,
+	174, 176, 613, 180, 607, 614, 590, 672, 431, 718,
+	255, 1094, 567, 689, 1, 145, 145, 684, 148, 243,
+	477, 26, 185, 177, 189, 476, 25, 397, 5, 356,
+	244, 455, 461, 550, 249, 549, 545, 525, 353, 727,
+	134, 401, 268, 186, 253, 188, 227, 81, 79, 196,
+	408, 422, 142, 236, 516, 220, 980, 190, 219, 300,
+	69, 553, 1053, 554, 555, 556, 548, 1105, 21, 551,
+	185, 317, 906, 907, 402, 553, 413, 554, 555, 556,
+	548, 220, 33, 551, 219, 146, 504, 219, 582, 219,
+	306, 239, 484, 188, 472, 3, 154, 242, 745, 746,
+	187, 405, 258, 707, 708, 246, 914, 170, 899, 1100,
+	832, 188, 807, 806, 297, 298, 128, 777, 743, 113,
+	742, 739, 717, 715, 135, 273, 131, 709, 705, 133,
+	679, 130, 237, 308, 132, 75, 240, 623, 26, 620,
+	318, 695, 502, 25, 269, 209, 95, 200, 187, 421,
+	183, 564, 416, 211, 210, 212, 213, 214, 322, 183,
+	281, 288, 115, 318, 1225, 332, 187, 220, 318, 1224,
+	219, 321, 318, 1146, 1145, 1144, 320, 805, 552, 1143,
+	1119, 1117, 254, 1116, 370, 371, 333, 1133, 1115, 21,
+	277, 698, 279, 318, 1114, 1112, 390, 209, 1087, 1186,
+	305, 115, 1086, 33, 1084, 211, 210, 212, 213, 214,
+	75, 1083, 3, 104, 105, 106, 1081, 260, 261, 262,
+	263, 264, 265, 1079, 409, 333, 135, 1078, 1066, 399,
+	1065, 1047, 1046, 206, 400, 1044, 205, 204, 207, 203,
+	1041, 993, 447, 449, 452, 454, 457, 407, 413, 981,
+	327, 457, 462, 382, 937, 908, 462, 462, 905, 26,
+	469, 870, 869, 868, 25, 145, 867, 21, 866, 280,
+	865, 861, 838, 405, 258, 275, 831, 128, 817, 468,
+	816, 33, 137, 396, 809, 808, 776, 773, 772, 493,
+	771, 113, 764, 760, 741, 495, 496, 482, 738, 716,
+	565, 576, 400, 414, 714, 186, 658, 188, 651, 419,
+	650, 610, 426, 978, 649, 636, 604, 418, 209, 201,
+	200, 501, 499, 424, 425, 202, 211, 210, 212, 213,
+	214, 515, 460, 3, 438, 519, 497, 21, 466, 467,
+	487, 446, 442, 444, 537, 538, 349, 432, 137, 368,
+	369, 33, 427, 543, 383, 465, 313, 348, 350, 517,
+	378, 314, 187, 463, 464, 312, 428, 209, 573, 95,
+	139, 1185, 486, 1082, 1080, 490, 489, 212, 213, 214,
+	445, 188, 443, 1076, 137, 104, 105, 106, 188, 260,
+	261, 262, 263, 264, 265, 617, 409, 619, 1071, 514,
+	1027, 530, 1025, 1024, 1023, 188, 1022, 26, 1020, 577,
+	986, 972, 25, 967, 188, 965, 188, 437, 963, 407,
+	961, 960, 951, 522, 544, 626, 949, 616, 572, 520,
+	521, 921, 269, 920, 917, 912, 187, 622, 563, 837,
+	836, 710, 400, 566, 655, 632, 585, 578, 627, 560,
+	511, 510, 509, 508, 507, 506, 505, 417, 143, 138,
+	592, 571, 579, 581, 580, 583, 584, 254, 241, 605,
+	235, 609, 234, 593, 429, 406, 654, 137, 21, 663,
+	224, 3, 559, 223, 222, 21, 498, 488, 221, 441,
+	294, 188, 33, 633, 430, 229, 292, 706, 996, 33,
+	625, 117, 282, 183, 209, 512, 513, 791, 1164, 677,
+	673, 699, 964, 962, 376, 523, 793, 884, 874, 780,
+	138, 959, 954, 654, 774, 1059, 936, 103, 702, 872,
+	935, 844, 1166, 143, 1165, 1033, 1031, 536, 957, 956,
+	875, 638, 662, 674, 958, 953, 187, 703, 26, 666,
+	284, 873, 955, 25, 952, 26, 871, 864, 95, 711,
+	25, 678, 1019, 669, 661, 1037, 790, 713, 598, 657,
+	113, 457, 535, 440, 462, 1227, 21, 1211, 225, 21,
+	21, 683, 734, 720, 226, 692, 377, 691, 1199, 1198,
+	33, 150, 1193, 33, 33, 675, 1177, 1176, 656, 1168,
+	1148, 751, 704, 1140, 755, 756, 188, 712, 283, 161,
+	162, 775, 1132, 1129, 1061, 293, 1058, 1057, 1007, 788,
+	995, 291, 3, 1192, 948, 947, 1162, 694, 942, 3,
+	641, 642, 643, 644, 645, 670, 858, 543, 285, 286,
+	792, 640, 857, 783, 660, 624, 646, 647, 648, 149,
+	531, 529, 747, 1192, 1161, 151, 749, 1191, 796, 1137,
+	1136, 759, 1052, 1128, 104, 105, 106, 1127, 107, 108,
+	109, 110, 111, 112, 769, 758, 941, 757, 629, 152,
+	940, 159, 160, 163, 164, 628, 786, 528, 841, 316,
+	1191, 527, 815, 1174, 1127, 850, 785, 819, 594, 1092,
+	701, 794, 940, 21, 855, 856, 803, 823, 21, 21,
+	527, 720, 388, 103, 386, 1228, 1195, 33, 811, 1169,
+	814, 1156, 33, 33, 822, 820, 616, 849, 853, 824,
+	616, 1131, 810, 859, 860, 21, 654, 1062, 390, 116,
+	852, 877, 1049, 944, 843, 847, 848, 784, 846, 33,
+	752, 534, 238, 1230, 1171, 1158, 113, 1064, 902, 1051,
+	787, 754, 797, 799, 384, 245, 1218, 1217, 1197, 1196,
+	1154, 888, 1014, 1013, 946, 945, 750, 1128, 765, 766,
+	767, 768, 770, 188, 941, 528, 1234, 883, 1226, 1187,
+	1167, 188, 1108, 1060, 188, 21, 882, 900, 880, 881,
+	782, 1220, 1215, 102, 1152, 26, 21, 1011, 664, 33,
+	25, 1223, 1203, 1207, 208, 1236, 188, 1206, 919, 918,
+	33, 1221, 1222, 1205, 1122, 779, 1203, 932, 75, 274,
+	915, 943, 834, 826, 229, 827, 828, 100, 904, 1219,
+	1088, 922, 652, 984, 373, 813, 911, 1106, 372, 913,
+	104, 105, 106, 1054, 107, 108, 109, 110, 111, 112,
+	330, 485, 654, 969, 329, 331, 825, 982, 910, 654,
+	968, 924, 1183, 319, 987, 970, 894, 896, 423, 3,
+	997, 694, 188, 75, 999, 1003, 21, 21, 973, 974,
+	979, 21, 1010, 1232, 988, 21, 1204, 1004, 1005, 75,
+	33, 33, 75, 998, 989, 33, 228, 1201, 903, 33,
+	1204, 101, 1001, 1002, 375, 374, 1009, 1000, 932, 932,
+	1012, 337, 336, 188, 1008, 991, 992, 75, 271, 1028,
+	909, 1030, 1032, 730, 818, 731, 732, 985, 1029, 927,
+	301, 1029, 1034, 654, 1036, 295, 1181, 21, 1042, 270,
+	271, 272, 690, 1182, 931, 898, 1184, 802, 1048, 891,
+	892, 33, 553, 801, 554, 555, 729, 75, 1043, 688,
+	1039, 687, 394, 977, 694, 393, 394, 1038, 1015, 932,
+	1110, 1056, 1069, 1063, 686, 553, 1055, 554, 555, 556,
+	1070, 395, 1072, 1073, 1074, 1075, 1077, 681, 682, 685,
+	21, 1026, 1093, 21, 553, 1029, 554, 555, 556, 548,
+	21, 1090, 551, 21, 33, 856, 1021, 33, 188, 1102,
+	879, 1107, 876, 546, 33, 247, 1068, 33, 696, 916,
+	927, 927, 932, 835, 598, 737, 736, 302, 1109, 744,
+	728, 1111, 932, 21, 983, 931, 931, 654, 1113, 1134,
+	1120, 1121, 1124, 1118, 1130, 886, 887, 33, 67, 141,
+	1029, 188, 722, 723, 724, 725, 140, 199, 543, 1006,
+	862, 1142, 1135, 1089, 851, 932, 845, 1141, 21, 1151,
+	842, 654, 21, 1149, 21, 432, 1147, 21, 21, 1150,
+	740, 927, 33, 1153, 153, 155, 33, 621, 33, 503,
+	1102, 33, 33, 1102, 1102, 82, 931, 21, 1170, 1175,
+	932, 251, 21, 21, 932, 458, 1123, 266, 250, 252,
+	21, 33, 1093, 1102, 398, 21, 33, 33, 1102, 1102,
+	126, 1188, 415, 315, 33, 1085, 667, 251, 420, 33,
+	304, 1102, 21, 1214, 927, 1155, 21, 1096, 1159, 1160,
+	1212, 1210, 932, 303, 927, 299, 33, 178, 1102, 931,
+	33, 129, 1102, 1208, 1209, 98, 96, 96, 1172, 931,
+	1229, 804, 98, 1178, 1179, 1233, 95, 184, 195, 21,
+	459, 1175, 198, 436, 68, 144, 1194, 927, 1237, 217,
+	218, 1173, 1091, 33, 854, 1102, 433, 434, 385, 231,
+	232, 10, 931, 1213, 9, 435, 553, 1216, 554, 555,
+	556, 548, 891, 892, 551, 568, 8, 7, 387, 569,
+	103, 63, 927, 354, 355, 184, 927, 404, 1096, 410,
+	126, 1096, 1096, 587, 267, 412, 403, 931, 599, 602,
+	1235, 931, 256, 259, 178, 1231, 258, 1200, 1180, 1163,
+	90, 1096, 62, 61, 65, 57, 1096, 1096, 64, 59,
+	58, 885, 680, 113, 927, 541, 540, 56, 197, 1096,
+	676, 500, 671, 668, 889, 248, 893, 6, 20, 931,
+	19, 70, 696, 158, 17, 615, 1096, 612, 16, 310,
+	1096, 456, 15, 14, 821, 719, 589, 588, 206, 216,
+	215, 205, 204, 207, 203, 11, 324, 325, 326, 18,
+	328, 13, 12, 335, 1097, 338, 339, 340, 341, 342,
+	343, 344, 345, 1096, 928, 103, 178, 351, 357, 206,
+	216, 215, 205, 204, 207, 203, 1095, 926, 473, 471,
+	4, 379, 2, 0, 0, 0, 0, 178, 0, 0,
+	0, 389, 0, 0, 103, 0, 0, 104, 105, 106,
+	0, 107, 108, 109, 110, 111, 112, 0, 113, 587,
+	0, 975, 0, 976, 0, 696, 0, 0, 0, 357,
+	258, 587, 0, 209, 201, 200, 178, 0, 439, 587,
+	202, 211, 210, 212, 213, 214, 0, 113, 311, 307,
+	0, 0, 0, 0, 587, 85, 75, 0, 0, 0,
+	0, 0, 0, 178, 209, 201, 200, 0, 0, 0,
+	0, 202, 211, 210, 212, 213, 214, 0, 0, 0,
+	307, 0, 0, 0, 0, 492, 0, 494, 147, 178,
+	0, 0, 0, 156, 157, 0, 165, 166, 0, 0,
+	0, 0, 171, 0, 1040, 178, 175, 0, 179, 0,
+	181, 182, 104, 105, 106, 0, 107, 108, 109, 110,
+	111, 112, 0, 0, 178, 178, 206, 216, 215, 205,
+	204, 207, 203, 0, 178, 0, 0, 0, 0, 0,
+	389, 104, 105, 106, 532, 107, 108, 109, 110, 111,
+	112, 542, 0, 0, 547, 233, 0, 60, 0, 569,
+	0, 0, 0, 0, 587, 0, 0, 0, 0, 587,
+	0, 0, 103, 0, 0, 0, 0, 0, 829, 830,
+	0, 0, 0, 0, 0, 136, 0, 257, 0, 257,
+	0, 0, 0, 0, 0, 257, 278, 257, 258, 0,
+	0, 0, 0, 0, 0, 287, 257, 289, 290, 0,
+	0, 209, 201, 200, 296, 113, 0, 0, 202, 211,
+	210, 212, 213, 214, 0, 0, 0, 878, 0, 0,
+	0, 126, 0, 0, 0, 0, 0, 0, 0, 206,
+	216, 215, 205, 204, 207, 203, 0, 634, 0, 0,
+	230, 0, 0, 0, 323, 0, 637, 0, 357, 0,
+	178, 0, 0, 0, 0, 178, 178, 178, 0, 0,
+	0, 0, 0, 0, 0, 346, 0, 0, 360, 0,
+	659, 0, 0, 0, 0, 0, 0, 0, 0, 665,
+	0, 0, 380, 206, 216, 215, 205, 204, 207, 203,
+	0, 0, 0, 0, 0, 0, 0, 257, 257, 104,
+	105, 106, 0, 260, 261, 262, 263, 264, 265, 178,
+	763, 0, 257, 257, 209, 201, 200, 0, 0, 360,
+	0, 202, 211, 210, 212, 213, 214, 0, 0, 0,
+	524, 0, 0, 0, 0, 0, 136, 448, 450, 451,
+	453, 206, 216, 215, 205, 204, 207, 203, 0, 0,
+	257, 0, 0, 0, 334, 0, 587, 0, 0, 0,
+	0, 0, 0, 481, 0, 483, 0, 0, 209, 201,
+	200, 0, 0, 334, 334, 202, 211, 210, 212, 213,
+	214, 761, 0, 762, 0, 0, 0, 178, 178, 178,
+	178, 178, 0, 0, 0, 0, 0, 0, 0, 411,
+	103, 778, 381, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 411, 0, 0, 206, 216,
+	215, 205, 204, 207, 203, 542, 209, 201, 200, 0,
+	587, 795, 178, 202, 211, 210, 212, 213, 214, 0,
+	0, 360, 307, 113, 0, 0, 0, 0, 0, 557,
+	0, 0, 812, 257, 178, 0, 561, 0, 0, 0,
+	0, 570, 257, 574, 0, 0, 257, 257, 0, 0,
+	0, 0, 0, 0, 833, 570, 591, 0, 334, 596,
+	570, 570, 603, 0, 334, 334, 606, 608, 0, 0,
+	0, 618, 206, 216, 215, 205, 204, 207, 203, 0,
+	389, 0, 0, 209, 201, 200, 0, 0, 0, 863,
+	202, 211, 210, 212, 213, 214, 0, 0, 1045, 0,
+	334, 518, 518, 518, 0, 0, 0, 0, 0, 0,
+	630, 631, 0, 0, 608, 0, 0, 104, 105, 106,
+	0, 107, 108, 109, 110, 111, 112, 0, 360, 639,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 411, 0,
+	136, 0, 136, 136, 0, 0, 0, 209, 201, 200,
+	0, 0, 0, 923, 202, 211, 210, 212, 213, 214,
+	0, 0, 1035, 0, 0, 0, 0, 0, 257, 0,
+	0, 0, 0, 0, 697, 0, 0, 0, 700, 0,
+	0, 570, 206, 216, 215, 205, 204, 207, 203, 0,
+	0, 0, 0, 570, 966, 0, 0, 0, 0, 0,
+	0, 570, 384, 0, 0, 0, 0, 971, 0, 0,
+	596, 0, 0, 0, 733, 0, 570, 735, 0, 103,
+	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 990, 0, 334, 0, 748, 0, 0,
+	0, 0, 0, 0, 0, 116, 126, 0, 0, 0,
+	206, 216, 215, 205, 204, 207, 203, 0, 0, 0,
+	601, 0, 113, 0, 0, 0, 0, 209, 201, 200,
+	411, 0, 0, 0, 202, 211, 210, 212, 213, 214,
+	0, 0, 334, 0, 0, 0, 206, 216, 215, 205,
+	204, 207, 203, 0, 0, 360, 103, 0, 0, 0,
+	0, 0, 0, 257, 257, 0, 0, 206, 216, 215,
+	205, 204, 207, 203, 0, 0, 0, 0, 0, 0,
+	562, 570, 0, 0, 0, 257, 570, 0, 0, 0,
+	0, 570, 0, 591, 0, 209, 201, 200, 0, 113,
+	570, 570, 202, 211, 210, 212, 213, 214, 0, 0,
+	994, 0, 839, 840, 0, 608, 104, 105, 106, 0,
+	107, 108, 109, 110, 111, 112, 103, 389, 347, 0,
+	334, 209, 201, 200, 0, 0, 0, 0, 202, 211,
+	210, 212, 213, 214, 0, 178, 950, 0, 0, 0,
+	600, 0, 209, 201, 200, 0, 0, 0, 0, 202,
+	211, 210, 212, 213, 214, 411, 411, 781, 0, 113,
+	0, 0, 0, 411, 0, 126, 0, 257, 257, 0,
+	0, 0, 257, 901, 0, 0, 542, 0, 0, 0,
+	0, 0, 0, 104, 105, 106, 0, 107, 108, 109,
+	110, 111, 112, 0, 0, 0, 0, 0, 596, 0,
+	0, 0, 608, 0, 103, 76, 77, 78, 0, 100,
+	80, 95, 98, 96, 97, 22, 72, 0, 0, 0,
+	35, 36, 0, 0, 389, 0, 0, 28, 0, 0,
+	116, 0, 29, 44, 0, 30, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 334, 413, 113, 0, 0,
+	0, 0, 0, 104, 105, 106, 0, 107, 108, 109,
+	110, 111, 112, 0, 257, 257, 411, 0, 411, 411,
+	411, 405, 258, 0, 411, 92, 0, 0, 570, 93,
+	0, 0, 0, 101, 0, 75, 608, 608, 0, 113,
+	0, 0, 1099, 1098, 103, 933, 0, 0, 0, 0,
+	0, 32, 99, 0, 39, 37, 38, 34, 40, 0,
+	0, 897, 0, 0, 0, 0, 42, 43, 479, 480,
+	0, 47, 48, 49, 50, 41, 52, 53, 54, 45,
+	51, 55, 0, 0, 0, 934, 0, 113, 608, 31,
+	46, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 570, 86, 89, 87, 88, 114, 0, 0,
+	0, 0, 0, 411, 0, 411, 411, 411, 0, 83,
+	84, 334, 0, 0, 94, 71, 0, 0, 334, 0,
+	0, 0, 0, 104, 105, 106, 0, 260, 261, 262,
+	263, 264, 265, 0, 409, 0, 0, 0, 103, 76,
+	77, 78, 0, 100, 80, 95, 98, 96, 97, 22,
+	72, 0, 0, 0, 35, 36, 103, 407, 0, 1103,
+	1104, 28, 0, 0, 116, 0, 29, 44, 0, 30,
+	0, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 113, 0, 0, 0, 0, 411, 0, 0, 0,
+	0, 0, 334, 0, 0, 0, 0, 598, 0, 113,
+	0, 0, 0, 0, 0, 0, 413, 0, 0, 92,
+	0, 1138, 1139, 93, 0, 0, 360, 101, 0, 75,
+	0, 0, 0, 103, 0, 0, 475, 474, 0, 73,
+	0, 405, 258, 0, 0, 32, 99, 0, 39, 37,
+	38, 34, 40, 0, 0, 0, 0, 558, 0, 113,
+	42, 43, 479, 480, 74, 47, 48, 49, 50, 41,
+	52, 53, 54, 45, 51, 55, 113, 0, 0, 0,
+	0, 895, 0, 31, 46, 104, 105, 106, 0, 107,
+	108, 109, 110, 111, 112, 115, 0, 86, 89, 87,
+	88, 114, 0, 104, 105, 106, 334, 107, 108, 109,
+	110, 111, 112, 83, 84, 0, 0, 0, 94, 71,
+	103, 76, 77, 78, 0, 100, 80, 95, 98, 96,
+	97, 22, 72, 0, 0, 0, 35, 36, 103, 0,
+	334, 0, 0, 28, 0, 95, 116, 0, 29, 44,
+	0, 30, 0, 104, 105, 106, 0, 260, 261, 262,
+	263, 264, 265, 113, 409, 0, 0, 0, 0, 0,
+	104, 105, 106, 0, 107, 108, 109, 110, 111, 112,
+	0, 113, 0, 0, 0, 0, 0, 407, 413, 0,
+	0, 92, 0, 0, 0, 93, 0, 0, 0, 101,
+	0, 75, 0, 0, 0, 0, 0, 103, 930, 929,
+	0, 933, 0, 405, 258, 98, 0, 32, 99, 0,
+	39, 37, 38, 34, 40, 0, 0, 0, 0, 0,
+	0, 113, 42, 43, 0, 0, 0, 47, 48, 49,
+	50, 41, 52, 53, 54, 45, 51, 55, 0, 0,
+	113, 934, 0, 800, 0, 31, 46, 104, 105, 106,
+	0, 107, 108, 109, 110, 111, 112, 115, 0, 86,
+	89, 87, 88, 114, 0, 104, 105, 106, 0, 107,
+	108, 109, 110, 111, 112, 83, 84, 0, 0, 0,
+	94, 71, 103, 76, 77, 78, 0, 100, 80, 95,
+	98, 96, 97, 22, 72, 0, 0, 0, 35, 36,
+	0, 0, 0, 0, 0, 28, 0, 0, 116, 0,
+	29, 44, 0, 30, 0, 104, 105, 106, 0, 260,
+	261, 262, 263, 264, 265, 113, 409, 0, 0, 0,
+	0, 0, 0, 0, 104, 105, 106, 0, 107, 108,
+	109, 110, 111, 112, 0, 0, 0, 0, 0, 407,
+	413, 0, 0, 92, 0, 0, 0, 93, 0, 0,
+	0, 101, 0, 75, 0, 0, 0, 0, 0, 0,
+	24, 23, 0, 73, 0, 405, 258, 0, 0, 32,
+	99, 0, 39, 37, 38, 34, 40, 0, 0, 0,
+	0, 0, 0, 113, 42, 43, 0, 0, 74, 47,
 	48, 49, 50, 41, 52, 53, 54, 45, 51, 55,
-	0, 0, 0, 0, 0, 0, 31, 46, 103, 104,
-	105, 0, 106, 107, 108, 109, 111, 0, 85, 88,
-	86, 87, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 0, 0, 0, 93, 70,
-	102, 75, 76, 77, 0, 99, 79, 94, 97, 95,
-	96, 0, 71, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 118, 0, 0, 112, 0, 0, 0,
-	0, 0, 0, 0, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
-	112, 0, 0, 0, 0, 0, 91, 0, 0, 0,
-	92, 0, 0, 0, 100, 0, 0, 0, 0, 0,
-	0, 0, 0, 120, 117, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	0, 0, 0, 0, 0, 0, 0, 120, 117, 0,
-	0, 0, 0, 0, 0, 0, 190, 98, 0, 354,
-	0, 103, 104, 105, 0, 106, 107, 108, 109, 111,
-	0, 85, 355, 86, 353, 356, 357, 358, 359, 0,
-	0, 0, 0, 0, 0, 0, 82, 83, 0, 0,
-	0, 93, 70, 189, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
+	0, 0, 0, 0, 0, 798, 0, 31, 46, 104,
+	105, 106, 0, 107, 108, 109, 110, 111, 112, 115,
+	0, 86, 89, 87, 88, 114, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 0,
+	0, 0, 94, 71, 103, 76, 77, 78, 0, 100,
+	80, 95, 98, 96, 97, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 0,
+	116, 0, 0, 0, 0, 0, 0, 104, 105, 106,
+	0, 260, 261, 262, 263, 264, 265, 113, 409, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 102, 75, 76,
-	77, 0, 99, 79, 94, 97, 95, 96, 0, 71,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 112, 0, 0,
-	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
-	0, 100, 0, 0, 0, 0, 0, 0, 0, 0,
-	120, 117, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 267, 0, 0, 0,
-	0, 0, 0, 0, 120, 117, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 119, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 111, 0, 85, 88,
-	86, 87, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 351, 0, 0, 93, 70,
-	119, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 70, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
-	112, 0, 0, 0, 0, 0, 0, 0, 102, 75,
-	76, 77, 0, 99, 79, 94, 97, 95, 96, 0,
-	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 118, 0, 0, 112, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	74, 0, 0, 0, 0, 0, 0, 120, 117, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 117, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 119, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
+	0, 407, 0, 0, 0, 92, 0, 0, 0, 93,
+	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 99, 0, 0, 0, 0, 0, 0, 103,
+	76, 77, 78, 0, 100, 80, 95, 98, 96, 97,
+	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 0, 0, 116, 0, 0, 0, 362,
+	0, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 113, 86, 363, 87, 361, 364, 365, 366,
+	367, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 359, 0, 0, 94, 71, 352, 0, 0, 0,
+	92, 0, 0, 0, 93, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 121, 0,
+	0, 0, 0, 0, 0, 0, 0, 99, 0, 0,
+	0, 0, 0, 0, 103, 76, 77, 78, 0, 100,
+	80, 95, 98, 96, 97, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 0,
+	116, 0, 0, 0, 362, 0, 104, 105, 106, 0,
+	107, 108, 109, 110, 111, 112, 115, 113, 86, 363,
+	87, 361, 364, 365, 366, 367, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 359, 0, 0, 94,
+	71, 0, 0, 0, 0, 92, 0, 0, 0, 93,
+	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 99, 0, 0, 0, 0, 0, 0, 103,
+	76, 77, 78, 0, 100, 80, 95, 98, 96, 97,
+	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 0, 0, 116, 0, 0, 0, 362,
+	0, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 113, 86, 363, 87, 361, 364, 365, 366,
+	367, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 0, 0, 0, 94, 71, 0, 0, 0, 0,
+	92, 0, 0, 0, 93, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 121, 0,
+	0, 0, 0, 0, 0, 0, 194, 99, 0, 0,
+	0, 0, 0, 0, 103, 76, 77, 78, 0, 100,
+	80, 95, 98, 96, 97, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 0,
+	116, 0, 0, 0, 193, 0, 104, 105, 106, 0,
+	107, 108, 109, 110, 111, 112, 115, 113, 86, 89,
+	87, 88, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 0, 0, 0, 94,
+	71, 0, 0, 0, 0, 92, 0, 0, 0, 93,
+	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 99, 0, 0, 0, 0, 0, 0, 103,
+	76, 77, 78, 0, 100, 80, 95, 98, 96, 97,
+	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 0, 0, 116, 0, 0, 0, 123,
+	0, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 113, 86, 89, 87, 88, 114, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 359, 0, 0, 94, 71, 0, 0, 0, 0,
+	92, 0, 0, 0, 93, 0, 0, 0, 101, 274,
+	0, 0, 0, 0, 0, 0, 0, 124, 121, 0,
+	0, 0, 0, 0, 0, 0, 0, 99, 0, 0,
+	0, 0, 0, 0, 103, 76, 77, 78, 0, 100,
+	80, 95, 98, 96, 97, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 0,
+	116, 0, 0, 0, 123, 0, 104, 105, 106, 0,
+	107, 108, 109, 110, 111, 112, 115, 113, 86, 89,
+	87, 88, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 0, 0, 0, 94,
+	71, 0, 0, 0, 0, 92, 0, 0, 0, 93,
+	0, 0, 0, 101, 0, 75, 0, 0, 0, 0,
+	0, 0, 124, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 99, 0, 0, 0, 0, 0, 0, 103,
+	76, 77, 78, 0, 100, 80, 95, 98, 96, 97,
+	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 0, 0, 116, 0, 0, 0, 123,
+	0, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 113, 86, 89, 87, 88, 114, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 0, 0, 0, 94, 71, 0, 0, 0, 0,
+	92, 0, 0, 0, 93, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 121, 0,
+	0, 0, 0, 0, 0, 0, 0, 99, 0, 0,
+	0, 0, 0, 0, 103, 76, 77, 78, 0, 100,
+	80, 95, 98, 96, 97, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 0,
+	116, 0, 0, 0, 123, 0, 104, 105, 106, 0,
+	107, 108, 109, 110, 111, 112, 115, 113, 86, 89,
+	87, 88, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 0, 0, 0, 94,
+	71, 0, 0, 0, 0, 92, 0, 0, 0, 93,
+	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 99, 0, 0, 0, 0, 0, 0, 103,
+	76, 77, 78, 0, 100, 80, 95, 98, 96, 97,
+	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 0, 0, 575, 0, 0, 0, 123,
+	0, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 113, 86, 89, 87, 88, 114, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 0, 0, 0, 94, 119, 0, 0, 0, 0,
+	92, 0, 0, 0, 93, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 121, 0,
+	0, 0, 413, 0, 0, 0, 0, 99, 0, 0,
+	0, 0, 0, 0, 103, 76, 309, 78, 0, 100,
+	80, 95, 98, 96, 97, 0, 72, 405, 258, 206,
+	216, 215, 205, 204, 207, 203, 0, 122, 0, 0,
+	116, 0, 0, 0, 123, 113, 104, 105, 106, 0,
+	107, 108, 109, 110, 111, 112, 115, 113, 86, 89,
+	87, 88, 114, 0, 0, 413, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 0, 0, 0, 94,
+	71, 0, 0, 75, 0, 92, 0, 0, 0, 93,
+	405, 258, 0, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 121, 0, 0, 0, 0, 113, 0,
+	0, 0, 99, 0, 209, 201, 200, 0, 0, 0,
+	0, 202, 211, 210, 212, 213, 214, 0, 0, 206,
+	635, 215, 205, 204, 207, 203, 0, 0, 0, 104,
+	105, 106, 0, 260, 261, 262, 263, 264, 265, 123,
+	409, 104, 105, 106, 0, 107, 108, 109, 110, 111,
+	112, 115, 0, 86, 89, 87, 88, 114, 0, 0,
+	0, 206, 216, 407, 205, 204, 207, 203, 0, 83,
+	84, 0, 0, 0, 94, 71, 0, 0, 0, 0,
+	0, 206, 216, 215, 205, 204, 207, 203, 0, 0,
+	0, 0, 104, 105, 106, 0, 260, 261, 262, 263,
+	264, 265, 533, 409, 209, 201, 200, 0, 0, 0,
+	0, 202, 211, 210, 212, 213, 214, 206, 491, 215,
+	205, 204, 207, 203, 0, 0, 407, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 119, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 102, 75, 76, 77, 0,
-	99, 79, 94, 97, 95, 96, 0, 71, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	0, 561, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 0, 0, 0, 0,
-	0, 0, 0, 0, 120, 117, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 0, 92, 0, 0, 0, 100,
-	0, 0, 0, 0, 0, 0, 0, 0, 120, 117,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	119, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 202, 612, 210,
-	201, 200, 203, 199, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 115, 119, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 111, 0, 85, 88, 86, 87,
-	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 0, 0, 0, 93, 70, 102, 75,
-	302, 77, 0, 99, 79, 94, 97, 95, 96, 0,
-	71, 202, 478, 210, 201, 200, 203, 199, 0, 0,
-	0, 118, 0, 0, 112, 0, 0, 0, 0, 197,
-	196, 0, 0, 0, 0, 198, 206, 205, 207, 208,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 201, 200, 0,
+	0, 0, 0, 202, 211, 210, 212, 213, 214, 0,
+	0, 0, 0, 0, 0, 0, 209, 201, 200, 0,
+	0, 0, 0, 202, 211, 210, 212, 213, 214, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 117, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 197, 196, 0, 0, 0, 0, 198,
-	206, 205, 207, 208, 209, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70,
+	0, 0, 209, 201, 200, 0, 0, 0, 0, 202,
+	211, 210, 212, 213, 214,
 }
-var yyPact = [...]int{
 
-	2923, -1000, 301, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	2828, -1000, 384, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3677, 3514, -1000, -1000, 96, 333, 1036,
-	1031, 273, 2131, -1000, 588, 1190, 1185, 562, 562, 567,
-	562, 3514, -1000, -1000, 3514, 3514, 1636, 3514, 3514, 3514,
-	3514, 3514, 3514, -1000, 562, 562, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 310, -1000, -1000, -1000, -1000,
-	3480, -1000, 3120, 1200, 1044, -1000, -1000, -1000, -1000, -1000,
-	-1000, 2152, 3514, 3514, -44, 279, 278, 277, 274, -1000,
-	374, 196, 3514, 3514, -1000, -1000, -1000, -1000, 562, -1000,
+	-1000, -1000, -1000, 3840, 3735, -1000, -1000, 157, 395, 1080,
+	1073, 408, 2674, -1000, 597, 1203, 1204, 2380, 2380, 622,
+	2380, 3735, -1000, -1000, 3735, 3735, 2743, 3735, 3735, 3735,
+	3735, 3735, 3735, -1000, 2380, 2380, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 389, -1000, -1000, -1000,
+	-1000, 3630, -1000, 3315, 1222, 1086, -1000, -1000, -1000, -1000,
+	-1000, -1000, 3993, 3735, 3735, -44, 363, 359, 358, 355,
+	-1000, 466, 352, 3735, 3735, -1000, -1000, -1000, -1000, 2380,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	271, 270, -57, 2923, 674, 3480, -1000, 269, 267, 263,
-	3514, 689, 2152, -1000, 993, 1126, 1140, 1607, 1131, 704,
-	898, 778, -1000, 776, 3514, 1607, 562, 1607, -1000, 778,
-	46, 308, -1000, 488, -1000, 562, 1389, 562, 562, 414,
-	413, -1000, 927, -1000, 562, -1000, -1000, -1000, -1000, 3514,
-	3514, 1178, 57, 914, 1002, 1177, -1000, 1175, -1000, -1000,
-	102, -44, -1000, -1000, 1559, -44, -1000, -1000, 3874, 3514,
-	1310, 189, 184, 188, 219, 613, 69, 855, 1194, 263,
-	-1000, -1000, -1000, 45, 562, -1000, 3514, 3514, 3514, 817,
-	3514, 788, 97, 3514, 891, 3514, 3514, 3514, 3514, 3514,
-	3514, 3514, -1000, -1000, 1842, 3317, 3514, 2031, 778, 778,
-	97, 97, 864, 882, -1000, -1000, 56, -1000, 394, 778,
-	3514, 1820, -1000, 2923, 184, 183, 3514, 688, 650, 648,
-	3514, 968, 986, 1173, 1144, 1194, 1263, 1607, 1150, 39,
-	-1000, -1000, -1000, -1000, 258, -1000, -1000, -1000, -1000, 1607,
-	1263, 1174, 32, 875, 875, 875, 2248, -1000, 180, -1000,
-	207, 318, 1120, 3514, 1194, 3514, 477, 286, 253, 250,
-	-1000, -1000, -1000, -1000, 3514, 3514, 3514, 3514, 3514, 1117,
-	-1000, -1000, 1203, 3514, 3514, 1192, 1192, 1607, 3514, 3514,
-	3514, -1000, 3514, 2152, -1000, -1000, -1000, -1000, 1173, 2597,
-	562, 1194, 562, 67, 850, 1044, 236, 42, 20, 20,
-	856, 3820, 3514, 97, 3514, -1000, 3480, -1000, 20, 97,
-	97, 264, 264, -1000, -1000, -1000, 1351, 56, -1000, -1000,
-	178, 3514, 177, 1202, -1000, 176, 31, 1104, -1000, 2152,
-	-1000, -1000, -40, 249, 248, 246, 245, 244, 243, 242,
-	3514, 3283, -1000, -1000, 97, 195, 195, 195, 817, -1000,
-	3514, 1496, -1000, -1000, 637, -1000, 3514, 574, 2923, 573,
-	3514, 2128, 671, 473, 471, 3514, 3514, 3086, 1144, 991,
-	3514, -1000, 30, -1000, 99, 1810, -1000, -1000, -1000, 1830,
-	-1000, 239, 517, 171, 1210, 1607, 3711, 204, 1144, 1263,
-	1389, 219, -1000, 219, 219, -1000, -1000, 238, 1210, 562,
-	776, -1000, 117, 416, 1210, 562, 174, -1000, 2152, 1232,
-	562, 776, 185, 562, -1000, -44, -1000, -44, -44, -1000,
-	-44, -1000, -1000, 26, 1102, 1194, -1000, -1000, -1000, 25,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 571, 300, -1000,
-	-1000, 3677, 3514, -1000, -1000, -1000, -1000, -1000, 606, -1000,
-	601, 562, 562, -1000, 237, 562, -1000, -1000, 3514, 3756,
-	-1000, 20, -1000, -1000, -1000, 173, -1000, 3514, -1000, 2248,
-	562, 3317, 778, 778, 778, 778, 3514, 3514, 3514, 172,
-	169, 168, 830, -1000, 147, -1000, 234, -1000, -1000, 512,
-	165, 3514, 568, 647, 2923, 3514, 746, -1000, -1000, 2152,
-	3514, 2923, 1165, 530, 432, 387, -1000, 24, 975, 2152,
-	-1000, 991, 969, 984, 2152, 956, 953, 939, 926, 402,
-	-1000, -1000, -1000, -1000, -1000, 562, 108, 3514, -1000, 562,
-	97, 1210, -1000, 1173, 23, 288, -35, -1000, -16, 19,
-	-44, -57, 233, 1210, -1000, 1144, -1000, 870, -1000, -1000,
-	870, 1210, 164, 18, 163, 17, -1000, 1141, 562, 1022,
-	-1000, 1210, 1001, 998, -1000, -1000, -1000, 161, -1000, 1100,
-	160, 10, -1000, -1000, 9, 1016, -18, 3514, 562, -1000,
-	3514, 710, 2597, 667, 686, 2597, 2597, 598, 596, 776,
-	159, 56, 3514, -1000, 1462, -1000, -1000, 158, 3514, 3514,
-	3514, 3283, 3514, 155, 154, 153, -1000, -1000, -1000, 97,
-	150, 7, 3514, -1000, 773, 351, 1841, 736, 566, -1000,
-	666, -1000, 1994, 685, -1000, 3514, -1000, -1000, 397, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 3086, 344, -1000, -1000,
-	969, -1000, 3514, 3514, 1792, 1676, 952, -1000, 946, 939,
-	-1000, 1058, 196, 4, -1000, -1000, 2, -1000, -1000, 149,
-	1144, 1210, 3514, -1000, 3514, 1389, 1210, 144, -1000, 141,
-	913, 1210, 1099, 562, -1000, -1000, -1000, 1210, 1210, 140,
-	1, 3514, 139, 562, 3514, 1092, 364, 1078, 1194, 1194,
-	3514, 1073, 1194, -1000, -1000, -1000, -1000, -1000, 2597, 643,
-	3514, 565, 556, 2597, 2597, 138, 1070, 56, -1000, 3514,
-	449, 137, 135, 134, 124, 115, 114, 440, 382, 372,
-	-1000, -1000, 97, 1446, -1000, 990, -1000, -1000, 735, 2923,
-	-1000, -1000, 3514, 432, 959, -1000, 346, -1000, 1057, 993,
-	2152, -1000, 957, 196, 1630, 196, 1313, 1160, 945, -22,
-	402, 3514, 869, -1000, -1000, 2152, 111, -26, 110, 895,
-	868, 232, -1000, 776, -1000, -1000, -1000, 1141, 562, 2152,
-	-1000, -1000, -44, -1000, 776, 2760, 362, -1000, -1000, -1000,
-	1016, -1000, 361, 109, 617, 555, 2597, 665, 708, 703,
-	554, 553, -1000, 228, 1730, 225, 439, 424, 418, 415,
-	404, 379, 224, 218, 343, 217, 339, -1000, 3514, 214,
-	-1000, 722, 397, -1000, -1000, -1000, -1000, -1000, 968, -1000,
-	-1000, 3514, 209, 906, 1630, 196, 957, 196, 501, 402,
-	-1000, -58, 107, 97, -1000, -1000, -1000, 3514, 860, 208,
-	97, -1000, 1210, -1000, -1000, -1000, -1000, 549, 291, -1000,
-	-1000, 3677, 3514, -1000, -1000, 3120, 3514, 2760, 2760, 1061,
-	544, 627, 2597, 3514, 744, -1000, 2597, -1000, -1000, 702,
-	700, 776, -1000, 453, 205, 203, 200, 199, 198, 197,
-	453, 453, 386, 453, 384, 1627, 993, -1000, -1000, 425,
-	2152, 562, -1000, -1000, 906, -1000, 957, 196, -1000, -1000,
-	-1000, -1000, 105, 97, -1000, 1210, -1000, 104, -1000, 2760,
-	663, 683, 595, 33, 844, 1194, -1000, 535, 534, 358,
-	732, 533, -1000, 662, -1000, 680, -1000, -1000, 98, 93,
-	-1000, 994, 982, 453, 453, 453, 453, 453, 453, 83,
-	993, 82, 194, 80, 191, -1000, 79, 1156, 78, -1000,
-	-1000, -1000, -1000, 77, 836, -1000, 2760, 625, 3514, 2434,
-	562, 562, 53, 832, -1000, -1000, 2760, -1000, 731, 2597,
-	-1000, 3514, -1000, -1000, -1000, 973, 3514, 76, 75, 71,
-	66, 64, 62, -1000, -1000, 453, -1000, 453, -1000, -1000,
-	-1000, 834, 97, -1000, 593, 531, 2760, 660, 528, 272,
-	-1000, -1000, 3677, 3514, -1000, -1000, -1000, 589, 587, 562,
-	562, 527, -1000, 719, 3086, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 61, 58, 97, -1000, -1000, 519, 616, 2760,
-	3514, 743, -1000, 2760, 698, 2434, 656, 678, 2434, 2434,
-	583, 576, -1000, -1000, 332, -1000, -1000, -1000, 729, 516,
-	-1000, 655, -1000, 677, -1000, -1000, 2434, 594, 3514, 515,
-	507, 2434, 2434, -1000, 847, -1000, 727, 2760, -1000, 3514,
-	586, 494, 2434, 654, 697, 695, 492, 491, -1000, 842,
-	769, 768, 751, -1000, 715, 485, 532, 2434, 3514, 741,
-	-1000, 2434, -1000, -1000, 693, 590, 828, 759, -1000, 766,
-	750, -1000, -1000, -1000, -1000, 726, 483, -1000, 652, -1000,
-	676, -1000, -1000, 826, -1000, -1000, -1000, -1000, -1000, 725,
-	2434, -1000, 3514, -1000, 754, -1000, -1000, 714, -1000, -1000,
+	-1000, -1000, -1000, -1000, 347, 345, -75, 2828, 705, 3630,
+	-1000, 343, 334, 333, 3735, 719, 3993, -1000, 1025, 1143,
+	1144, 1568, 1142, 1266, 929, 795, -1000, 793, 3735, 1568,
+	2380, 1568, -1000, 795, 33, 388, -1000, 556, -1000, 2380,
+	1400, 2380, 2380, 503, 497, -1000, 928, -1000, 2380, -1000,
+	-1000, -1000, -1000, 3735, 3735, 1187, 42, 923, 1044, 1185,
+	-1000, 1172, -1000, -1000, 73, -44, -1000, -1000, 1675, -44,
+	-1000, -1000, 4050, 3735, 1272, 239, 230, 235, 259, 640,
+	45, 847, 1215, 333, -1000, -1000, -1000, 31, 2380, -1000,
+	3735, 3735, 3735, 805, 3735, 834, 61, 3735, 898, 3735,
+	3735, 3735, 3735, 3735, 3735, 3735, 3735, -1000, -1000, 2202,
+	3525, 3735, 3000, 795, 795, 61, 61, 818, 891, -1000,
+	-1000, 207, -1000, 482, 795, 3735, 1806, -1000, 2828, 230,
+	228, 3735, 718, 666, 664, 3735, 969, 988, 1169, 1151,
+	1215, 4101, 1568, 1162, 25, -1000, -1000, -1000, -1000, 332,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1568, 4101, 1170, 22,
+	855, 855, 855, 3105, -1000, 226, -1000, 349, 369, 1213,
+	3735, 1215, 3735, 520, 364, 257, 255, -1000, -1000, -1000,
+	-1000, 3735, 3735, 3735, 3735, 3735, 1140, -1000, -1000, 1225,
+	3735, 3735, 1210, 1210, 1568, 3735, 3735, 3735, -1000, 3735,
+	3993, -1000, -1000, -1000, -1000, 1169, 2484, 2380, 1215, 2380,
+	66, 835, 1086, 362, 86, 34, 34, 878, 4191, 3735,
+	61, 3735, -1000, 3630, -1000, 34, 61, 61, -1000, 256,
+	256, 393, 393, 393, 4135, 207, -1000, -1000, 210, 3735,
+	196, 1303, -1000, 195, 15, 1121, -1000, 3993, -1000, -1000,
+	-39, 331, 330, 329, 328, 327, 326, 325, 3735, 3420,
+	-1000, -1000, 61, 234, 234, 234, 805, -1000, 3735, 1563,
+	-1000, -1000, 643, -1000, 3735, 601, 2828, 600, 3735, 4155,
+	704, 519, 483, 3735, 3735, 3210, 1151, 1022, 3735, -1000,
+	13, -1000, 51, 2569, -1000, -1000, -1000, 4038, -1000, 324,
+	2132, -1000, -1000, 313, 175, 759, 1568, 3945, 284, 1151,
+	4101, 1400, 259, -1000, 259, 259, -1000, -1000, 321, 759,
+	2380, 793, -1000, 573, 2055, 759, 2380, 190, -1000, 3993,
+	1371, 2380, 793, 185, 3735, 2380, 3735, -1000, -44, -1000,
+	-44, -44, -1000, -44, -1000, -1000, 12, 1119, 1215, -1000,
+	-1000, -1000, 10, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	595, 383, -1000, -1000, 3840, 3735, -1000, -1000, -1000, -1000,
+	-1000, 636, -1000, 629, 2380, 2380, -1000, 320, 2380, -1000,
+	-1000, 3735, 4093, -1000, 34, -1000, -1000, -1000, 189, -1000,
+	3735, -1000, 3105, 2380, 3525, 795, 795, 795, 795, 3735,
+	3735, 3735, 188, 184, 182, 815, -1000, 100, -1000, 319,
+	-1000, -1000, 543, 180, 3735, 594, 662, 2828, 3735, 766,
+	-1000, -1000, 3993, 3735, 2828, 1167, 576, 502, 468, -1000,
+	3, 993, 3993, -1000, 1022, 997, 981, 3993, 962, 960,
+	941, 975, 122, -1000, -1000, -1000, -1000, -1000, 2380, 65,
+	3735, -1000, 2380, 3735, 61, 759, -1000, 1169, 1, 379,
+	-41, -1000, -23, 0, -44, -75, 316, 759, -1000, 1151,
+	-1000, 907, -1000, -1000, 907, 759, 178, -4, 173, -5,
+	-1000, 1039, -1000, 1075, 2380, -1000, 1049, 937, 2380, -1000,
+	759, 2380, 1043, 1042, -1000, -1000, -1000, 172, -6, -1000,
+	1112, 168, -7, -1000, -1000, -9, 1048, -1000, -28, -1000,
+	3735, 2380, -1000, 3735, 731, 2484, 703, 715, 2484, 2484,
+	628, 626, 793, 167, 207, 3735, -1000, 1617, -1000, -1000,
+	166, 3735, 3735, 3735, 3420, 3735, 164, 162, 161, 436,
+	-1000, -1000, 61, 160, -10, 3735, -1000, 789, 431, 2071,
+	757, 593, -1000, 700, -1000, 1946, 714, -1000, 3735, -1000,
+	-1000, 472, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3210,
+	424, -1000, -1000, 997, -1000, 3735, 3735, 2896, 2724, 954,
+	-1000, 948, 941, -1000, 994, 223, -14, -1000, -1000, -15,
+	-1000, 159, -1000, 158, 1151, 759, 3735, -1000, 3735, 1400,
+	759, 154, -1000, 152, 917, 759, 1107, 2502, -1000, 1039,
+	837, -1000, -1000, -1000, 759, 759, 150, -17, 3735, 799,
+	1036, 315, 314, -1000, 146, -1000, 2380, 3735, 1102, 2380,
+	447, 1098, 1215, 1215, 3735, 1096, 1215, -1000, -1000, -1000,
+	-1000, -1000, 2484, 656, 3735, 592, 586, 2484, 2484, 145,
+	1092, 207, -1000, 3735, 492, 144, 142, 140, 137, 136,
+	135, 491, 464, 453, 1021, -1000, -1000, 61, 1450, -1000,
+	1019, -1000, -1000, 755, 2828, -1000, -1000, 3735, 502, 965,
+	-1000, 426, -1000, 1068, 1025, 3993, -1000, 952, 223, 1196,
+	223, 2552, 2332, 946, -19, 313, 122, 3735, -1000, 932,
+	-1000, -1000, 3993, 132, -54, 129, 913, 892, 310, -1000,
+	793, -21, -1000, -1000, -1000, 797, 1032, -1000, 309, -1000,
+	-1000, 1075, 2380, 3993, 308, 306, 2380, 3735, -1000, -1000,
+	-44, -1000, 793, -1000, 2656, 446, -1000, -1000, -1000, 1048,
+	-1000, 442, 128, 632, 578, 2484, 696, 730, 729, 575,
+	574, -1000, 301, 2050, 297, 489, 457, 487, 474, 473,
+	456, 296, 295, 421, 293, 420, 290, -1000, 3735, 288,
+	-1000, 741, 472, -1000, -1000, -1000, -1000, -1000, 969, -1000,
+	-1000, 3735, 286, 943, 1196, 223, 952, 223, 294, 122,
+	-1000, -70, 123, 61, -1000, -1000, -1000, 3735, 867, 285,
+	61, -1000, 759, -1000, 1039, -1000, -1000, 3735, -1000, -1000,
+	2380, 2380, 115, 2014, -1000, 570, 381, -1000, -1000, 3840,
+	3735, -1000, -1000, 3315, 3735, 2656, 2656, 1091, 568, 654,
+	2484, 3735, 765, -1000, 2484, -1000, -1000, 728, 727, 793,
+	-1000, 498, 283, 1015, 281, 279, 278, 277, 1000, 275,
+	498, 498, 471, 498, 470, 1025, 1826, 1025, -1000, -1000,
+	512, 3993, 2380, -1000, -1000, 943, -1000, 952, 223, -1000,
+	-1000, -1000, -1000, 114, 61, -1000, 759, -1000, 109, -1000,
+	1752, 106, 105, -1000, -1000, -1000, 2656, 695, 713, 613,
+	36, 827, 1215, -1000, 567, 566, 441, 750, 564, -1000,
+	690, -1000, 711, -1000, -1000, 104, 102, -1000, 1026, 979,
+	498, 273, 498, 498, 498, 498, 258, 498, 101, 1025,
+	97, 249, 90, 248, 85, -1000, 78, 1166, 76, -1000,
+	-1000, -1000, -1000, 72, 864, -1000, -1000, -1000, -1000, 2656,
+	651, 3735, 2290, 2380, 2380, 41, 821, -1000, -1000, 2656,
+	-1000, 749, 2484, -1000, 3735, -1000, -1000, -1000, 977, 3735,
+	69, 1025, 68, 62, 57, 55, 1025, 54, -1000, -1000,
+	498, -1000, 498, -1000, -1000, -1000, -1000, 848, 61, -1000,
+	619, 563, 2656, 684, 562, 70, -1000, -1000, 3840, 3735,
+	-1000, -1000, -1000, 611, 610, 2380, 2380, 553, -1000, 740,
+	3210, -1000, -1000, 53, -1000, -1000, -1000, -1000, 49, -1000,
+	48, 47, 61, -1000, -1000, 550, 646, 2656, 3735, 762,
+	-1000, 2656, 725, 2290, 674, 709, 2290, 2290, 605, 577,
+	-1000, -1000, 415, 469, 467, -1000, -1000, -1000, 747, 549,
+	-1000, 672, -1000, 708, -1000, -1000, 2290, 645, 3735, 547,
+	546, 2290, 2290, -1000, 916, 246, 74, -1000, 746, 2656,
+	-1000, 3735, 609, 542, 2290, 669, 724, 723, 539, 538,
+	-1000, 870, 785, 779, 772, 498, 498, -1000, 733, 527,
+	642, 2290, 3735, 760, -1000, 2290, -1000, -1000, 722, 721,
+	812, 763, -1000, 783, 770, -1000, -1000, -1000, 43, 38,
+	-1000, 745, 525, -1000, 668, -1000, 707, -1000, -1000, 856,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 743, 2290, -1000,
+	3735, -1000, 776, -1000, -1000, 579, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 62, 110, 11, 117, 75, 89, 1335, 59, 30,
-	39, 1334, 1333, 1332, 1328, 31, 26, 1325, 1324, 1323,
-	1322, 1321, 1319, 1316, 87, 29, 38, 1313, 1310, 1309,
-	78, 1308, 64, 1307, 1306, 52, 47, 1305, 1303, 1298,
-	1291, 1289, 74, 1288, 107, 105, 1133, 1287, 79, 61,
-	81, 68, 18, 34, 28, 1286, 1285, 41, 1283, 44,
-	21, 1282, 97, 1281, 101, 96, 83, 1053, 0, 72,
-	58, 13, 5, 1280, 1272, 1268, 1266, 1576, 1256, 93,
-	1255, 1253, 1249, 969, 1248, 1247, 1246, 7, 27, 16,
-	19, 1245, 1244, 4, 1242, 1241, 65, 1239, 1238, 94,
-	90, 91, 1237, 20, 35, 547, 1235, 37, 1234, 1232,
-	1231, 23, 69, 1228, 36, 32, 77, 88, 33, 86,
-	1227, 1226, 1223, 63, 1222, 1221, 43, 82, 12, 25,
-	6, 17, 2, 3, 70, 1219, 9, 1218, 10, 1217,
-	8, 1215, 1447, 22, 66, 14, 1211, 106, 1123, 1209,
-	100, 99, 98, 85, 67, 80, 102, 1207, 56, 797,
+var yyPgo = [...]int16{
+	0, 64, 25, 11, 61, 144, 16, 1392, 75, 29,
+	70, 1390, 1389, 1388, 1387, 159, 32, 1386, 1374, 1364,
+	1362, 1361, 1359, 1355, 89, 35, 1347, 1346, 56, 59,
+	1345, 1344, 39, 41, 37, 1343, 1342, 1341, 81, 1338,
+	55, 1337, 1335, 52, 43, 1334, 1333, 1331, 1330, 1328,
+	78, 1327, 138, 90, 1183, 1325, 84, 77, 86, 67,
+	26, 36, 30, 1323, 1322, 57, 1320, 47, 23, 1318,
+	99, 1317, 98, 97, 853, 1155, 0, 79, 8, 13,
+	10, 1316, 1315, 1312, 1311, 1557, 1310, 1309, 104, 1308,
+	1305, 1304, 186, 1303, 1302, 1300, 7, 18, 19, 27,
+	1299, 1298, 5, 1297, 1295, 60, 1293, 1292, 100, 92,
+	94, 1286, 1285, 1279, 525, 40, 124, 1277, 44, 1274,
+	1273, 1271, 22, 80, 1268, 31, 21, 82, 91, 54,
+	88, 1267, 1266, 1265, 62, 1254, 1251, 38, 87, 17,
+	34, 12, 6, 2, 9, 69, 1248, 20, 1244, 4,
+	1242, 3, 1241, 1455, 33, 28, 14, 1235, 102, 1108,
+	1234, 110, 175, 96, 85, 63, 83, 101, 1232, 58,
+	864,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]uint8{
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 6, 6,
@@ -1429,52 +1534,55 @@ var yyR1 = [...]int{
 	15, 16, 16, 17, 17, 18, 18, 18, 18, 18,
 	19, 19, 19, 19, 19, 19, 20, 20, 20, 20,
 	21, 21, 21, 21, 21, 22, 22, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 24, 24, 25,
-	25, 26, 26, 26, 26, 26, 27, 27, 27, 27,
-	27, 27, 27, 28, 28, 28, 28, 29, 29, 30,
-	30, 31, 31, 31, 31, 32, 33, 33, 34, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 38,
-	38, 38, 38, 38, 38, 38, 39, 39, 39, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 41, 41, 41, 42, 42, 43, 43,
-	44, 44, 44, 44, 45, 45, 46, 47, 48, 48,
-	49, 49, 50, 50, 51, 51, 52, 52, 53, 53,
-	53, 54, 54, 54, 55, 55, 56, 56, 57, 57,
-	57, 58, 58, 58, 59, 59, 60, 60, 61, 61,
-	62, 62, 63, 63, 63, 63, 63, 63, 64, 65,
-	66, 66, 66, 66, 66, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 69, 70, 70, 70, 71,
-	71, 72, 72, 73, 73, 74, 74, 75, 75, 75,
-	76, 76, 77, 78, 79, 79, 79, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 81, 81, 81, 81,
-	81, 81, 81, 82, 82, 82, 82, 83, 83, 84,
-	84, 84, 84, 84, 84, 84, 84, 85, 85, 85,
-	85, 85, 85, 86, 86, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 88, 89, 89,
-	90, 90, 91, 91, 92, 92, 92, 93, 93, 93,
-	94, 94, 95, 95, 96, 96, 97, 97, 97, 97,
-	98, 98, 98, 98, 99, 99, 102, 102, 102, 103,
-	103, 103, 104, 104, 104, 104, 105, 105, 105, 105,
-	105, 105, 105, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 107, 107, 108, 108, 109, 109, 109,
-	110, 111, 111, 112, 112, 113, 113, 114, 114, 115,
-	115, 116, 116, 117, 117, 100, 100, 101, 101, 118,
-	118, 119, 119, 120, 120, 120, 120, 121, 122, 123,
-	123, 124, 124, 124, 124, 124, 124, 124, 124, 125,
-	125, 126, 126, 127, 127, 128, 128, 129, 129, 130,
-	130, 131, 131, 132, 132, 133, 133, 134, 134, 135,
-	135, 136, 136, 137, 137, 138, 138, 139, 139, 140,
-	140, 141, 141, 142, 142, 142, 142, 142, 142, 142,
-	142, 143, 144, 144, 145, 146, 146, 147, 147, 148,
-	149, 150, 151, 151, 152, 152, 153, 153, 154, 154,
-	155, 155, 155, 156, 156, 157, 157, 158, 158, 159,
-	159,
+	23, 23, 23, 23, 23, 23, 23, 23, 23, 26,
+	26, 27, 27, 28, 29, 29, 30, 30, 30, 30,
+	31, 31, 32, 32, 32, 32, 33, 33, 24, 24,
+	25, 25, 34, 34, 34, 34, 34, 35, 35, 35,
+	35, 35, 35, 35, 36, 36, 36, 36, 37, 37,
+	38, 38, 39, 39, 39, 39, 40, 41, 41, 42,
+	43, 43, 44, 44, 44, 45, 45, 45, 45, 45,
+	45, 45, 46, 46, 46, 46, 46, 46, 46, 47,
+	47, 47, 48, 48, 48, 48, 48, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 49, 49, 49, 50,
+	50, 51, 51, 52, 52, 52, 52, 53, 53, 54,
+	55, 56, 56, 57, 57, 58, 58, 59, 59, 60,
+	60, 61, 61, 61, 62, 62, 62, 63, 63, 64,
+	64, 65, 65, 65, 66, 66, 66, 67, 67, 68,
+	68, 69, 69, 70, 70, 71, 71, 71, 71, 71,
+	71, 72, 73, 74, 74, 74, 74, 74, 75, 75,
+	75, 76, 76, 76, 76, 76, 76, 76, 76, 76,
+	76, 76, 76, 76, 76, 76, 76, 76, 76, 77,
+	78, 78, 78, 79, 79, 80, 80, 81, 81, 82,
+	82, 83, 83, 83, 84, 84, 85, 86, 88, 88,
+	88, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+	89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+	87, 90, 90, 90, 90, 90, 90, 90, 91, 91,
+	91, 91, 92, 92, 93, 93, 93, 93, 93, 93,
+	93, 93, 94, 94, 94, 94, 94, 94, 94, 95,
+	95, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 97, 98, 98, 99, 99,
+	100, 100, 101, 101, 101, 102, 102, 102, 103, 103,
+	104, 104, 105, 105, 106, 106, 106, 106, 106, 106,
+	107, 107, 107, 107, 108, 108, 111, 111, 111, 112,
+	113, 113, 114, 114, 114, 115, 115, 115, 115, 116,
+	116, 116, 116, 116, 116, 116, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 118, 118, 119, 119,
+	120, 120, 120, 121, 122, 122, 123, 123, 124, 124,
+	125, 125, 126, 126, 127, 127, 128, 128, 109, 109,
+	110, 110, 129, 129, 130, 130, 131, 131, 131, 131,
+	132, 133, 134, 134, 135, 135, 135, 135, 135, 135,
+	135, 135, 136, 136, 137, 137, 138, 138, 139, 139,
+	140, 140, 141, 141, 142, 142, 143, 143, 144, 144,
+	145, 145, 146, 146, 147, 147, 148, 148, 149, 149,
+	150, 150, 151, 151, 152, 152, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 154, 155, 155,
+	156, 157, 157, 158, 158, 159, 160, 161, 162, 162,
+	163, 163, 164, 164, 165, 165, 166, 166, 166, 167,
+	167, 168, 168, 169, 169, 170, 170,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -1484,298 +1592,319 @@ var yyR2 = [...]int{
 	9, 1, 2, 1, 1, 7, 8, 6, 1, 1,
 	7, 8, 6, 1, 1, 1, 2, 2, 1, 2,
 	4, 4, 4, 4, 2, 1, 1, 6, 8, 5,
-	6, 8, 5, 7, 7, 7, 7, 1, 3, 1,
-	3, 0, 1, 1, 2, 2, 5, 5, 2, 4,
-	2, 3, 5, 6, 8, 5, 3, 1, 3, 1,
-	3, 4, 2, 4, 3, 1, 1, 3, 3, 1,
-	3, 1, 1, 3, 9, 10, 10, 12, 3, 0,
-	1, 1, 1, 1, 2, 2, 5, 6, 3, 4,
-	4, 4, 4, 4, 4, 2, 2, 2, 2, 4,
-	4, 2, 2, 2, 4, 1, 2, 2, 4, 2,
-	2, 1, 2, 2, 3, 4, 4, 6, 9, 11,
-	5, 4, 4, 4, 1, 1, 3, 2, 0, 2,
-	0, 2, 0, 3, 0, 2, 0, 3, 1, 6,
-	5, 0, 1, 2, 1, 1, 0, 1, 1, 1,
-	1, 0, 1, 1, 0, 3, 0, 2, 6, 9,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 3, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 1, 1, 3, 1, 6, 1,
-	3, 1, 3, 2, 4, 1, 1, 0, 1, 1,
-	1, 1, 3, 3, 3, 1, 6, 3, 3, 3,
-	3, 4, 4, 5, 6, 6, 3, 4, 4, 3,
-	4, 4, 4, 4, 4, 2, 3, 3, 3, 3,
-	3, 2, 2, 3, 3, 2, 2, 0, 1, 4,
-	4, 6, 8, 3, 4, 4, 4, 5, 5, 5,
-	5, 5, 1, 5, 10, 8, 9, 9, 9, 9,
-	9, 9, 8, 8, 10, 8, 10, 2, 1, 5,
-	0, 3, 2, 5, 2, 2, 2, 2, 2, 2,
-	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	4, 6, 6, 8, 1, 1, 1, 6, 6, 1,
-	2, 3, 1, 2, 3, 4, 1, 2, 3, 1,
-	1, 1, 3, 4, 5, 6, 5, 6, 5, 6,
-	7, 6, 7, 2, 4, 1, 1, 1, 3, 1,
-	5, 0, 1, 4, 5, 0, 2, 1, 3, 1,
-	3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 6, 9, 5, 8, 7, 3, 1,
-	3, 10, 13, 9, 12, 9, 12, 8, 11, 5,
-	6, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 1, 3, 1, 3, 1,
-	1, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-	1, 1, 1, 0, 1, 0, 1, 0, 1, 1,
-	1,
+	6, 8, 5, 7, 7, 5, 6, 7, 7, 1,
+	3, 1, 3, 2, 0, 2, 3, 3, 2, 5,
+	1, 3, 6, 6, 5, 5, 0, 2, 1, 3,
+	1, 3, 0, 1, 1, 2, 2, 5, 5, 2,
+	4, 2, 3, 5, 6, 8, 5, 3, 1, 3,
+	1, 3, 4, 2, 4, 3, 1, 1, 3, 3,
+	1, 3, 1, 1, 3, 9, 10, 10, 12, 5,
+	5, 3, 0, 1, 1, 1, 1, 2, 2, 5,
+	6, 3, 4, 4, 4, 4, 4, 4, 2, 2,
+	2, 2, 4, 4, 2, 2, 2, 4, 1, 2,
+	2, 4, 2, 2, 1, 2, 2, 3, 4, 4,
+	6, 9, 11, 5, 4, 4, 4, 1, 1, 3,
+	2, 0, 2, 0, 2, 0, 3, 0, 2, 0,
+	3, 1, 6, 5, 0, 1, 2, 1, 1, 0,
+	1, 1, 1, 1, 0, 1, 1, 0, 3, 0,
+	2, 6, 9, 1, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 1, 1,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	3, 1, 6, 1, 3, 1, 3, 2, 4, 1,
+	1, 0, 1, 1, 1, 1, 3, 3, 3, 1,
+	6, 3, 3, 3, 3, 4, 4, 5, 6, 6,
+	3, 4, 4, 3, 4, 4, 4, 4, 4, 2,
+	3, 3, 3, 3, 3, 3, 2, 2, 3, 3,
+	2, 2, 0, 1, 4, 4, 6, 8, 3, 4,
+	4, 4, 5, 5, 10, 5, 5, 5, 1, 5,
+	10, 8, 9, 9, 14, 9, 9, 9, 9, 14,
+	8, 8, 10, 8, 10, 2, 1, 5, 0, 3,
+	2, 5, 2, 2, 2, 2, 2, 2, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	4, 6, 6, 8, 1, 1, 1, 6, 6, 4,
+	1, 1, 1, 2, 3, 1, 2, 3, 4, 1,
+	2, 3, 1, 1, 1, 3, 4, 5, 6, 5,
+	6, 5, 6, 7, 6, 7, 2, 4, 1, 1,
+	1, 3, 1, 5, 0, 1, 4, 5, 0, 2,
+	1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+	1, 3, 1, 3, 1, 3, 6, 9, 5, 8,
+	7, 3, 1, 3, 10, 13, 9, 12, 9, 12,
+	8, 11, 5, 6, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 1, 3, 1, 3, 1, 1, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 1, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -42, -43, -120, -121, -124,
-	-125, -23, -20, -21, -27, -28, -31, -37, -22, -40,
-	-41, -68, 15, 88, 87, -8, -10, -60, 27, 32,
-	35, 133, 96, -145, 102, 20, 21, 100, 101, 99,
-	103, 120, 111, 112, 33, 124, 134, 116, 117, 118,
-	119, 125, 121, 122, 123, 126, -63, -81, -78, -77,
-	-84, -85, -110, -80, -82, -143, -148, -149, -150, -39,
-	166, 16, 90, 115, 80, 5, 6, 7, -64, 10,
-	-65, -67, 160, 161, -142, 145, 147, 148, 146, -86,
-	-70, 70, 74, 165, 11, 13, 14, 12, 97, 9,
-	78, -66, 4, 135, 136, 137, 139, 140, 141, 142,
-	149, 143, 30, 158, -68, 166, -145, 88, 27, 133,
-	87, -111, -67, -68, -44, -46, 24, 19, 27, 22,
-	-45, 17, -77, 166, 166, 25, 36, 36, -147, 166,
-	-146, -143, -147, -142, -143, 97, 44, 103, 127, -148,
-	-150, -148, -142, -142, -38, 104, 105, 37, 38, 106,
-	107, -142, -142, -68, -68, -68, -150, -142, -68, -68,
-	-68, -142, -68, -115, -67, -142, -68, -142, -142, 155,
-	-67, -68, -115, -42, -60, -68, -143, -144, -9, 133,
-	96, 6, -62, -61, -157, 31, 154, 153, 159, 77,
-	75, 74, 71, 76, -159, 161, 160, 162, 163, 164,
-	73, 72, -67, -67, 169, 166, 166, 166, 166, 166,
-	153, 159, -152, -159, 74, -77, -67, -67, -142, 166,
-	166, 169, -1, 92, -115, -83, 166, -111, -134, -112,
-	91, -52, 45, -47, -48, 25, 18, 25, -101, -99,
-	-96, -98, -142, 30, -97, 139, 140, 141, 142, 25,
-	18, -100, -96, 65, 66, 67, -151, 79, -83, -115,
-	-99, -142, -99, -151, 168, 155, 97, 44, 127, 128,
-	-142, -96, -142, -142, 159, 43, 159, 43, 62, -142,
-	-68, -68, 18, 62, 62, 43, 18, 18, 168, 62,
-	168, -68, 6, -67, 167, 167, 167, 167, -46, 94,
-	71, 168, 71, -143, -144, 168, -142, -67, -67, -67,
-	-152, -67, 75, 71, 76, -70, 166, -77, -67, 69,
-	68, -67, -67, -67, -67, -67, -67, -67, -142, 6,
-	-83, -151, -83, -67, 167, -119, -109, -108, -69, -67,
-	-87, 162, -142, 148, 133, 146, 149, 150, 151, 152,
-	-151, -151, -70, -70, 75, 71, 69, 68, 77, 146,
-	-151, -67, -142, 6, -1, 167, 91, -135, 93, -113,
-	93, -67, -68, -53, -59, 51, 52, 48, -48, -49,
-	23, -144, -143, -117, -105, -102, -106, 29, -103, 166,
-	-99, 144, -77, -99, 20, 168, 166, -99, -117, 18,
-	168, -156, 68, -156, -156, -119, 167, 62, 166, 166,
-	-158, 28, 33, 34, 42, 20, -83, -147, -67, 98,
-	166, 28, 166, 166, -68, -142, -68, -142, -142, -68,
-	-142, -68, -30, -29, -68, 25, 5, -30, -116, -68,
-	-150, -150, -99, -116, -116, -115, -68, -2, -12, -5,
-	-13, 88, 87, -8, -10, -6, 113, 114, -142, -144,
-	-142, 71, 71, -62, 28, 166, -64, -65, 72, -67,
-	-70, -67, -70, -70, 167, -83, 167, 18, 167, 168,
-	28, 166, 166, 166, 166, 166, 166, 166, 166, -83,
-	-83, -69, -70, -79, 166, -77, 143, -79, -79, -152,
-	-83, 168, -127, -126, 93, 89, 95, -1, 95, -67,
-	92, 92, 98, 99, -68, -68, -72, -73, -74, -67,
-	-87, -49, -50, 46, -67, 60, -153, -155, 63, 168,
-	55, 57, 58, 59, -142, 28, -105, 166, -142, 28,
-	26, 166, -42, -123, -122, -66, -142, -101, -96, -68,
-	-142, 30, 62, 166, -49, -117, -100, -45, -44, -45,
-	-45, 166, -114, -66, -118, -142, -42, -24, 166, -142,
-	-66, 166, -66, -142, 167, -42, -142, -118, -42, 167,
-	-36, -33, -35, -32, -34, -143, -142, 168, 28, -144,
-	168, 95, 158, -68, -111, 94, 94, -142, -142, 166,
-	-118, -67, 72, 167, -67, -119, -142, -83, -151, -151,
-	-151, -151, -151, -83, -83, -83, 167, 167, 167, 72,
-	-71, -70, 166, 100, 71, 167, -67, 95, -127, -1,
-	-68, 87, -67, -1, 19, -55, 37, 104, -56, -57,
-	53, 86, 137, -58, 86, 137, 168, -75, 49, 50,
-	-50, -51, 47, 48, 54, 54, -154, 56, -153, -155,
-	-104, -105, 64, -103, -142, 167, -68, -142, -71, -114,
-	-48, 168, 159, 167, 168, 168, 166, -114, -49, -114,
-	167, 168, 167, 168, -26, 37, 38, 39, 40, -25,
-	-24, 41, -114, 43, 43, 167, 28, 167, 168, 168,
-	41, 167, 168, -30, -142, -116, 90, -2, 92, -136,
-	91, -2, -2, 94, 94, -42, 167, -67, 167, 98,
-	167, -83, -83, -83, -83, -69, -83, 167, 167, 167,
-	-70, 167, 168, -67, 81, 132, 167, 88, 95, 92,
-	-112, -134, 91, -68, -54, 138, 80, -72, 136, -51,
-	-67, -115, -105, 64, -105, 64, 54, 54, -154, -103,
-	168, 168, 167, -49, -123, -67, -83, -96, -114, 167,
-	167, 62, -114, -158, -118, -66, -66, 167, 168, -67,
-	167, -142, -142, -68, 28, 129, 28, -32, -35, -35,
-	-143, -68, 28, -36, -2, -137, 93, -68, 95, 95,
-	-2, -2, 167, 28, -67, 110, 167, 167, 167, 167,
-	167, 167, 110, 110, 131, 110, 131, -71, 168, 46,
-	88, -1, -57, -59, 135, -76, 37, 38, -52, -103,
-	-107, 61, 62, -103, -105, 64, -105, 64, 54, 168,
-	-104, -142, -68, 26, -42, 167, 167, 168, 167, 62,
-	26, -42, 166, -42, -26, -25, -42, -3, -14, -5,
-	-18, 88, 87, -15, -16, 90, 130, 129, 129, 167,
-	-129, -128, 93, 89, 95, -2, 92, 90, 90, 95,
-	95, 166, 167, 166, 110, 110, 110, 110, 110, 110,
-	166, 166, 136, 166, 136, -67, 166, -126, -54, -53,
-	-67, 166, -107, -107, -103, -103, -105, 64, -104, 167,
-	167, -71, -83, 26, -42, 166, -71, -114, 95, 158,
-	-68, -111, -68, -143, -144, -9, -68, -3, -3, 28,
-	95, -129, -2, -68, 87, -2, 90, 90, -42, -89,
-	-88, -90, 109, 166, 166, 166, 166, 166, 166, -88,
-	-90, -89, 110, -88, 110, 167, -52, 98, -118, -107,
-	-103, 167, -71, -114, 167, -3, 92, -138, 91, 94,
-	71, 71, -143, -144, 95, 95, 129, 88, 95, 92,
-	-136, 91, 167, 167, -52, 45, 48, -89, -89, -89,
-	-89, -89, -88, 167, 167, 166, 167, 166, 167, 19,
-	167, 167, 26, -42, -3, -139, 93, -68, -4, -17,
-	-5, -19, 88, 87, -15, -16, -6, -142, -142, 71,
-	71, -3, 88, -2, 48, -115, 167, 167, 167, 167,
-	167, 167, -89, -88, 26, -42, -71, -131, -130, 93,
-	89, 95, -3, 92, 95, 158, -68, -111, 94, 94,
-	-142, -142, 95, -128, -72, 167, 167, -71, 95, -131,
-	-3, -68, 87, -3, 90, -4, 92, -140, 91, -4,
-	-4, 94, 94, -91, 137, 88, 95, 92, -138, 91,
-	-4, -141, 93, -68, 95, 95, -4, -4, -92, 75,
-	82, 6, 85, 88, -3, -133, -132, 93, 89, 95,
-	-4, 92, 90, 90, 95, 95, -94, 82, -93, 6,
-	85, 83, 83, 86, -130, 95, -133, -4, -68, 87,
-	-4, 90, 90, 72, 83, 83, 84, 86, 88, 95,
-	92, -140, 91, -95, 82, -93, 88, -4, 84, -132,
+var yyChk = [...]int16{
+	-1000, -1, -7, -5, -11, -50, -51, -131, -132, -135,
+	-136, -23, -20, -21, -35, -36, -39, -45, -22, -48,
+	-49, -76, 15, 93, 92, -8, -10, -68, 27, 32,
+	35, 139, 101, -156, 107, 20, 21, 105, 106, 104,
+	108, 125, 116, 117, 33, 129, 140, 121, 122, 123,
+	124, 130, 126, 127, 128, 131, -71, -90, -86, -87,
+	-85, -93, -94, -121, -89, -91, -154, -159, -160, -161,
+	-47, 175, 16, 95, 120, 85, 5, 6, 7, -72,
+	10, -73, -75, 169, 170, -153, 153, 155, 156, 154,
+	-95, -78, 75, 79, 174, 11, 13, 14, 12, 102,
+	9, 83, -74, 4, 141, 142, 143, 145, 146, 147,
+	148, 149, 150, 47, 157, 151, 30, 167, -76, 175,
+	-156, 93, 27, 139, 92, -122, -75, -76, -52, -54,
+	24, 19, 27, 22, -53, 17, -85, 175, 175, 25,
+	36, 36, -158, 175, -157, -154, -158, -153, -154, 102,
+	44, 108, 132, -159, -161, -159, -153, -153, -46, 109,
+	110, 37, 38, 111, 112, -153, -153, -76, -76, -76,
+	-161, -153, -76, -76, -76, -153, -76, -126, -75, -153,
+	-76, -153, -153, 164, -75, -76, -126, -50, -68, -76,
+	-154, -155, -9, 139, 101, 6, -70, -69, -168, 31,
+	163, 162, 168, 82, 80, 79, 76, 81, -170, 161,
+	170, 169, 171, 172, 173, 78, 77, -75, -75, 178,
+	175, 175, 175, 175, 175, 162, 168, -163, -170, 79,
+	-85, -75, -75, -153, 175, 175, 178, -1, 97, -126,
+	-92, 175, -122, -145, -123, 96, -60, 50, -55, -56,
+	25, 18, 25, -110, -108, -105, -107, -153, 30, -106,
+	145, 146, 147, 148, 149, 150, 25, 18, -109, -105,
+	70, 71, 72, -162, 84, -92, -126, -108, -153, -108,
+	-162, 177, 164, 102, 44, 132, 133, -153, -105, -153,
+	-153, 168, 43, 168, 43, 67, -153, -76, -76, 18,
+	67, 67, 43, 18, 18, 177, 67, 177, -76, 6,
+	-75, 176, 176, 176, 176, -54, 99, 76, 177, 76,
+	-154, -155, 177, -153, -75, -75, -75, -163, -75, 80,
+	76, 81, -78, 175, -85, -75, 74, 73, -75, -75,
+	-75, -75, -75, -75, -75, -75, -153, 6, -92, -162,
+	-92, -75, 176, -130, -120, -119, -77, -75, -96, 171,
+	-153, 156, 139, 154, 157, 158, 159, 160, -162, -162,
+	-78, -78, 80, 76, 74, 73, 82, 154, -162, -75,
+	-153, 6, -1, 176, 96, -146, 98, -124, 98, -75,
+	-76, -61, -67, 56, 57, 53, -56, -57, 23, -155,
+	-154, -128, -116, -111, -117, 29, -114, 175, -108, 152,
+	-113, -85, -112, 4, -108, 20, 177, 175, -108, -128,
+	18, 177, -167, 73, -167, -167, -130, 176, 67, 175,
+	175, -169, 28, 33, 34, 42, 20, -92, -158, -75,
+	103, 175, 28, 175, 136, 175, 136, -76, -153, -76,
+	-153, -153, -76, -153, -76, -38, -37, -76, 25, 5,
+	-38, -127, -76, -161, -161, -108, -127, -127, -126, -76,
+	-2, -12, -5, -13, 93, 92, -8, -10, -6, 118,
+	119, -153, -155, -153, 76, 76, -70, 28, 175, -72,
+	-73, 77, -75, -78, -75, -78, -78, 176, -92, 176,
+	18, 176, 177, 28, 175, 175, 175, 175, 175, 175,
+	175, 175, -92, -92, -77, -78, -88, 175, -85, 151,
+	-88, -88, -163, -92, 177, -138, -137, 98, 94, 100,
+	-1, 100, -75, 97, 97, 103, 104, -76, -76, -80,
+	-81, -82, -75, -96, -57, -58, 51, -75, 65, -164,
+	-166, 68, 177, 60, 62, 63, 64, -153, 28, -116,
+	175, -153, 28, 175, 26, 175, -50, -134, -133, -74,
+	-153, -110, -105, -76, -153, 30, 67, 175, -57, -128,
+	-109, -53, -52, -53, -53, 175, -125, -74, -26, -27,
+	-28, -153, -50, -24, 175, -32, -153, -33, 45, -74,
+	175, 45, -74, -153, 176, -50, -153, -129, -153, -50,
+	176, -44, -41, -43, -40, -42, -154, -76, -153, -76,
+	177, 28, -155, 177, 100, 167, -76, -122, 99, 99,
+	-153, -153, 175, -129, -75, 77, 176, -75, -130, -153,
+	-92, -162, -162, -162, -162, -162, -92, -92, -92, 176,
+	176, 176, 77, -79, -78, 175, 105, 76, 176, -75,
+	100, -138, -1, -76, 92, -75, -1, 19, -63, 37,
+	109, -64, -65, 58, 91, 143, -66, 91, 143, 177,
+	-83, 54, 55, -58, -59, 52, 53, 59, 59, -165,
+	61, -164, -166, -115, -116, 69, -114, -153, 176, -76,
+	-153, -92, -79, -125, -56, 177, 168, 176, 177, 177,
+	175, -125, -57, -125, 176, 177, 176, 177, -29, -30,
+	-33, -34, 37, 38, 39, 40, -25, -24, 41, 79,
+	46, 48, 49, -153, -125, -153, 43, 43, 176, 177,
+	28, 176, 177, 177, 41, 176, 177, -38, -153, -127,
+	95, -2, 97, -147, 96, -2, -2, 99, 99, -50,
+	176, -75, 176, 103, 176, -92, -92, -92, -92, -77,
+	-92, 176, 176, 176, 138, -78, 176, 177, -75, 86,
+	138, 176, 93, 100, 97, -123, -145, 96, -76, -62,
+	144, 85, -80, 142, -59, -75, -126, -116, 69, -116,
+	69, 59, 59, -165, -114, 4, 177, 177, 176, 176,
+	-57, -134, -75, -92, -105, -125, 176, 176, 67, -125,
+	-169, -31, -28, -32, -29, 79, 46, 48, 49, -74,
+	-74, 176, 177, -75, 83, 47, 175, 175, 176, -153,
+	-153, -76, 28, -129, 134, 28, -40, -43, -43, -154,
+	-76, 28, -44, -2, -148, 98, -76, 100, 100, -2,
+	-2, 176, 28, -75, 115, 176, 176, 176, 176, 176,
+	176, 115, 115, 137, 115, 137, 51, -79, 177, 51,
+	93, -1, -65, -67, 141, -84, 37, 38, -60, -114,
+	-118, 66, 67, -114, -116, 69, -116, 69, 59, 177,
+	-115, -153, -76, 26, -50, 176, 176, 177, 176, 67,
+	26, -50, 175, -50, 177, 83, 47, 175, -34, -25,
+	175, 175, -129, -75, -50, -3, -14, -5, -18, 93,
+	92, -15, -16, 95, 135, 134, 134, 176, -140, -139,
+	98, 94, 100, -2, 97, 95, 95, 100, 100, 175,
+	176, 175, 115, 138, 115, 115, 115, 115, 138, 115,
+	175, 175, 142, 175, 142, 175, -75, 175, -137, -62,
+	-61, -75, 175, -118, -118, -114, -114, -116, 69, -115,
+	176, 176, -79, -92, 26, -50, 175, -79, -125, -32,
+	-75, -129, -129, 176, 176, 100, 167, -76, -122, -76,
+	-154, -155, -9, -76, -3, -3, 28, 100, -140, -2,
+	-76, 92, -2, 95, 95, -50, -98, -97, -99, 114,
+	175, 51, 175, 175, 175, 175, 51, 175, -97, -99,
+	-98, 115, -97, 115, -60, 176, -60, 103, -129, -118,
+	-114, 176, -79, -125, 176, 176, 176, 176, -3, 97,
+	-149, 96, 99, 76, 76, -154, -155, 100, 100, 134,
+	93, 100, 97, -147, 96, 176, 176, -60, 50, 53,
+	-98, 175, -98, -98, -98, -98, 175, -97, 176, 176,
+	175, 176, 175, 176, 176, 19, 176, 176, 26, -50,
+	-3, -150, 98, -76, -4, -17, -5, -19, 93, 92,
+	-15, -16, -6, -153, -153, 76, 76, -3, 93, -2,
+	53, -126, 176, -60, 176, 176, 176, 176, -60, 176,
+	-98, -97, 26, -50, -79, -142, -141, 98, 94, 100,
+	-3, 97, 100, 167, -76, -122, 99, 99, -153, -153,
+	100, -139, -80, 176, 176, 176, 176, -79, 100, -142,
+	-3, -76, 92, -3, 95, -4, 97, -151, 96, -4,
+	-4, 99, 99, -100, 143, 115, 115, 93, 100, 97,
+	-149, 96, -4, -152, 98, -76, 100, 100, -4, -4,
+	-101, 80, 87, 6, 90, 175, 175, 93, -3, -144,
+	-143, 98, 94, 100, -4, 97, 95, 95, 100, 100,
+	-103, 87, -102, 6, 90, 88, 88, 91, -99, -99,
+	-141, 100, -144, -4, -76, 92, -4, 95, 95, 77,
+	88, 88, 89, 91, 176, 176, 93, 100, 97, -151,
+	96, -104, 87, -102, 93, -4, 89, -143,
 }
-var yyDef = [...]int{
 
+var yyDef = [...]int16{
 	-2, -2, 2, 30, 31, 10, 11, 12, 13, 14,
 	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	25, -2, 27, 0, 401, 46, 47, 0, 0, 0,
-	0, 0, 0, -2, 0, 0, 0, 0, 0, 139,
+	25, -2, 27, 0, 434, 46, 47, 0, 0, 0,
+	0, 0, 0, -2, 0, 0, 0, 0, 0, 162,
 	0, 0, 85, 86, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 171, 0, 0, 238, 239, 240, 241,
-	242, 243, 244, 245, 246, 247, 249, 250, 251, 252,
-	216, 254, 0, 39, 505, 222, 223, 224, 225, 226,
-	227, 0, 0, 0, 230, 0, 0, 0, 0, 322,
-	494, 0, 0, 0, 481, 489, 490, 491, 0, 228,
-	229, 235, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 0, 0, -2, 236, -2, 248, 0, 0, 0,
-	401, 0, 402, 236, -2, 188, 0, 0, 0, 0,
-	0, 492, 185, 216, 307, 0, 0, 0, 76, 492,
-	487, 485, 77, 0, 79, 0, 0, 0, 0, 0,
-	0, 84, 108, 110, 0, 140, 141, 142, 143, 0,
-	0, 0, -2, -2, 236, 236, 155, 167, -2, -2,
-	-2, -2, -2, 166, 409, -2, -2, 172, 173, 0,
-	0, 236, 0, 0, 0, 236, 247, 0, 0, 37,
-	38, 40, 217, 220, 0, 506, 0, 509, 510, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 301, 302, 0, 307, 307, 0, 492, 492,
-	509, 510, 0, 0, 495, 295, 305, 306, 0, 492,
-	0, 0, 3, -2, 0, 0, 307, 0, 459, 405,
-	0, 214, 0, 188, 190, 0, 0, 0, 0, 417,
-	364, 365, 354, 355, 0, -2, -2, -2, -2, 0,
-	0, 0, 415, 503, 503, 503, 0, 493, 0, 308,
-	0, 507, 0, 307, 0, 0, 0, 0, 0, 0,
-	111, 116, 124, 138, 0, 0, 0, 0, 0, 0,
-	-2, -2, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, -2, 223, 484, 237, 253, 256, 272, 188, -2,
-	0, 0, 0, 0, 0, 505, 0, 273, -2, -2,
-	0, 0, 0, 0, 0, 286, 216, 257, -2, 0,
-	0, 296, 297, 298, 299, 300, 303, 304, 231, 233,
-	0, 307, 0, 409, 313, 0, 421, 397, 399, 395,
-	396, 255, 230, 0, 0, 0, 0, 0, 0, 0,
-	307, 307, 278, 280, 0, 0, 0, 0, 494, 148,
-	307, 0, 232, 234, 443, 315, 0, 0, -2, 0,
-	0, 0, 236, 176, 198, 0, 0, 0, 190, 192,
-	0, 187, 482, 189, -2, 376, 379, 380, 381, 216,
-	366, 0, 369, 216, 0, 0, 0, 0, 190, 0,
-	0, 0, 504, 0, 0, 186, 316, 0, 0, 0,
-	216, 508, 0, 0, 0, 0, 0, 488, 486, 216,
-	0, 216, 0, 0, -2, -2, -2, -2, -2, -2,
-	-2, -2, 109, 119, -2, 0, 121, 123, 164, -2,
-	153, 154, 168, 159, 160, 410, -2, 0, 0, 41,
-	42, 0, 401, 51, 52, 53, 28, 29, 0, 483,
-	0, 0, 0, 221, 0, 0, 281, 282, 0, 0,
-	287, -2, 291, 293, 309, 0, 310, 0, 314, 0,
-	0, 307, 492, 492, 492, 492, 307, 307, 307, 0,
-	0, 0, 0, 288, 216, 275, 0, 292, 294, 0,
-	0, 0, 0, 443, -2, 0, 0, 460, 400, 406,
-	0, -2, 0, 0, -2, -2, 197, 261, 267, 265,
-	266, 192, 194, 0, 191, 0, 0, 498, 496, 0,
-	497, 500, 501, 502, 377, 0, 496, 0, 370, 0,
-	0, 0, 425, 188, 429, 0, 230, 418, 0, 236,
-	-2, 355, 0, 0, 439, 190, 416, 181, 184, 182,
-	183, 0, 0, 407, 0, 419, 89, 101, 0, 97,
-	92, 0, 0, 0, 319, 106, 107, 0, 115, 0,
-	0, 131, 132, 126, 129, 125, 0, 0, 0, 112,
-	0, 0, -2, 236, 0, -2, -2, 0, 0, 216,
-	0, 283, 0, 317, 0, 422, 398, 0, 307, 307,
-	307, 307, 307, 0, 0, 0, 318, 320, 321, 0,
-	0, 259, 0, 146, 0, 323, 0, 0, 0, 444,
-	236, 45, 403, 457, 177, 0, 204, 205, 201, 207,
-	208, 209, 210, 215, 212, 213, 0, 263, 268, 269,
-	194, 180, 0, 0, 0, 0, 0, 499, 0, 498,
-	414, -2, 0, 381, 378, 382, 236, 371, 423, 0,
-	190, 0, 0, 360, 307, 0, 0, 0, 440, 0,
-	0, 0, -2, 0, 90, 102, 103, 0, 0, 0,
-	99, 0, 0, 0, 0, 113, 0, 0, 0, 0,
-	0, 0, 0, 120, 118, 412, 32, 5, -2, 463,
-	0, 0, 0, -2, -2, 0, 0, 284, 311, 0,
-	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	285, 274, 0, 0, 147, 0, 258, 43, 0, -2,
-	404, 458, 0, 236, 214, 202, 0, 262, 0, 196,
-	195, 193, 383, 0, 496, 0, 0, 0, 0, 373,
-	0, 0, 216, 427, 430, 428, 0, 0, 0, 0,
-	216, 0, 408, 216, 420, 104, 105, 101, 0, 98,
-	93, 94, -2, -2, 216, -2, 0, 127, 133, 130,
-	0, -2, 0, 0, 447, 0, -2, 236, 0, 0,
-	0, 0, 218, 0, 0, 0, 317, 318, 319, 320,
-	321, 323, 0, 0, 0, 0, 0, 260, 0, 0,
-	44, 441, 201, 200, 203, 264, 270, 271, 214, 388,
-	384, 0, 0, 0, 496, 0, 386, 0, 0, 0,
-	374, 230, 236, 0, 426, 361, 362, 307, 216, 0,
-	0, 437, 0, 88, 91, 100, 114, 0, 0, 54,
-	55, 0, 401, 68, 69, 0, 61, -2, -2, 0,
-	0, 447, -2, 0, 0, 464, -2, 33, 34, 0,
-	0, 216, 312, 340, 0, 0, 0, 0, 0, 0,
-	340, 340, 0, 340, 0, 0, 196, 442, 199, 178,
-	393, 0, 389, 385, 0, 391, 387, 0, 375, 367,
-	368, 424, 0, 0, 433, 0, 435, 0, 134, -2,
-	236, 0, 236, 247, 0, 0, -2, 0, 0, 0,
-	0, 0, 448, 236, 50, 461, 35, 36, 0, 0,
-	338, 196, 0, 340, 340, 340, 340, 340, 340, 0,
-	196, 0, 0, 0, 0, 276, 0, 0, 0, 390,
-	392, 363, 431, 0, 216, 7, -2, 467, 0, -2,
-	0, 0, 0, 0, 135, 136, -2, 48, 0, -2,
-	462, 0, 219, 325, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 333, 340, 335, 340, 324, 179,
-	394, 216, 0, 438, 451, 0, -2, 236, 0, 0,
-	63, 64, 0, 401, 73, 74, 75, 0, 0, 0,
-	0, 0, 49, 445, 0, 341, 326, 327, 328, 329,
-	330, 331, 0, 0, 0, 434, 436, 0, 451, -2,
-	0, 0, 468, -2, 0, -2, 236, 0, -2, -2,
-	0, 0, 137, 446, 197, 334, 336, 432, 0, 0,
-	452, 236, 67, 465, 56, 9, -2, 471, 0, 0,
-	0, -2, -2, 339, 0, 65, 0, -2, 466, 0,
-	455, 0, -2, 236, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 66, 449, 0, 455, -2, 0, 0,
-	472, -2, 57, 58, 0, 0, 0, 0, 351, 0,
-	0, 344, 345, 346, 450, 0, 0, 456, 236, 72,
-	469, 59, 60, 0, 350, 347, 348, 349, 70, 0,
-	-2, 470, 0, 343, 0, 353, 71, 453, 352, 454,
+	0, 188, 0, 194, 0, 0, 261, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 273, 274, 275,
+	276, 239, 278, 0, 39, 541, 245, 246, 247, 248,
+	249, 250, 0, 0, 0, 253, 0, 0, 0, 0,
+	348, 530, 0, 0, 0, 517, 525, 526, 527, 0,
+	251, 252, 258, 506, 507, 508, 509, 510, 511, 512,
+	513, 514, 515, 516, 0, 0, 0, -2, 259, -2,
+	272, 0, 0, 0, 434, 0, 435, 259, -2, 211,
+	0, 0, 0, 0, 0, 528, 208, 239, 332, 0,
+	0, 0, 76, 528, 523, 521, 77, 0, 79, 0,
+	0, 0, 0, 0, 0, 84, 129, 131, 0, 163,
+	164, 165, 166, 0, 0, 0, -2, -2, 259, 259,
+	178, 190, -2, -2, -2, -2, -2, 189, 442, -2,
+	-2, 195, 196, 0, 0, 259, 0, 0, 0, 259,
+	271, 0, 0, 37, 38, 40, 240, 243, 0, 542,
+	0, 545, 546, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 327, 0,
+	332, 332, 0, 528, 528, 545, 546, 0, 0, 531,
+	319, 330, 331, 0, 528, 0, 0, 3, -2, 0,
+	0, 332, 0, 492, 438, 0, 237, 0, 211, 213,
+	0, 0, 0, 0, 450, 394, 395, 382, 383, 0,
+	-2, -2, -2, -2, -2, -2, 0, 0, 0, 448,
+	539, 539, 539, 0, 529, 0, 333, 0, 543, 0,
+	332, 0, 0, 0, 0, 0, 0, 132, 137, 145,
+	161, 0, 0, 0, 0, 0, 0, -2, -2, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, -2, 246,
+	520, 260, 277, 280, 296, 211, -2, 0, 0, 0,
+	0, 0, 541, 0, 297, -2, -2, 0, 0, 0,
+	0, 0, 310, 239, 281, -2, 0, 0, 320, 321,
+	322, 323, 324, 325, 328, 329, 254, 256, 0, 332,
+	0, 442, 338, 0, 454, 430, 432, 428, 429, 279,
+	253, 0, 0, 0, 0, 0, 0, 0, 332, 332,
+	302, 304, 0, 0, 0, 0, 530, 171, 332, 0,
+	255, 257, 476, 340, 0, 0, -2, 0, 0, 0,
+	259, 199, 221, 0, 0, 0, 213, 215, 0, 210,
+	518, 212, -2, 409, 412, 413, 414, 239, 396, 0,
+	402, 400, 401, 506, 239, 0, 0, 0, 0, 213,
+	0, 0, 0, 540, 0, 0, 209, 341, 0, 0,
+	0, 239, 544, 116, 0, 0, 0, 0, 524, 522,
+	239, 0, 239, 0, 0, 0, 0, -2, -2, -2,
+	-2, -2, -2, -2, -2, 130, 140, -2, 0, 142,
+	144, 187, -2, 176, 177, 191, 182, 183, 443, -2,
+	0, 0, 41, 42, 0, 434, 51, 52, 53, 28,
+	29, 0, 519, 0, 0, 0, 244, 0, 0, 305,
+	306, 0, 0, 311, -2, 315, 317, 334, 0, 335,
+	0, 339, 0, 0, 332, 528, 528, 528, 528, 332,
+	332, 332, 0, 0, 0, 0, 312, 239, 299, 0,
+	316, 318, 0, 0, 0, 0, 476, -2, 0, 0,
+	493, 433, 439, 0, -2, 0, 0, -2, -2, 220,
+	285, 291, 289, 290, 215, 217, 0, 214, 0, 0,
+	534, 532, 0, 533, 536, 537, 538, 410, 0, 532,
+	0, 403, 0, 332, 0, 0, 458, 211, 462, 0,
+	253, 451, 0, 259, -2, 383, 0, 0, 472, 213,
+	449, 204, 207, 205, 206, 0, 0, 440, 0, 99,
+	101, -2, 89, 122, 0, 95, 118, 0, 0, 92,
+	0, 0, 0, 0, 345, 127, 128, 0, 452, 136,
+	0, 0, 152, 153, 147, 150, 146, -2, 0, -2,
+	0, 0, 133, 0, 0, -2, 259, 0, -2, -2,
+	0, 0, 239, 0, 307, 0, 342, 0, 455, 431,
+	0, 332, 332, 332, 332, 332, 0, 0, 0, 343,
+	346, 347, 0, 0, 283, 0, 169, 0, 349, 0,
+	0, 0, 477, 259, 45, 436, 490, 200, 0, 227,
+	228, 224, 230, 231, 232, 233, 238, 235, 236, 0,
+	287, 292, 293, 217, 203, 0, 0, 0, 0, 0,
+	535, 0, 534, 447, -2, 0, 414, 411, 415, 259,
+	404, 0, 456, 0, 213, 0, 0, 390, 332, 0,
+	0, 0, 473, 0, 0, 0, -2, 116, 103, -2,
+	0, 90, 123, 124, 0, 0, 0, 120, 0, 0,
+	0, 0, 0, 117, 0, 96, 0, 0, 134, 0,
+	0, 0, 0, 0, 0, 0, 0, 141, 139, 445,
+	32, 5, -2, 496, 0, 0, 0, -2, -2, 0,
+	0, 308, 336, 0, 334, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 309, 298, 0, 0, 170,
+	0, 282, 43, 0, -2, 437, 491, 0, 259, 237,
+	225, 0, 286, 0, 219, 218, 216, 416, 0, 532,
+	0, 0, 0, 0, 406, 0, 0, 0, 399, 239,
+	460, 463, 461, 0, 0, 0, 0, 239, 0, 441,
+	239, 100, 102, 110, 105, 0, 0, 108, 0, 125,
+	126, 122, 0, 119, 0, 0, 0, 0, 93, 94,
+	-2, -2, 239, 453, -2, 0, 148, 154, 151, 0,
+	-2, 0, 0, 480, 0, -2, 259, 0, 0, 0,
+	0, 241, 0, 0, 0, 342, 343, 345, 346, 347,
+	349, 0, 0, 0, 0, 0, 0, 284, 0, 0,
+	44, 474, 224, 223, 226, 288, 294, 295, 237, 421,
+	417, 0, 0, 0, 532, 0, 419, 0, 0, 0,
+	407, 253, 259, 0, 459, 391, 392, 332, 239, 0,
+	0, 470, 0, 88, 116, 106, 107, 0, 91, 121,
+	0, 0, 0, 0, 135, 0, 0, 54, 55, 0,
+	434, 68, 69, 0, 61, -2, -2, 0, 0, 480,
+	-2, 0, 0, 497, -2, 33, 34, 0, 0, 239,
+	337, 368, 0, 0, 0, 0, 0, 0, 0, 0,
+	368, 368, 0, 368, 0, 219, 0, 219, 475, 222,
+	201, 426, 0, 422, 418, 0, 424, 420, 0, 408,
+	397, 398, 457, 0, 0, 466, 0, 468, 0, 111,
+	0, 0, 0, 114, 115, 155, -2, 259, 0, 259,
+	271, 0, 0, -2, 0, 0, 0, 0, 0, 481,
+	259, 50, 494, 35, 36, 0, 0, 366, 219, 0,
+	368, 0, 368, 368, 368, 368, 0, 368, 0, 219,
+	0, 0, 0, 0, 0, 300, 0, 0, 0, 423,
+	425, 393, 464, 0, 239, 109, 112, 113, 7, -2,
+	500, 0, -2, 0, 0, 0, 0, 156, 157, -2,
+	48, 0, -2, 495, 0, 242, 351, 365, 0, 0,
+	0, 219, 0, 0, 0, 0, 219, 0, 360, 361,
+	368, 363, 368, 344, 350, 202, 427, 239, 0, 471,
+	484, 0, -2, 259, 0, 0, 63, 64, 0, 434,
+	73, 74, 75, 0, 0, 0, 0, 0, 49, 478,
+	0, 369, 352, 0, 353, 355, 356, 357, 0, 358,
+	0, 0, 0, 467, 469, 0, 484, -2, 0, 0,
+	501, -2, 0, -2, 259, 0, -2, -2, 0, 0,
+	158, 479, 220, 344, 350, 362, 364, 465, 0, 0,
+	485, 259, 67, 498, 56, 9, -2, 504, 0, 0,
+	0, -2, -2, 367, 0, 0, 0, 65, 0, -2,
+	499, 0, 488, 0, -2, 259, 0, 0, 0, 0,
+	370, 0, 0, 0, 0, 368, 368, 66, 482, 0,
+	488, -2, 0, 0, 505, -2, 57, 58, 0, 0,
+	0, 0, 379, 0, 0, 372, 373, 374, 0, 0,
+	483, 0, 0, 489, 259, 72, 502, 59, 60, 0,
+	378, 375, 376, 377, 354, 359, 70, 0, -2, 503,
+	0, 371, 0, 381, 71, 486, 380, 487,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]uint8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 165, 3, 3, 3, 164, 3, 3,
-	166, 167, 162, 161, 168, 160, 169, 163, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 158,
-	3, 159,
+	3, 3, 3, 174, 3, 3, 3, 173, 3, 3,
+	175, 176, 171, 170, 177, 169, 178, 172, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 167,
+	3, 168,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]uint8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1791,9 +1920,11 @@ var yyTok2 = [...]int{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -1875,9 +2006,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1887,13 +2018,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1924,30 +2055,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -2002,7 +2133,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -2013,8 +2144,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -2027,7 +2158,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -2036,18 +2167,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -2069,10 +2200,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -2108,7 +2239,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -2119,16 +2250,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -2136,1060 +2267,1201 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:248
+//line parser.y:270
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:253
+//line parser.y:275
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:258
+//line parser.y:280
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:265
+//line parser.y:287
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:269
+//line parser.y:291
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:275
+//line parser.y:297
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:279
+//line parser.y:301
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:285
+//line parser.y:307
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:289
+//line parser.y:311
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:295
+//line parser.y:317
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:299
+//line parser.y:321
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:303
+//line parser.y:325
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:307
+//line parser.y:329
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:311
+//line parser.y:333
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:315
+//line parser.y:337
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:319
+//line parser.y:341
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:323
+//line parser.y:345
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:327
+//line parser.y:349
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:331
+//line parser.y:353
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+//line parser.y:357
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:339
+//line parser.y:361
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:343
+//line parser.y:365
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:347
+//line parser.y:369
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:351
+//line parser.y:373
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:355
+//line parser.y:377
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:359
+//line parser.y:381
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:363
+//line parser.y:385
 		{
 			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:369
+//line parser.y:391
 		{
 			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:373
+//line parser.y:395
 		{
 			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:379
+//line parser.y:401
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:383
+//line parser.y:405
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 32:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:389
+//line parser.y:411
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
 	case 33:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:393
+//line parser.y:415
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 34:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:397
+//line parser.y:419
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 35:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:401
+//line parser.y:423
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
 	case 36:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:405
+//line parser.y:427
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:411
+//line parser.y:433
 		{
 			yyVAL.token = yyDollar[1].token
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:415
+//line parser.y:437
 		{
 			yyVAL.token = yyDollar[1].token
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:421
+//line parser.y:443
 		{
 			yyVAL.statement = Exit{}
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:425
+//line parser.y:447
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:431
+//line parser.y:453
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:435
+//line parser.y:457
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 43:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:441
+//line parser.y:463
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:445
+//line parser.y:467
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 45:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:449
+//line parser.y:471
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:453
+//line parser.y:475
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:457
+//line parser.y:479
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 48:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:463
+//line parser.y:485
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 49:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:467
+//line parser.y:489
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 50:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:471
+//line parser.y:493
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:475
+//line parser.y:497
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+//line parser.y:501
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:483
+//line parser.y:505
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:489
+//line parser.y:511
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:493
+//line parser.y:515
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 56:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:499
+//line parser.y:521
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
 	case 57:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:503
+//line parser.y:525
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 58:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:507
+//line parser.y:529
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 59:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:511
+//line parser.y:533
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
 	case 60:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:515
+//line parser.y:537
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
 	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:521
+//line parser.y:543
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
 	case 62:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:525
+//line parser.y:547
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:531
+//line parser.y:553
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:535
+//line parser.y:557
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 65:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:541
+//line parser.y:563
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 66:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:545
+//line parser.y:567
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 67:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:549
+//line parser.y:571
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:553
+//line parser.y:575
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:557
+//line parser.y:579
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:563
+//line parser.y:585
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 71:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:567
+//line parser.y:589
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 72:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:571
+//line parser.y:593
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:575
+//line parser.y:597
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:579
+//line parser.y:601
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:583
+//line parser.y:605
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:589
+//line parser.y:611
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
 	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:593
+//line parser.y:615
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
 	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:597
+//line parser.y:619
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:601
+//line parser.y:623
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
 	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:607
+//line parser.y:629
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
 	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:611
+//line parser.y:633
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
 	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:615
+//line parser.y:637
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
 	case 83:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:619
+//line parser.y:641
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
 	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:623
+//line parser.y:645
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:629
+//line parser.y:651
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
 	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:633
+//line parser.y:655
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
 	case 87:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:639
+//line parser.y:661
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
+			yyVAL.statement = CreateTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].identifier, Fields: yyDollar[5].tableelems.fields, Constraints: yyDollar[5].tableelems.constraints}
 		}
 	case 88:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:643
+//line parser.y:665
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+			yyVAL.statement = CreateTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].identifier, Fields: yyDollar[5].tableelems.fields, Constraints: yyDollar[5].tableelems.constraints, Query: yyDollar[8].queryexpr}
 		}
 	case 89:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:647
+//line parser.y:669
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
+			yyVAL.statement = CreateTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
 	case 90:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:651
+//line parser.y:673
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
 	case 91:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:655
+//line parser.y:677
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
 	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:659
+//line parser.y:681
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
 	case 93:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:663
+//line parser.y:685
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
 	case 94:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:667
+//line parser.y:689
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
 	case 95:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:693
+		{
+			yyVAL.statement = AddConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Constraint: yyDollar[5].constraint}
+		}
+	case 96:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:697
+		{
+			yyVAL.statement = DropConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Name: yyDollar[6].identifier}
+		}
+	case 97:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:671
+//line parser.y:701
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 96:
+	case 98:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:675
+//line parser.y:705
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 97:
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:711
+		{
+			yyVAL.tableelems = yyDollar[1].tableelems
+		}
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:715
+		{
+			yyVAL.tableelems = tableElements{fields: yyDollar[1].tableelems.fields, constraints: append(yyDollar[1].tableelems.constraints, yyDollar[3].constraints...)}
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:721
+		{
+			yyVAL.tableelems = yyDollar[1].tableelems
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:725
+		{
+			yyVAL.tableelems = tableElements{fields: append(yyDollar[1].tableelems.fields, yyDollar[3].tableelems.fields...), constraints: append(yyDollar[1].tableelems.constraints, yyDollar[3].tableelems.constraints...)}
+		}
+	case 103:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:731
+		{
+			for i := range yyDollar[2].constraints {
+				yyDollar[2].constraints[i].Columns = []QueryExpression{yyDollar[1].identifier}
+			}
+			yyVAL.tableelems = tableElements{fields: []QueryExpression{yyDollar[1].identifier}, constraints: yyDollar[2].constraints}
+		}
+	case 104:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:740
+		{
+			yyVAL.constraints = nil
+		}
+	case 105:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:744
+		{
+			yyVAL.constraints = append([]TableConstraint{yyDollar[1].constraint}, yyDollar[2].constraints...)
+		}
+	case 106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:750
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token}
+		}
+	case 107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:754
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:758
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token}
+		}
+	case 109:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:762
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token, Condition: yyDollar[4].queryexpr}
+		}
+	case 110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:768
+		{
+			yyVAL.constraints = []TableConstraint{yyDollar[1].constraint}
+		}
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:772
+		{
+			yyVAL.constraints = append(yyDollar[1].constraints, yyDollar[3].constraint)
+		}
+	case 112:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:778
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token, Columns: yyDollar[5].queryexprs}
+		}
+	case 113:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:782
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token, Columns: yyDollar[5].queryexprs}
+		}
+	case 114:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:786
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token, Columns: yyDollar[4].queryexprs}
+		}
+	case 115:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:790
+		{
+			yyVAL.constraint = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[2].token), Name: yyDollar[1].identifier, Type: yyDollar[2].token, Condition: yyDollar[4].queryexpr}
+		}
+	case 116:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:796
+		{
+			yyVAL.identifier = Identifier{}
+		}
+	case 117:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:800
+		{
+			yyVAL.identifier = yyDollar[2].identifier
+		}
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:681
+//line parser.y:806
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
 		}
-	case 98:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:685
+//line parser.y:810
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
 		}
-	case 99:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:691
+//line parser.y:816
 		{
 			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
-	case 100:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:695
+//line parser.y:820
 		{
 			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
-	case 101:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:701
+//line parser.y:826
 		{
 			yyVAL.expression = nil
 		}
-	case 102:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:705
+//line parser.y:830
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 103:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:709
+//line parser.y:834
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 104:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:713
+//line parser.y:838
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 105:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:717
+//line parser.y:842
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 106:
+	case 127:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:723
+//line parser.y:848
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 107:
+	case 128:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:727
+//line parser.y:852
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Statement: yyDollar[5].identifier}
 		}
-	case 108:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:731
+//line parser.y:856
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 109:
+	case 130:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:735
+//line parser.y:860
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 110:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:739
+//line parser.y:864
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 111:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:743
+//line parser.y:868
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 112:
+	case 133:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:747
+//line parser.y:872
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 113:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:753
+//line parser.y:878
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 114:
+	case 135:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:757
+//line parser.y:882
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 115:
+	case 136:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:761
+//line parser.y:886
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 116:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:765
+//line parser.y:890
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].queryexpr}
 		}
-	case 117:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:771
+//line parser.y:896
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr}
 		}
-	case 118:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:775
+//line parser.y:900
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr, Name: yyDollar[3].identifier}
 		}
-	case 119:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:781
+//line parser.y:906
 		{
 			yyVAL.replacevals = []ReplaceValue{yyDollar[1].replaceval}
 		}
-	case 120:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:785
+//line parser.y:910
 		{
 			yyVAL.replacevals = append([]ReplaceValue{yyDollar[1].replaceval}, yyDollar[3].replacevals...)
 		}
-	case 121:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:791
+//line parser.y:916
 		{
 			yyVAL.statement = StatementPreparation{Name: yyDollar[2].identifier, Statement: value.NewString(yyDollar[4].token.Literal)}
 		}
-	case 122:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:795
+//line parser.y:920
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
 		}
-	case 123:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:799
+//line parser.y:924
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 124:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:803
+//line parser.y:928
 		{
 			yyVAL.statement = DisposeStatement{Name: yyDollar[3].identifier}
 		}
-	case 125:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:809
+//line parser.y:934
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 126:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:815
+//line parser.y:940
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 127:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:819
+//line parser.y:944
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 128:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:825
+//line parser.y:950
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 129:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:831
+//line parser.y:956
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 130:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:835
+//line parser.y:960
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 131:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:841
+//line parser.y:966
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 132:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:845
+//line parser.y:970
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 133:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:849
+//line parser.y:974
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 134:
+	case 155:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:855
+//line parser.y:980
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 135:
+	case 156:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:859
+//line parser.y:984
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 136:
+	case 157:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:863
+//line parser.y:988
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 137:
+	case 158:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:867
+//line parser.y:992
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 138:
+	case 159:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:996
+		{
+			yyVAL.statement = ExternalFunctionDeclaration{Name: yyDollar[2].identifier, Type: yyDollar[3].token, Command: yyDollar[5].queryexpr}
+		}
+	case 160:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1000
+		{
+			yyVAL.statement = ExternalFunctionDeclaration{Name: yyDollar[2].identifier, Type: yyDollar[3].token, Command: yyDollar[5].queryexpr}
+		}
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:871
+//line parser.y:1004
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 139:
+	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:877
+//line parser.y:1010
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 140:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:881
+//line parser.y:1014
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 141:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:885
+//line parser.y:1018
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 142:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:889
+//line parser.y:1022
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 143:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:893
+//line parser.y:1026
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 144:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:897
+//line parser.y:1030
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 145:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:901
+//line parser.y:1034
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 146:
+	case 169:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:907
+//line parser.y:1040
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[5].token}
 		}
-	case 147:
+	case 170:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:911
+//line parser.y:1044
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[6].token}
 		}
-	case 148:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:915
+//line parser.y:1048
 		{
 			yyVAL.queryexpr = CursorAttrebute{Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 149:
+	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:921
+//line parser.y:1054
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 150:
+	case 173:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:925
+//line parser.y:1058
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 151:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:929
+//line parser.y:1062
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 152:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:933
+//line parser.y:1066
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 153:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:937
+//line parser.y:1070
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 154:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:941
+//line parser.y:1074
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 155:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:945
+//line parser.y:1078
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag}
 		}
-	case 156:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:949
+//line parser.y:1082
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 157:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:953
+//line parser.y:1086
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 158:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:957
+//line parser.y:1090
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 159:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:961
+//line parser.y:1094
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 160:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:965
+//line parser.y:1098
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 161:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:969
+//line parser.y:1102
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 162:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:973
+//line parser.y:1106
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 163:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:977
+//line parser.y:1110
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 164:
+	case 187:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:981
+//line parser.y:1114
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 165:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:985
+//line parser.y:1118
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 166:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:989
+//line parser.y:1122
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 167:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:993
+//line parser.y:1126
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 168:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:997
+//line parser.y:1130
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].queryexpr}
 		}
-	case 169:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1001
+//line parser.y:1134
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 170:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1005
+//line parser.y:1138
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 171:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1009
+//line parser.y:1142
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 172:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1013
+//line parser.y:1146
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 173:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1019
+//line parser.y:1152
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 174:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1023
+//line parser.y:1156
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 175:
+	case 198:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1027
+//line parser.y:1160
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 176:
+	case 199:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1033
+//line parser.y:1166
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3198,9 +3470,9 @@ yydefault:
 				LimitClause:   yyDollar[4].queryexpr,
 			}
 		}
-	case 177:
+	case 200:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1042
+//line parser.y:1175
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3210,9 +3482,9 @@ yydefault:
 				Context:       yyDollar[6].token,
 			}
 		}
-	case 178:
+	case 201:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1054
+//line parser.y:1187
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3228,9 +3500,9 @@ yydefault:
 				LimitClause:   yyDollar[9].queryexpr,
 			}
 		}
-	case 179:
+	case 202:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:1070
+//line parser.y:1203
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3247,9 +3519,9 @@ yydefault:
 				Context:       yyDollar[11].token,
 			}
 		}
-	case 180:
+	case 203:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1089
+//line parser.y:1222
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -3259,9 +3531,9 @@ yydefault:
 				HavingClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 181:
+	case 204:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1099
+//line parser.y:1232
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3270,9 +3542,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 182:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1108
+//line parser.y:1241
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3281,9 +3553,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 183:
+	case 206:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1117
+//line parser.y:1250
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3292,93 +3564,93 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 184:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1128
+//line parser.y:1261
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 185:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1132
+//line parser.y:1265
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 186:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1138
+//line parser.y:1271
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 187:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1144
+//line parser.y:1277
 		{
 			yyVAL.queryexpr = IntoClause{Variables: yyDollar[2].variables}
 		}
-	case 188:
+	case 211:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1150
+//line parser.y:1283
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 189:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1154
+//line parser.y:1287
 		{
 			yyVAL.queryexpr = FromClause{Tables: yyDollar[2].queryexprs}
 		}
-	case 190:
+	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1160
+//line parser.y:1293
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 191:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1164
+//line parser.y:1297
 		{
 			yyVAL.queryexpr = WhereClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 192:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1170
+//line parser.y:1303
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 193:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1174
+//line parser.y:1307
 		{
 			yyVAL.queryexpr = GroupByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 194:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1180
+//line parser.y:1313
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 195:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1184
+//line parser.y:1317
 		{
 			yyVAL.queryexpr = HavingClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 196:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1190
+//line parser.y:1323
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 197:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1194
+//line parser.y:1327
 		{
 			yyVAL.queryexpr = OrderByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 198:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1200
+//line parser.y:1333
 		{
 			if yyDollar[1].queryexpr == nil {
 				yyVAL.queryexpr = yyDollar[1].queryexpr
@@ -3386,9 +3658,9 @@ yydefault:
 				yyVAL.queryexpr = LimitClause{BaseExpr: yyDollar[1].queryexpr.(OffsetClause).BaseExpr, OffsetClause: yyDollar[1].queryexpr}
 			}
 		}
-	case 199:
+	case 222:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1208
+//line parser.y:1341
 		{
 			var base *BaseExpr
 			if yyDollar[1].queryexpr == nil {
@@ -3398,333 +3670,339 @@ yydefault:
 			}
 			yyVAL.queryexpr = LimitClause{BaseExpr: base, Type: yyDollar[2].token, Position: yyDollar[3].token, Value: yyDollar[4].queryexpr, Unit: yyDollar[5].token, Restriction: yyDollar[6].token, OffsetClause: yyDollar[1].queryexpr}
 		}
-	case 200:
+	case 223:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1218
+//line parser.y:1351
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token, Restriction: yyDollar[4].token, OffsetClause: yyDollar[5].queryexpr}
 		}
-	case 201:
+	case 224:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1224
+//line parser.y:1357
 		{
 			yyVAL.token = Token{}
 		}
-	case 202:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1228
+//line parser.y:1361
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 203:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1232
+//line parser.y:1365
 		{
 			yyVAL.token = yyDollar[2].token
 		}
-	case 204:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1238
+//line parser.y:1371
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 205:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1242
+//line parser.y:1375
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 206:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1248
+//line parser.y:1381
 		{
 			yyVAL.token = Token{}
 		}
-	case 207:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1252
+//line parser.y:1385
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 208:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1258
+//line parser.y:1391
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 209:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1262
+//line parser.y:1395
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 210:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1266
+//line parser.y:1399
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 211:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1272
+//line parser.y:1405
 		{
 			yyVAL.token = Token{}
 		}
-	case 212:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1276
+//line parser.y:1409
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 213:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1280
+//line parser.y:1413
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 214:
+	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1286
+//line parser.y:1419
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 215:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1290
+//line parser.y:1423
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token}
 		}
-	case 216:
+	case 239:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1296
+//line parser.y:1429
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 217:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1300
+//line parser.y:1433
 		{
 			yyVAL.queryexpr = WithClause{InlineTables: yyDollar[2].queryexprs}
 		}
-	case 218:
+	case 241:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1306
+//line parser.y:1439
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 219:
+	case 242:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1310
+//line parser.y:1443
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 220:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1316
+//line parser.y:1449
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 221:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1320
+//line parser.y:1453
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 222:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1326
+//line parser.y:1459
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 223:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1330
+//line parser.y:1463
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 224:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1334
+//line parser.y:1467
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 225:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1338
+//line parser.y:1471
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 226:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1342
+//line parser.y:1475
 		{
 			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal, yylex.(*Lexer).GetDatetimeFormats())
 		}
-	case 227:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1346
+//line parser.y:1479
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 228:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1352
+//line parser.y:1485
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 229:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1358
+//line parser.y:1491
 		{
 			yyVAL.queryexpr = NewNullValue()
 		}
-	case 230:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1364
+//line parser.y:1497
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 231:
+	case 254:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1368
+//line parser.y:1501
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 232:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1372
+//line parser.y:1505
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 233:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1376
+//line parser.y:1509
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 234:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1380
+//line parser.y:1513
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 235:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1386
+//line parser.y:1519
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 236:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1390
+//line parser.y:1523
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 237:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1394
+//line parser.y:1527
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 238:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1400
+//line parser.y:1533
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 239:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1404
+//line parser.y:1537
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 240:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1408
+//line parser.y:1541
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 241:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1412
+//line parser.y:1545
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1416
+//line parser.y:1549
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 243:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1420
+//line parser.y:1553
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 244:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1424
+//line parser.y:1557
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 245:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1428
+//line parser.y:1561
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 246:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1432
+//line parser.y:1565
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 247:
+	case 270:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1569
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1436
+//line parser.y:1573
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 248:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1440
+//line parser.y:1577
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 249:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1444
+//line parser.y:1581
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 250:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1448
+//line parser.y:1585
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 251:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1452
+//line parser.y:1589
 		{
 			yyVAL.queryexpr = yyDollar[1].flag
 		}
-	case 252:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1456
+//line parser.y:1593
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 253:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1460
+//line parser.y:1597
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 254:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1464
+//line parser.y:1601
 		{
 			name := ""
 			if yyDollar[1].token.Literal[0] == ':' {
@@ -3732,117 +4010,117 @@ yydefault:
 			}
 			yyVAL.queryexpr = Placeholder{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Ordinal: yyDollar[1].token.HolderOrdinal, Name: name}
 		}
-	case 255:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1474
+//line parser.y:1611
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 256:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1480
+//line parser.y:1617
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 257:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1484
+//line parser.y:1621
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 258:
+	case 282:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1488
+//line parser.y:1625
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 259:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1494
+//line parser.y:1631
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 260:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1498
+//line parser.y:1635
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 261:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1504
+//line parser.y:1641
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 262:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1508
+//line parser.y:1645
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 263:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1514
+//line parser.y:1651
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 264:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1518
+//line parser.y:1655
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, NullsPosition: yyDollar[4].token}
 		}
-	case 265:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1524
+//line parser.y:1661
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 266:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1528
+//line parser.y:1665
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 267:
+	case 291:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1534
+//line parser.y:1671
 		{
 			yyVAL.token = Token{}
 		}
-	case 268:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1538
+//line parser.y:1675
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 269:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1542
+//line parser.y:1679
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 270:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1548
+//line parser.y:1685
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 271:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1552
+//line parser.y:1689
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 272:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1558
+//line parser.y:1695
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 273:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1564
+//line parser.y:1701
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3863,1442 +4141,1514 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 274:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1587
+//line parser.y:1724
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 275:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1591
+//line parser.y:1728
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 276:
+	case 300:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1595
+//line parser.y:1732
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 277:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1601
+//line parser.y:1738
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 278:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1605
+//line parser.y:1742
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 279:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1609
+//line parser.y:1746
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 280:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1613
+//line parser.y:1750
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 281:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1617
+//line parser.y:1754
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 282:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1621
+//line parser.y:1758
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 283:
+	case 307:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1625
+//line parser.y:1762
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 284:
+	case 308:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1629
+//line parser.y:1766
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 285:
+	case 309:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1633
+//line parser.y:1770
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 286:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1637
+//line parser.y:1774
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 287:
+	case 311:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1641
+//line parser.y:1778
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 288:
+	case 312:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1645
+//line parser.y:1782
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 289:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1649
+//line parser.y:1786
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 290:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1653
+//line parser.y:1790
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 291:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1657
+//line parser.y:1794
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 292:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1661
+//line parser.y:1798
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 293:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1665
+//line parser.y:1802
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 294:
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1669
+//line parser.y:1806
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 295:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1673
+//line parser.y:1810
 		{
 			yyVAL.queryexpr = Exists{Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 296:
+	case 320:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1816
+		{
+			yyVAL.queryexpr = AtTimeZone{Expr: yyDollar[1].queryexpr, TimeZone: yyDollar[3].queryexpr}
+		}
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1679
+//line parser.y:1822
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 297:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1683
+//line parser.y:1826
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 298:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1687
+//line parser.y:1830
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 299:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1691
+//line parser.y:1834
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 300:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1695
+//line parser.y:1838
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 301:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1699
+//line parser.y:1842
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 302:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1703
+//line parser.y:1846
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 303:
+	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1709
+//line parser.y:1852
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 304:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1713
+//line parser.y:1856
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 305:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1717
+//line parser.y:1860
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 306:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1721
+//line parser.y:1864
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 307:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1727
+//line parser.y:1870
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 308:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1731
+//line parser.y:1874
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 309:
+	case 334:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1737
+//line parser.y:1880
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 310:
+	case 335:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1741
+//line parser.y:1884
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 311:
+	case 336:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1745
+//line parser.y:1888
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr}, From: yyDollar[4].token}
 		}
-	case 312:
+	case 337:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1749
+//line parser.y:1892
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr, yyDollar[7].queryexpr}, From: yyDollar[4].token, For: yyDollar[6].token}
 		}
-	case 313:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1753
+//line parser.y:1896
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 314:
+	case 339:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1757
+//line parser.y:1900
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 315:
+	case 340:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1761
+//line parser.y:1904
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 316:
+	case 341:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1765
+//line parser.y:1908
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 317:
+	case 342:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1772
+//line parser.y:1915
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 318:
+	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1776
+//line parser.y:1919
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 319:
+	case 344:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:1923
+		{
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr}
+		}
+	case 345:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1780
+//line parser.y:1927
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 320:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1784
+//line parser.y:1931
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 321:
+	case 347:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1788
+//line parser.y:1935
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 322:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1792
+//line parser.y:1939
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 323:
+	case 349:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1798
+//line parser.y:1945
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 324:
+	case 350:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1802
+//line parser.y:1949
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 325:
+	case 351:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1808
+//line parser.y:1955
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 326:
+	case 352:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1812
+//line parser.y:1959
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 327:
+	case 353:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1816
+//line parser.y:1963
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 328:
+	case 354:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser.y:1967
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr, AnalyticClause: AnalyticClause{PartitionClause: yyDollar[13].queryexpr}}
+		}
+	case 355:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1820
+//line parser.y:1971
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 329:
+	case 356:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1824
+//line parser.y:1975
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 330:
+	case 357:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1828
+//line parser.y:1979
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 331:
+	case 358:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1832
+//line parser.y:1983
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 332:
+	case 359:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser.y:1987
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr, AnalyticClause: AnalyticClause{PartitionClause: yyDollar[13].queryexpr}}
+		}
+	case 360:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1836
+//line parser.y:1991
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 333:
+	case 361:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1840
+//line parser.y:1995
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 334:
+	case 362:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1844
+//line parser.y:1999
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 335:
+	case 363:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1848
+//line parser.y:2003
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 336:
+	case 364:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1852
+//line parser.y:2007
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 337:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1858
+//line parser.y:2013
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 338:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1864
+//line parser.y:2019
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 339:
+	case 367:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1868
+//line parser.y:2023
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: OrderByClause{Items: yyDollar[4].queryexprs}, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 340:
+	case 368:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1874
+//line parser.y:2029
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 341:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1878
+//line parser.y:2033
 		{
 			yyVAL.queryexpr = PartitionClause{Values: yyDollar[3].queryexprs}
 		}
-	case 342:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1884
+//line parser.y:2039
 		{
 			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[2].queryexpr}
 		}
-	case 343:
+	case 371:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1888
+//line parser.y:2043
 		{
 			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr}
 		}
-	case 344:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1894
+//line parser.y:2049
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 345:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1898
+//line parser.y:2053
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 346:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1903
+//line parser.y:2058
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 347:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1909
+//line parser.y:2064
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 348:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1914
+//line parser.y:2069
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 349:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1919
+//line parser.y:2074
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 350:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1925
+//line parser.y:2080
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 351:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1929
+//line parser.y:2084
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 352:
+	case 380:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1935
+//line parser.y:2090
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 353:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1939
+//line parser.y:2094
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 354:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1945
+//line parser.y:2100
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 355:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1949
+//line parser.y:2104
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 356:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1955
+//line parser.y:2110
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 357:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1959
+//line parser.y:2114
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 358:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1963
+//line parser.y:2118
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 359:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1967
+//line parser.y:2122
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 360:
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2126
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 389:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2130
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 390:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1973
+//line parser.y:2136
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: nil}
 		}
-	case 361:
+	case 391:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1977
+//line parser.y:2140
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: yyDollar[5].queryexprs}
 		}
-	case 362:
+	case 392:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1981
+//line parser.y:2144
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: nil}
 		}
-	case 363:
+	case 393:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1985
+//line parser.y:2148
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: yyDollar[7].queryexprs}
 		}
-	case 364:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1991
+//line parser.y:2154
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 365:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1995
+//line parser.y:2158
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 366:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2001
+//line parser.y:2164
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 367:
+	case 397:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2005
+//line parser.y:2168
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
 		}
-	case 368:
+	case 398:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2009
+//line parser.y:2172
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 369:
+	case 399:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2178
+		{
+			yyVAL.queryexpr = TableFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2015
+//line parser.y:2184
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2188
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 402:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2194
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 370:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2019
+//line parser.y:2198
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 371:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2023
+//line parser.y:2202
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 372:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2029
+//line parser.y:2208
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 373:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2033
+//line parser.y:2212
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = []QueryExpression{yyDollar[2].table}
 		}
-	case 374:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2039
+//line parser.y:2218
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].table}, yyDollar[3].queryexprs...)
 		}
-	case 375:
+	case 408:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2043
+//line parser.y:2222
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[2].table}, yyDollar[4].queryexprs...)
 		}
-	case 376:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2051
+//line parser.y:2230
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 377:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2055
+//line parser.y:2234
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 378:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2059
+//line parser.y:2238
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 379:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2063
+//line parser.y:2242
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 380:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2067
+//line parser.y:2246
 		{
 			yyVAL.queryexpr = Table{Object: Dual{}}
 		}
-	case 381:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2071
+//line parser.y:2250
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 382:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2075
+//line parser.y:2254
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 383:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2081
+//line parser.y:2260
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 384:
+	case 417:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2085
+//line parser.y:2264
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 385:
+	case 418:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2089
+//line parser.y:2268
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 386:
+	case 419:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2093
+//line parser.y:2272
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 387:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2097
+//line parser.y:2276
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 388:
+	case 421:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2101
+//line parser.y:2280
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 389:
+	case 422:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2107
+//line parser.y:2286
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 390:
+	case 423:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2113
+//line parser.y:2292
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[7].queryexpr}
 		}
-	case 391:
+	case 424:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2119
+//line parser.y:2298
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 392:
+	case 425:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2125
+//line parser.y:2304
 		{
 			yyDollar[7].table.Lateral = yyDollar[6].token
 			yyDollar[7].table.BaseExpr = NewBaseExpr(yyDollar[6].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[7].table, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 393:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2133
+//line parser.y:2312
 		{
 			yyVAL.queryexpr = JoinCondition{On: yyDollar[2].queryexpr}
 		}
-	case 394:
+	case 427:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2137
+//line parser.y:2316
 		{
 			yyVAL.queryexpr = JoinCondition{Using: yyDollar[3].queryexprs}
 		}
-	case 395:
+	case 428:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2143
+//line parser.y:2322
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 396:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2147
+//line parser.y:2326
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 397:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2153
+//line parser.y:2332
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 398:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2157
+//line parser.y:2336
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 399:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2161
+//line parser.y:2340
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 400:
+	case 433:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2167
+//line parser.y:2346
 		{
 			yyVAL.queryexpr = CaseExpr{Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 401:
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2173
+//line parser.y:2352
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 402:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2177
+//line parser.y:2356
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 403:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2183
+//line parser.y:2362
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 404:
+	case 437:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2187
+//line parser.y:2366
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 405:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2193
+//line parser.y:2372
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 406:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2197
+//line parser.y:2376
 		{
 			yyVAL.queryexpr = CaseExprElse{Result: yyDollar[2].queryexpr}
 		}
-	case 407:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2203
+//line parser.y:2382
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 408:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2207
+//line parser.y:2386
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 409:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2213
+//line parser.y:2392
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 410:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2217
+//line parser.y:2396
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 411:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2223
+//line parser.y:2402
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 412:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2227
+//line parser.y:2406
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 413:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2233
+//line parser.y:2412
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 414:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2237
+//line parser.y:2416
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 415:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2243
+//line parser.y:2422
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 416:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2247
+//line parser.y:2426
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 417:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2253
+//line parser.y:2432
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 418:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2257
+//line parser.y:2436
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 419:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2263
+//line parser.y:2442
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 420:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2267
+//line parser.y:2446
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 421:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2273
+//line parser.y:2452
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 422:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2277
+//line parser.y:2456
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 423:
+	case 456:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2283
+//line parser.y:2462
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs}
+			yyVAL.expression = InsertQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 424:
+	case 457:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2287
+//line parser.y:2466
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
+			yyVAL.expression = InsertQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 425:
+	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2291
+//line parser.y:2470
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery)}
+			yyVAL.expression = InsertQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 426:
+	case 459:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2295
+//line parser.y:2474
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
+			yyVAL.expression = InsertQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 427:
+	case 460:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2301
+//line parser.y:2480
 		{
-			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
+			yyVAL.expression = UpdateQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 428:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2307
+//line parser.y:2486
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 429:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2313
+//line parser.y:2492
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 430:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2317
+//line parser.y:2496
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 431:
+	case 464:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:2323
+//line parser.y:2502
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs}
 		}
-	case 432:
+	case 465:
 		yyDollar = yyS[yypt-13 : yypt+1]
-		//line parser.y:2327
+//line parser.y:2506
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs}
 		}
-	case 433:
+	case 466:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2331
+//line parser.y:2510
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery)}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery)}
 		}
-	case 434:
+	case 467:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2335
+//line parser.y:2514
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery)}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery)}
 		}
-	case 435:
+	case 468:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2339
+//line parser.y:2518
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 436:
+	case 469:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2343
+//line parser.y:2522
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs}
 		}
-	case 437:
+	case 470:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2347
+//line parser.y:2526
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 438:
+	case 471:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:2351
+//line parser.y:2530
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery)}
+			yyVAL.expression = ReplaceQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery)}
 		}
-	case 439:
+	case 472:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2357
+//line parser.y:2536
 		{
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: FromClause{Tables: yyDollar[4].queryexprs}, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 440:
+	case 473:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2361
+//line parser.y:2540
 		{
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: FromClause{Tables: yyDollar[5].queryexprs}, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 441:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2367
+//line parser.y:2546
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 442:
+	case 475:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2371
+//line parser.y:2550
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 443:
+	case 476:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2377
+//line parser.y:2556
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 444:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2381
+//line parser.y:2560
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 445:
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2387
+//line parser.y:2566
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 446:
+	case 479:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2391
+//line parser.y:2570
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 447:
+	case 480:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2397
+//line parser.y:2576
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 448:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2401
+//line parser.y:2580
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 449:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2407
+//line parser.y:2586
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 450:
+	case 483:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2411
+//line parser.y:2590
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 451:
+	case 484:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2417
+//line parser.y:2596
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 452:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2421
+//line parser.y:2600
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 453:
+	case 486:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2427
+//line parser.y:2606
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 454:
+	case 487:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2431
+//line parser.y:2610
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 455:
+	case 488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2437
+//line parser.y:2616
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 456:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2441
+//line parser.y:2620
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 457:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2447
+//line parser.y:2626
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 458:
+	case 491:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2451
+//line parser.y:2630
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 459:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2457
+//line parser.y:2636
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 460:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2461
+//line parser.y:2640
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 461:
+	case 494:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2467
+//line parser.y:2646
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 462:
+	case 495:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2471
+//line parser.y:2650
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 463:
+	case 496:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2477
+//line parser.y:2656
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 464:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2481
+//line parser.y:2660
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 465:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2487
+//line parser.y:2666
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 466:
+	case 499:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2491
+//line parser.y:2670
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 467:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2497
+//line parser.y:2676
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 468:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2501
+//line parser.y:2680
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 469:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2507
+//line parser.y:2686
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 470:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2511
+//line parser.y:2690
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 471:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2517
+//line parser.y:2696
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 472:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2521
+//line parser.y:2700
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 473:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2527
+//line parser.y:2706
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 474:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2531
+//line parser.y:2710
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 475:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2535
+//line parser.y:2714
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 476:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2539
+//line parser.y:2718
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 477:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2543
+//line parser.y:2722
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 478:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2547
+//line parser.y:2726
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 479:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2551
+//line parser.y:2730
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 480:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2555
+//line parser.y:2734
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 481:
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2738
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2742
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2561
+//line parser.y:2746
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2752
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 482:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2567
+//line parser.y:2758
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 483:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2571
+//line parser.y:2762
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 484:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2577
+//line parser.y:2768
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 485:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2583
+//line parser.y:2774
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 486:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2587
+//line parser.y:2778
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 487:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2593
+//line parser.y:2784
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 488:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2597
+//line parser.y:2788
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 489:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2603
+//line parser.y:2794
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 490:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2609
+//line parser.y:2800
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 491:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2615
+//line parser.y:2806
 		{
 			yyVAL.flag = Flag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 492:
+	case 528:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2621
+//line parser.y:2812
 		{
 			yyVAL.token = Token{}
 		}
-	case 493:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2625
+//line parser.y:2816
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 494:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2631
+//line parser.y:2822
 		{
 			yyVAL.token = Token{}
 		}
-	case 495:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2635
+//line parser.y:2826
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 496:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2641
+//line parser.y:2832
 		{
 			yyVAL.token = Token{}
 		}
-	case 497:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2645
+//line parser.y:2836
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 498:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2651
+//line parser.y:2842
 		{
 			yyVAL.token = Token{}
 		}
-	case 499:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2655
+//line parser.y:2846
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 500:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2661
+//line parser.y:2852
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 501:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2665
+//line parser.y:2856
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 502:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2669
+//line parser.y:2860
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 503:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2675
+//line parser.y:2866
 		{
 			yyVAL.token = Token{}
 		}
-	case 504:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2679
+//line parser.y:2870
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 505:
+	case 541:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2685
+//line parser.y:2876
 		{
 			yyVAL.token = Token{}
 		}
-	case 506:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2689
+//line parser.y:2880
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 507:
+	case 543:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2695
+//line parser.y:2886
 		{
 			yyVAL.token = Token{}
 		}
-	case 508:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2699
+//line parser.y:2890
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 509:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2705
+//line parser.y:2896
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 510:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2709
+//line parser.y:2900
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
@@ -6,6 +6,11 @@ import (
 
 	"github.com/mithrandie/csvq/lib/value"
 )
+
+type tableElements struct {
+	fields      []QueryExpression
+	constraints []TableConstraint
+}
 %}
 
 %union{
@@ -27,6 +32,9 @@ import (
     updatesets  []UpdateSet
     columndef   ColumnDefault
     columndefs  []ColumnDefault
+    constraint  TableConstraint
+    constraints []TableConstraint
+    tableelems  tableElements
     elseif      []ElseIf
     elseexpr    Else
     casewhen    []CaseWhen
@@ -62,6 +70,14 @@ import (
 %type<statement>   table_operation_statement
 %type<columndef>   column_default
 %type<columndefs>  column_defaults
+%type<tableelems>  table_elements
+%type<tableelems>  column_definitions
+%type<tableelems>  column_definition
+%type<constraints> column_constraints
+%type<constraint>  column_constraint
+%type<constraints> table_constraints
+%type<constraint>  table_constraint
+%type<identifier>  constraint_name
 %type<expression>  column_position
 %type<statement>   cursor_statement
 %type<statement>   temporary_table_statement
@@ -115,6 +131,7 @@ import (
 %type<token>       order_null_position
 %type<queryexpr>   subquery
 %type<queryexpr>   string_operation
+%type<queryexpr>   time_zone_conversion
 %type<queryexpr>   matrix_value
 %type<queryexpr>   comparison
 %type<queryexpr>   arithmetic
@@ -139,6 +156,8 @@ import (
 %type<queryexprs>  identified_tables
 %type<queryexprs>  updatable_tables
 %type<queryexpr>   virtual_table_object
+%type<queryexpr>   table_function
+%type<queryexpr>   laterable_table_object
 %type<table>       laterable_query_table
 %type<queryexprs>  joinable_tables
 %type<queryexpr>   table
@@ -202,6 +221,7 @@ import (
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES REPLACE AS DUAL STDIN
 %token<token> RECURSIVE
 %token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> CONSTRAINT PRIMARY KEY UNIQUE CHECK
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL LATERAL
 %token<token> UNION INTERSECT EXCEPT
@@ -216,14 +236,15 @@ import (
 %token<token> COMMIT ROLLBACK
 %token<token> CONTINUE BREAK EXIT
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
-%token<token> FUNCTION AGGREGATE BEGIN RETURN
+%token<token> FUNCTION AGGREGATE BEGIN RETURN EXTERNAL
 %token<token> IGNORE WITHIN
 %token<token> VAR SHOW
 %token<token> TIES NULLS ROWS ONLY
-%token<token> CSV JSON FIXED LTSV
+%token<token> CSV JSON FIXED LTSV XML YAML
 %token<token> JSON_ROW JSON_TABLE
 %token<token> SUBSTRING COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
+%token<token> AT_TIME_ZONE
 %token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
 %token<token> UMINUS UPLUS
 %token<token> ';' '=' '-' '+' '*' '/' '%' '!' '(' ')'
@@ -239,6 +260,7 @@ import (
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
+%left AT_TIME_ZONE
 %right UMINUS UPLUS '!'
 
 %%
@@ -635,17 +657,17 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' table_elements ')'
     {
-        $$ = CreateTable{Table: $3, Fields: $5}
+        $$ = CreateTable{BaseExpr: NewBaseExpr($1), Table: $3, Fields: $5.fields, Constraints: $5.constraints}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' table_elements ')' as select_query
     {
-        $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
+        $$ = CreateTable{BaseExpr: NewBaseExpr($1), Table: $3, Fields: $5.fields, Constraints: $5.constraints, Query: $8}
     }
     | CREATE TABLE identifier as select_query
     {
-        $$ = CreateTable{Table: $3, Query: $5}
+        $$ = CreateTable{BaseExpr: NewBaseExpr($1), Table: $3, Query: $5}
     }
     | ALTER TABLE updatable_table_identifier ADD column_default column_position
     {
@@ -667,6 +689,14 @@ table_operation_statement
     {
         $$ = RenameColumn{Table: $3, Old: $5, New: $7}
     }
+    | ALTER TABLE updatable_table_identifier ADD table_constraint
+    {
+        $$ = AddConstraint{BaseExpr: NewBaseExpr($1), Table: $3, Constraint: $5}
+    }
+    | ALTER TABLE updatable_table_identifier DROP CONSTRAINT identifier
+    {
+        $$ = DropConstraint{BaseExpr: NewBaseExpr($1), Table: $3, Name: $6}
+    }
     | ALTER TABLE updatable_table_identifier SET identifier TO identifier
     {
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
@@ -676,6 +706,101 @@ table_operation_statement
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
     }
 
+table_elements
+    : column_definitions
+    {
+        $$ = $1
+    }
+    | column_definitions ',' table_constraints
+    {
+        $$ = tableElements{fields: $1.fields, constraints: append($1.constraints, $3...)}
+    }
+
+column_definitions
+    : column_definition
+    {
+        $$ = $1
+    }
+    | column_definitions ',' column_definition
+    {
+        $$ = tableElements{fields: append($1.fields, $3.fields...), constraints: append($1.constraints, $3.constraints...)}
+    }
+
+column_definition
+    : identifier column_constraints
+    {
+        for i := range $2 {
+            $2[i].Columns = []QueryExpression{$1}
+        }
+        $$ = tableElements{fields: []QueryExpression{$1}, constraints: $2}
+    }
+
+column_constraints
+    :
+    {
+        $$ = nil
+    }
+    | column_constraint column_constraints
+    {
+        $$ = append([]TableConstraint{$1}, $2...)
+    }
+
+column_constraint
+    : constraint_name NOT NULL
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2}
+    }
+    | constraint_name PRIMARY KEY
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2}
+    }
+    | constraint_name UNIQUE
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2}
+    }
+    | constraint_name CHECK '(' value ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2, Condition: $4}
+    }
+
+table_constraints
+    : table_constraint
+    {
+        $$ = []TableConstraint{$1}
+    }
+    | table_constraints ',' table_constraint
+    {
+        $$ = append($1, $3)
+    }
+
+table_constraint
+    : constraint_name NOT NULL '(' identifiers ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2, Columns: $5}
+    }
+    | constraint_name PRIMARY KEY '(' identifiers ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2, Columns: $5}
+    }
+    | constraint_name UNIQUE '(' identifiers ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2, Columns: $4}
+    }
+    | constraint_name CHECK '(' value ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($2), Name: $1, Type: $2, Condition: $4}
+    }
+
+constraint_name
+    :
+    {
+        $$ = Identifier{}
+    }
+    | CONSTRAINT identifier
+    {
+        $$ = $2
+    }
+
 column_default
     : identifier
     {
@@ -867,6 +992,14 @@ user_defined_function_statement
     {
         $$ = AggregateDeclaration{Name: $2, Cursor: $5, Parameters: $7, Statements: $11}
     }
+    | DECLARE identifier FUNCTION EXTERNAL substantial_value
+    {
+        $$ = ExternalFunctionDeclaration{Name: $2, Type: $3, Command: $5}
+    }
+    | DECLARE identifier AGGREGATE EXTERNAL substantial_value
+    {
+        $$ = ExternalFunctionDeclaration{Name: $2, Type: $3, Command: $5}
+    }
     | DISPOSE FUNCTION identifier
     {
         $$ = DisposeFunction{Name: $3}
@@ -1408,6 +1541,10 @@ substantial_value
     {
         $$ = $1
     }
+    | time_zone_conversion
+    {
+        $$ = $1
+    }
     | subquery
     {
         $$ = $1
@@ -1674,6 +1811,12 @@ comparison
         $$ = Exists{Query: $2.(Subquery)}
     }
 
+time_zone_conversion
+    : value AT_TIME_ZONE value
+    {
+        $$ = AtTimeZone{Expr: $1, TimeZone: $3}
+    }
+
 arithmetic
     : value '+' value
     {
@@ -1776,6 +1919,10 @@ aggregate_function
     {
         $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
     }
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9}
+    }
     | VAR '(' distinct arguments ')'
     {
         $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
@@ -1816,6 +1963,10 @@ analytic_function
     {
         $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
     }
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' OVER '(' partition_clause ')'
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9, AnalyticClause: AnalyticClause{PartitionClause: $13}}
+    }
     | VAR '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
     {
         $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
@@ -1832,6 +1983,10 @@ analytic_function
     {
         $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
     }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' OVER '(' partition_clause ')'
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9, AnalyticClause: AnalyticClause{PartitionClause: $13}}
+    }
     | ANALYTIC_FUNCTION '(' arguments ')' OVER '(' analytic_clause ')'
     {
         $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $7.(AnalyticClause)}
@@ -1967,6 +2122,14 @@ table_object_type
     {
         $$ = $1
     }
+    | XML
+    {
+        $$ = $1
+    }
+    | YAML
+    {
+        $$ = $1
+    }
 
 table_object
     : table_object_type '(' table_identifier ')'
@@ -2010,16 +2173,32 @@ virtual_table_object
         $$ = JsonQuery{BaseExpr: NewBaseExpr($1), JsonQuery: $1, Query: $3, JsonText: $5}
     }
 
-laterable_query_table
+table_function
+    : IDENTIFIER '(' arguments ')'
+    {
+        $$ = TableFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+
+laterable_table_object
     : subquery
+    {
+        $$ = $1
+    }
+    | table_function
+    {
+        $$ = $1
+    }
+
+laterable_query_table
+    : laterable_table_object
     {
         $$ = Table{Object: $1}
     }
-    | subquery identifier
+    | laterable_table_object identifier
     {
         $$ = Table{Object: $1, Alias: $2}
     }
-    | subquery AS identifier
+    | laterable_table_object AS identifier
     {
         $$ = Table{Object: $1, As: $2, Alias: $3}
     }
@@ -2281,25 +2460,25 @@ fields
 insert_query
     : with_clause INSERT INTO updatable_table_identifier VALUES row_values
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6}
+        $$ = InsertQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, ValuesList: $6}
     }
     | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9}
+        $$ = InsertQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9}
     }
     | with_clause INSERT INTO updatable_table_identifier select_query
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery)}
+        $$ = InsertQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery)}
     }
     | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery)}
+        $$ = InsertQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery)}
     }
 
 update_query
     : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause
     {
-        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7}
+        $$ = UpdateQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7}
     }
 
 update_set
@@ -2321,35 +2500,35 @@ update_set_list
 replace_query
     : with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, ValuesList: $10}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Keys: $7, ValuesList: $10}
     }
     | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, ValuesList: $13}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, ValuesList: $13}
     }
     | with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, Query: $9.(SelectQuery)}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Keys: $7, Query: $9.(SelectQuery)}
     }
     | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, Query: $12.(SelectQuery)}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, Query: $12.(SelectQuery)}
     }
     | REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, ValuesList: $9}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($1), Table: Table{Object: $3}, Keys: $6, ValuesList: $9}
     }
     | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, ValuesList: $12}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($1), Table: Table{Object: $3}, Fields: $5, Keys: $9, ValuesList: $12}
     }
     | REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, Query: $8.(SelectQuery)}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($1), Table: Table{Object: $3}, Keys: $6, Query: $8.(SelectQuery)}
     }
     | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, Query: $11.(SelectQuery)}
+        $$ = ReplaceQuery{BaseExpr: NewBaseExpr($1), Table: Table{Object: $3}, Fields: $5, Keys: $9, Query: $11.(SelectQuery)}
     }
 
 delete_query
@@ -2555,6 +2734,18 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | XML
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | YAML
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | KEY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -278,6 +278,62 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from xml('catalog.book', `books.xml`, 'utf8')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Token{Token: XML, Literal: "xml", Line: 1, Char: 16},
+								FormatElement: NewStringValue("catalog.book"),
+								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "books.xml", Quoted: true},
+								Args:          []QueryExpression{NewStringValue("utf8")},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from yaml('items', `items.yaml`, 'utf8')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Token{Token: YAML, Literal: "yaml", Line: 1, Char: 16},
+								FormatElement: NewStringValue("items"),
+								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "items.yaml", Quoted: true},
+								Args:          []QueryExpression{NewStringValue("utf8")},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from ltsv(`table.ltsv`)",
 		Output: []Statement{
@@ -385,6 +441,37 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from diff(`old.csv`, `new.csv`, 'id') d",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Name:     "diff",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 21}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "old.csv", Quoted: true}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 32}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "new.csv", Quoted: true}},
+									NewStringValue("id"),
+								},
+							},
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "d"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from json_table('key', '{\"key2\":1}') jt",
 		Output: []Statement{
@@ -1112,6 +1199,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select ident at time zone 'Asia/Tokyo' + 1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Arithmetic{
+								LHS: AtTimeZone{
+									Expr:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}},
+									TimeZone: NewStringValue("Asia/Tokyo"),
+								},
+								Operator: Token{Token: '+', Literal: "+", Line: 1, Char: 40},
+								RHS:      NewIntegerValueFromString("1"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 = 1",
 		Output: []Statement{
@@ -2603,6 +2712,161 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select percentile_cont(0.9) within group (order by column1 desc)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_cont",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.9"),
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}}, Direction: Token{Token: DESC, Literal: "desc", Line: 1, Char: 60}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select percentile_disc(0.5) within group (order by column1) over (partition by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_disc",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.5"),
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}}},
+									},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 80}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "column2"}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode(column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "mode",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode() within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "mode",
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode() within group (order by column1) over (partition by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "mode",
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}}},
+									},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 66}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "column2"}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select regr_slope(column1, column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "regr_slope",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 28}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select cursor cur is not open",
 		Output: []Statement{
@@ -3441,6 +3705,40 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from table1 cross join lateral generate_series(1, c1) as s",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									JoinTable: Table{
+										BaseExpr: &BaseExpr{line: 1, char: 33},
+										Lateral:  Token{Token: LATERAL, Literal: "lateral", Line: 1, Char: 33},
+										Object: TableFunction{
+											BaseExpr: &BaseExpr{line: 1, char: 41},
+											Name:     "generate_series",
+											Args: []QueryExpression{
+												NewIntegerValueFromString("1"),
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 60}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "c1"}},
+											},
+										},
+										As:    Token{Token: AS, Literal: "as", Line: 1, Char: 64},
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 67}, Literal: "s"},
+									},
+									JoinType: Token{Token: CROSS, Literal: "cross", Line: 1, Char: 22},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 cross join table2 cross join table3",
 		Output: []Statement{
@@ -4006,6 +4304,7 @@ var parseTests = []struct {
 		Input: "with ct as (select 1) insert into table1 values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
 			InsertQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
 				WithClause: WithClause{
 					InlineTables: []QueryExpression{
 						InlineTable{
@@ -4051,7 +4350,8 @@ var parseTests = []struct {
 		Input: "insert into table1 (column1, column2, table1.3) values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
 			InsertQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
 				Fields: []QueryExpression{
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 21}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "column1"}},
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column2"}},
@@ -4084,7 +4384,8 @@ var parseTests = []struct {
 		Input: "insert into table1 select 1, 2",
 		Output: []Statement{
 			InsertQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
 				Query: SelectQuery{
 					SelectEntity: SelectEntity{
 						SelectClause: SelectClause{
@@ -4103,7 +4404,8 @@ var parseTests = []struct {
 		Input: "insert into table1 (column1, column2) select 1, 2",
 		Output: []Statement{
 			InsertQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
 				Fields: []QueryExpression{
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 21}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "column1"}},
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column2"}},
@@ -4126,6 +4428,7 @@ var parseTests = []struct {
 		Input: "with ct as (select 1) update table1 set column1 = 1, column2 = 2, table1.3 = 3 from table1 where true",
 		Output: []Statement{
 			UpdateQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
 				WithClause: WithClause{
 					InlineTables: []QueryExpression{
 						InlineTable{
@@ -4166,6 +4469,7 @@ var parseTests = []struct {
 		Input: "update csv(',', table1) set column1 = 1, column2 = 2, table1.3 = 3 where true",
 		Output: []Statement{
 			UpdateQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Tables: []QueryExpression{
 					Table{Object: TableObject{
 						BaseExpr:      &BaseExpr{line: 1, char: 8},
@@ -4189,6 +4493,7 @@ var parseTests = []struct {
 		Input: "with ct as (select 1) replace into table1 using(col1) values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
 			ReplaceQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
 				WithClause: WithClause{
 					InlineTables: []QueryExpression{
 						InlineTable{
@@ -4237,6 +4542,7 @@ var parseTests = []struct {
 		Input: "with ct as (select 1) replace into table1 (column1, column2, table1.3) using (column1, column2) values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
 			ReplaceQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
 				WithClause: WithClause{
 					InlineTables: []QueryExpression{
 						InlineTable{
@@ -4291,6 +4597,7 @@ var parseTests = []struct {
 		Input: "with ct as (select 1) replace into table1 using (table1.1) select 1, 2",
 		Output: []Statement{
 			ReplaceQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
 				WithClause: WithClause{
 					InlineTables: []QueryExpression{
 						InlineTable{
@@ -4330,6 +4637,7 @@ var parseTests = []struct {
 		Input: "with ct as (select 1) replace into table1 (column1, column2) using (column1) select 1, 2",
 		Output: []Statement{
 			ReplaceQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
 				WithClause: WithClause{
 					InlineTables: []QueryExpression{
 						InlineTable{
@@ -4373,7 +4681,8 @@ var parseTests = []struct {
 		Input: "replace into table1 using(col1) values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
 			ReplaceQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
 				Keys: []QueryExpression{
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 27}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "col1"}},
 				},
@@ -4404,7 +4713,8 @@ var parseTests = []struct {
 		Input: "replace into table1 (column1, column2, table1.3) using (column1, column2) values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
 			ReplaceQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
 				Fields: []QueryExpression{
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "column1"}},
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
@@ -4441,7 +4751,8 @@ var parseTests = []struct {
 		Input: "replace into table1 using (table1.1) select 1, 2",
 		Output: []Statement{
 			ReplaceQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
 				Keys: []QueryExpression{
 					ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 28}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}, Number: value.NewInteger(1)},
 				},
@@ -4463,7 +4774,8 @@ var parseTests = []struct {
 		Input: "replace into table1 (column1, column2) using (column1) select 1, 2",
 		Output: []Statement{
 			ReplaceQuery{
-				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"}},
 				Fields: []QueryExpression{
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "column1"}},
 					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
@@ -4538,7 +4850,8 @@ var parseTests = []struct {
 		Input: "create table newtable (column1, column2)",
 		Output: []Statement{
 			CreateTable{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
 				Fields: []QueryExpression{
 					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
 					Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column2"},
@@ -4546,11 +4859,62 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (id primary key, name constraint name_required not null unique, age check (age >= 0), unique (name, age))",
+		Output: []Statement{
+			CreateTable{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "id"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "name"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "age"},
+				},
+				Constraints: []TableConstraint{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 27},
+						Type:     Token{Token: PRIMARY, Literal: "primary", Line: 1, Char: 27},
+						Columns:  []QueryExpression{Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "id"}},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 70},
+						Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "name_required"},
+						Type:     Token{Token: NOT, Literal: "not", Line: 1, Char: 70},
+						Columns:  []QueryExpression{Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "name"}},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 79},
+						Type:     Token{Token: UNIQUE, Literal: "unique", Line: 1, Char: 79},
+						Columns:  []QueryExpression{Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "name"}},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 91},
+						Type:     Token{Token: CHECK, Literal: "check", Line: 1, Char: 91},
+						Columns:  []QueryExpression{Identifier{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "age"}},
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 98}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 98}, Literal: "age"}},
+							Operator: Token{Token: COMPARISON_OP, Literal: ">=", Line: 1, Char: 102},
+							RHS:      NewIntegerValueFromString("0"),
+						},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 109},
+						Type:     Token{Token: UNIQUE, Literal: "unique", Line: 1, Char: 109},
+						Columns: []QueryExpression{
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 117}, Literal: "name"},
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 123}, Literal: "age"},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2) select 1, 2",
 		Output: []Statement{
 			CreateTable{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
 				Fields: []QueryExpression{
 					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
 					Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column2"},
@@ -4577,7 +4941,8 @@ var parseTests = []struct {
 		Input: "create table newtable select 1, 2",
 		Output: []Statement{
 			CreateTable{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
 				Query: SelectQuery{
 					SelectEntity: SelectEntity{
 						SelectClause: SelectClause{
@@ -4600,7 +4965,8 @@ var parseTests = []struct {
 		Input: "create table newtable (column1, column2) as select 1, 2",
 		Output: []Statement{
 			CreateTable{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
 				Fields: []QueryExpression{
 					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
 					Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column2"},
@@ -4627,7 +4993,8 @@ var parseTests = []struct {
 		Input: "create table newtable as select 1, 2",
 		Output: []Statement{
 			CreateTable{
-				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
 				Query: SelectQuery{
 					SelectEntity: SelectEntity{
 						SelectClause: SelectClause{
@@ -4769,6 +5136,68 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 add constraint table1_pkey primary key (column1, column2)",
+		Output: []Statement{
+			AddConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Constraint: TableConstraint{
+					BaseExpr: &BaseExpr{line: 1, char: 47},
+					Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "table1_pkey"},
+					Type:     Token{Token: PRIMARY, Literal: "primary", Line: 1, Char: 47},
+					Columns: []QueryExpression{
+						Identifier{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "column1"},
+						Identifier{BaseExpr: &BaseExpr{line: 1, char: 69}, Literal: "column2"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add not null (column1)",
+		Output: []Statement{
+			AddConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Constraint: TableConstraint{
+					BaseExpr: &BaseExpr{line: 1, char: 24},
+					Type:     Token{Token: NOT, Literal: "not", Line: 1, Char: 24},
+					Columns: []QueryExpression{
+						Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column1"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add check (column1 > 0)",
+		Output: []Statement{
+			AddConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Constraint: TableConstraint{
+					BaseExpr: &BaseExpr{line: 1, char: 24},
+					Type:     Token{Token: CHECK, Literal: "check", Line: 1, Char: 24},
+					Condition: Comparison{
+						LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column1"}},
+						Operator: Token{Token: COMPARISON_OP, Literal: ">", Line: 1, Char: 39},
+						RHS:      NewIntegerValueFromString("0"),
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 drop constraint table1_pkey",
+		Output: []Statement{
+			DropConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "table1_pkey"},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 rename column1 to column2",
 		Output: []Statement{
@@ -6060,6 +6489,26 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare extfunc function external 'plugin --mode=scalar'",
+		Output: []Statement{
+			ExternalFunctionDeclaration{
+				Name:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "extfunc"},
+				Type:    Token{Token: FUNCTION, Literal: "function", Line: 1, Char: 17},
+				Command: NewStringValue("plugin --mode=scalar"),
+			},
+		},
+	},
+	{
+		Input: "declare extagg aggregate external @cmd",
+		Output: []Statement{
+			ExternalFunctionDeclaration{
+				Name:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "extagg"},
+				Type:    Token{Token: AGGREGATE, Literal: "aggregate", Line: 1, Char: 16},
+				Command: Variable{BaseExpr: &BaseExpr{line: 1, char: 35}, Name: "cmd"},
+			},
+		},
+	},
 	{
 		Input: "dispose function userfunc",
 		Output: []Statement{
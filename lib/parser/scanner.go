@@ -62,11 +62,21 @@ var aggregateFunctions = []string{
 	"STDEVP",
 	"VARP",
 	"MEDIAN",
+	"MODE",
+	"CORR",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"REGR_SLOPE",
+	"REGR_INTERCEPT",
+	"REGR_R2",
+	"REGR_COUNT",
 }
 
 var listFunctions = []string{
 	"LISTAGG",
 	"JSON_AGG",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
 }
 
 var analyticFunctions = []string{
@@ -93,14 +103,14 @@ func TokenLiteral(token int) string {
 	if TokenFrom <= token && token <= TokenTo {
 		return yyToknames[token-TokenFrom+3]
 	}
-	return string(token)
+	return string(rune(token))
 }
 
 func KeywordLiteral(token int) (string, error) {
 	if KeywordFrom <= token && token <= KeywordTo {
 		return yyToknames[token-TokenFrom+3], nil
 	}
-	return string(token), errTokenIsNotKeyword
+	return string(rune(token)), errTokenIsNotKeyword
 }
 
 type Scanner struct {
@@ -144,6 +154,11 @@ func (s *Scanner) HolderNumber() int {
 	return s.holderNumber
 }
 
+// Offset returns the position, counted in runes from the beginning of the source, at which the next scan starts.
+func (s *Scanner) Offset() int {
+	return s.srcPos
+}
+
 func (s *Scanner) holderNameExists(name string) bool {
 	for _, v := range s.holderNames {
 		if name == v {
@@ -232,6 +247,9 @@ func (s *Scanner) Scan() (Token, error) {
 		literal = s.literal.String()
 		if _, e := ternary.ConvertFromString(literal); e == nil {
 			token = TERNARY
+		} else if s.scanAtTimeZone(literal) {
+			token = AT_TIME_ZONE
+			literal = s.literal.String()
 		} else if t, e := s.searchKeyword(literal); e == nil {
 			token = rune(t)
 		} else if s.isAggregateFunctions(literal) {
@@ -361,6 +379,45 @@ func (s *Scanner) scanIdentifier(head rune) {
 	}
 }
 
+// scanAtTimeZone reads the words "TIME ZONE" following "AT".
+// The words are not keywords, so they can be used as identifiers in the other contexts.
+func (s *Scanner) scanAtTimeZone(literal string) bool {
+	if !strings.EqualFold(literal, "AT") {
+		return false
+	}
+
+	pos := s.srcPos
+	for _, word := range []string{"TIME", "ZONE"} {
+		if pos < len(s.src) && !unicode.IsSpace(s.src[pos]) {
+			return false
+		}
+		for pos < len(s.src) && unicode.IsSpace(s.src[pos]) {
+			pos++
+		}
+		end := pos
+		for end < len(s.src) && s.isIdentRune(s.src[end]) {
+			end++
+		}
+		if !strings.EqualFold(string(s.src[pos:end]), word) {
+			return false
+		}
+		pos = end
+	}
+
+	s.literal.Reset()
+	s.literal.WriteString("AT")
+	for _, word := range []string{"TIME", "ZONE"} {
+		for unicode.IsSpace(s.peek()) {
+			s.next()
+		}
+		for s.isIdentRune(s.peek()) {
+			s.next()
+		}
+		s.literal.WriteString(" " + word)
+	}
+	return true
+}
+
 func (s *Scanner) isIdentRune(ch rune) bool {
 	return ch == '_' || unicode.IsLetter(ch) || unicode.IsDigit(ch)
 }
@@ -550,6 +550,42 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "At Time Zone",
+		Input: "dt at  time\nzone 'UTC'",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "dt",
+			},
+			{
+				Token:   AT_TIME_ZONE,
+				Literal: "AT TIME ZONE",
+			},
+			{
+				Token:   STRING,
+				Literal: "UTC",
+			},
+		},
+	},
+	{
+		Name:  "At Time Zone Words as Identifiers",
+		Input: "at time timezone",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "at",
+			},
+			{
+				Token:   IDENTIFIER,
+				Literal: "time",
+			},
+			{
+				Token:   IDENTIFIER,
+				Literal: "timezone",
+			},
+		},
+	},
 	{
 		Name:        "Placeholder Disabled",
 		Input:       "?",
@@ -4,6 +4,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 
@@ -14,19 +15,50 @@ import (
 	"github.com/mithrandie/ternary"
 )
 
-type AggregateFunction func([]value.Primary, *cmd.Flags) value.Primary
+// AggregateFunction calculates a value from the lists of the values of the arguments.
+// Each list holds the values of one argument, and the nth elements of the lists are values of the same record.
+type AggregateFunction struct {
+	ArgsLen int
+	Fn      func([][]value.Primary, *cmd.Flags) value.Primary
+}
+
+func univariateAggregateFunction(fn func([]value.Primary, *cmd.Flags) value.Primary) AggregateFunction {
+	return AggregateFunction{
+		ArgsLen: 1,
+		Fn: func(lists [][]value.Primary, flags *cmd.Flags) value.Primary {
+			return fn(lists[0], flags)
+		},
+	}
+}
+
+func bivariateAggregateFunction(fn func([]value.Primary, []value.Primary, *cmd.Flags) value.Primary) AggregateFunction {
+	return AggregateFunction{
+		ArgsLen: 2,
+		Fn: func(lists [][]value.Primary, flags *cmd.Flags) value.Primary {
+			return fn(lists[0], lists[1], flags)
+		},
+	}
+}
 
 var AggregateFunctions = map[string]AggregateFunction{
-	"COUNT":  Count,
-	"MAX":    Max,
-	"MIN":    Min,
-	"SUM":    Sum,
-	"AVG":    Avg,
-	"STDEV":  StdEV,
-	"STDEVP": StdEVP,
-	"VAR":    Var,
-	"VARP":   VarP,
-	"MEDIAN": Median,
+	"COUNT":          univariateAggregateFunction(Count),
+	"MAX":            univariateAggregateFunction(Max),
+	"MIN":            univariateAggregateFunction(Min),
+	"SUM":            univariateAggregateFunction(Sum),
+	"AVG":            univariateAggregateFunction(Avg),
+	"STDEV":          univariateAggregateFunction(StdEV),
+	"STDEVP":         univariateAggregateFunction(StdEVP),
+	"VAR":            univariateAggregateFunction(Var),
+	"VARP":           univariateAggregateFunction(VarP),
+	"MEDIAN":         univariateAggregateFunction(Median),
+	"MODE":           univariateAggregateFunction(Mode),
+	"CORR":           bivariateAggregateFunction(Corr),
+	"COVAR_POP":      bivariateAggregateFunction(CovarPop),
+	"COVAR_SAMP":     bivariateAggregateFunction(CovarSamp),
+	"REGR_SLOPE":     bivariateAggregateFunction(RegrSlope),
+	"REGR_INTERCEPT": bivariateAggregateFunction(RegrIntercept),
+	"REGR_R2":        bivariateAggregateFunction(RegrR2),
+	"REGR_COUNT":     bivariateAggregateFunction(RegrCount),
 }
 
 func Count(list []value.Primary, _ *cmd.Flags) value.Primary {
@@ -184,21 +216,134 @@ func standardDeviation(list []float64, isP bool) float64 {
 	return math.Sqrt(variance(list, isP))
 }
 
-func Median(list []value.Primary, flags *cmd.Flags) value.Primary {
-	var values []float64
-
-	for _, v := range list {
-		if f := value.ToFloat(v); !value.IsNull(f) {
-			values = append(values, f.(*value.Float).Raw())
+func floatPairs(list1 []value.Primary, list2 []value.Primary) ([]float64, []float64) {
+	values1 := make([]float64, 0, len(list1))
+	values2 := make([]float64, 0, len(list2))
+	for i := 0; i < len(list1) && i < len(list2); i++ {
+		f1 := value.ToFloat(list1[i])
+		if value.IsNull(f1) {
 			continue
 		}
-		if d := value.ToDatetime(v, flags.DatetimeFormat); !value.IsNull(d) {
-			values = append(values, float64(d.(*value.Datetime).Raw().UnixNano())/1e9)
+		f2 := value.ToFloat(list2[i])
+		if value.IsNull(f2) {
 			continue
 		}
+		values1 = append(values1, f1.(*value.Float).Raw())
+		values2 = append(values2, f2.(*value.Float).Raw())
+	}
+	return values1, values2
+}
+
+func covariance(list1 []float64, list2 []float64, isP bool) float64 {
+	avg1 := average(list1)
+	avg2 := average(list2)
+	denom := float64(len(list1))
+	if !isP {
+		denom = denom - 1
+	}
+
+	var sum float64
+	for i := range list1 {
+		sum += (list1[i] - avg1) * (list2[i] - avg2)
+	}
+
+	if denom == 0 || sum == 0 {
+		return 0
+	}
+
+	return sum / denom
+}
+
+func Corr(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	values1, values2 := floatPairs(list1, list2)
+	if len(values1) < 1 {
+		return value.NewNull()
+	}
+
+	denom := standardDeviation(values1, true) * standardDeviation(values2, true)
+	if denom == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(values1, values2, true) / denom)
+}
+
+func CovarPop(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	values1, values2 := floatPairs(list1, list2)
+	if len(values1) < 1 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(values1, values2, true))
+}
+
+func CovarSamp(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	values1, values2 := floatPairs(list1, list2)
+	if len(values1) < 2 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(values1, values2, false))
+}
+
+// RegrSlope returns the slope of the least-squares regression line of the dependent values in list1
+// on the independent values in list2.
+func RegrSlope(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(list1, list2)
+	if len(xs) < 1 {
+		return value.NewNull()
+	}
+
+	varX := variance(xs, true)
+	if varX == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(ys, xs, true) / varX)
+}
+
+// RegrIntercept returns the y-intercept of the least-squares regression line of the dependent values in list1
+// on the independent values in list2.
+func RegrIntercept(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(list1, list2)
+	if len(xs) < 1 {
+		return value.NewNull()
+	}
+
+	varX := variance(xs, true)
+	if varX == 0 {
+		return value.NewNull()
+	}
+	slope := covariance(ys, xs, true) / varX
+	return value.ParseFloat64(average(ys) - slope*average(xs))
+}
+
+// RegrR2 returns the coefficient of determination of the least-squares regression line of the dependent values in list1
+// on the independent values in list2.
+func RegrR2(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(list1, list2)
+	if len(xs) < 1 {
+		return value.NewNull()
+	}
+
+	varX := variance(xs, true)
+	if varX == 0 {
+		return value.NewNull()
 	}
+	varY := variance(ys, true)
+	if varY == 0 {
+		return value.NewInteger(1)
+	}
+
+	cov := covariance(ys, xs, true)
+	return value.ParseFloat64((cov * cov) / (varX * varY))
+}
+
+// RegrCount returns the number of pairs in which both values are numbers.
+func RegrCount(list1 []value.Primary, list2 []value.Primary, _ *cmd.Flags) value.Primary {
+	values1, _ := floatPairs(list1, list2)
+	return value.NewInteger(int64(len(values1)))
+}
 
-	if values == nil || len(values) < 1 {
+func Median(list []value.Primary, flags *cmd.Flags) value.Primary {
+	values, _ := numberOrDatetimeList(list, flags)
+	if len(values) < 1 {
 		return value.NewNull()
 	}
 
@@ -215,6 +360,119 @@ func Median(list []value.Primary, flags *cmd.Flags) value.Primary {
 	return value.ParseFloat64(median)
 }
 
+// numberOrDatetimeList converts the values in the list to floats, and datetimes to unix times.
+// The second return value reports whether all the converted values are datetimes.
+func numberOrDatetimeList(list []value.Primary, flags *cmd.Flags) ([]float64, bool) {
+	var values []float64
+	allDatetime := true
+
+	for _, v := range list {
+		if f := value.ToFloat(v); !value.IsNull(f) {
+			values = append(values, f.(*value.Float).Raw())
+			allDatetime = false
+			continue
+		}
+		if d := value.ToDatetime(v, flags.DatetimeFormat); !value.IsNull(d) {
+			values = append(values, float64(d.(*value.Datetime).Raw().UnixNano())/1e9)
+			continue
+		}
+	}
+	return values, allDatetime && 0 < len(values)
+}
+
+// Mode returns the most frequent value in the list.
+// If several values are the most frequent, the one that appears first is returned.
+func Mode(list []value.Primary, flags *cmd.Flags) value.Primary {
+	counts := make(map[string]int, 40)
+	keys := make([]string, len(list))
+	maxCount := 0
+
+	buf := GetComparisonKeysBuf()
+	for i, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{v}, flags)
+		keys[i] = buf.String()
+		counts[keys[i]]++
+		if maxCount < counts[keys[i]] {
+			maxCount = counts[keys[i]]
+		}
+	}
+	PutComparisonkeysBuf(buf)
+
+	for i, v := range list {
+		if !value.IsNull(v) && counts[keys[i]] == maxCount {
+			return v
+		}
+	}
+	return value.NewNull()
+}
+
+// PercentileCont returns the value at the fraction of the sorted list interpolating between adjacent values.
+// The list must be sorted in the order in which the fraction is counted.
+// If all the values are datetimes, then the result is a datetime.
+func PercentileCont(list []value.Primary, fraction float64, flags *cmd.Flags) value.Primary {
+	values, isDatetime := numberOrDatetimeList(list, flags)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	lower := math.Floor(pos)
+	upper := math.Ceil(pos)
+
+	result := values[int(lower)]
+	if lower != upper {
+		result = result + (pos-lower)*(values[int(upper)]-result)
+	}
+	if isDatetime {
+		return percentileContDatetime(list, pos, flags)
+	}
+	return value.ParseFloat64(result)
+}
+
+// percentileContDatetime interpolates between the datetimes adjacent to the position in nanoseconds
+// so that the precision of the datetimes is not lost.
+func percentileContDatetime(list []value.Primary, pos float64, flags *cmd.Flags) value.Primary {
+	times := make([]time.Time, 0, len(list))
+	for _, v := range list {
+		if d := value.ToDatetime(v, flags.DatetimeFormat); !value.IsNull(d) {
+			times = append(times, d.(*value.Datetime).Raw())
+		}
+	}
+
+	lower := math.Floor(pos)
+	result := times[int(lower)]
+	if upper := math.Ceil(pos); lower != upper {
+		diff := times[int(upper)].Sub(result)
+		result = result.Add(time.Duration(math.Round((pos - lower) * float64(diff))))
+	}
+	return value.NewDatetime(result)
+}
+
+// PercentileDisc returns the first value in the sorted list whose cumulative distribution is greater than or equal to the fraction.
+// The list must be sorted in the order in which the fraction is counted.
+func PercentileDisc(list []value.Primary, fraction float64) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
 func ListAgg(list []value.Primary, separator string) value.Primary {
 	strlist := make([]string, 0)
 	for _, v := range list {
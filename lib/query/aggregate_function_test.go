@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -352,6 +353,163 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewString("a"),
+			value.NewString("b"),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("b"),
+			value.NewString("a"),
+			value.NewString("c"),
+		},
+		Result: value.NewString("a"),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewFloat(2),
+			value.NewInteger(2),
+		},
+		Result: value.NewFloat(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var percentileContTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Result   value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(10),
+		},
+		Fraction: 0.5,
+		Result:   value.NewInteger(3),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(20),
+		},
+		Fraction: 0.25,
+		Result:   value.NewFloat(12.5),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(20),
+		},
+		Fraction: 1,
+		Result:   value.NewInteger(20),
+	},
+	{
+		List: []value.Primary{
+			value.NewDatetime(time.Date(2026, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+			value.NewNull(),
+			value.NewDatetime(time.Date(2026, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+		},
+		Fraction: 0.25,
+		Result:   value.NewDatetime(time.Date(2026, 1, 1, 6, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		List: []value.Primary{
+			value.NewDatetime(time.Date(2026, 1, 1, 0, 0, 0, 1, GetTestLocation())),
+			value.NewDatetime(time.Date(2026, 1, 1, 0, 0, 0, 3, GetTestLocation())),
+		},
+		Fraction: 0.5,
+		Result:   value.NewDatetime(time.Date(2026, 1, 1, 0, 0, 0, 2, GetTestLocation())),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Result:   value.NewNull(),
+	},
+}
+
+func TestPercentileCont(t *testing.T) {
+	for _, v := range percentileContTests {
+		r := PercentileCont(v.List, v.Fraction, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("percentile_cont list = %s: fraction = %f, result = %s, want %s", v.List, v.Fraction, r, v.Result)
+		}
+	}
+}
+
+var percentileDiscTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Result   value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewString("a"),
+			value.NewNull(),
+			value.NewString("b"),
+			value.NewString("c"),
+			value.NewString("d"),
+		},
+		Fraction: 0.5,
+		Result:   value.NewString("b"),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Fraction: 0.9,
+		Result:   value.NewInteger(3),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Fraction: 0,
+		Result:   value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Result:   value.NewNull(),
+	},
+}
+
+func TestPercentileDisc(t *testing.T) {
+	for _, v := range percentileDiscTests {
+		r := PercentileDisc(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("percentile_disc list = %s: fraction = %f, result = %s, want %s", v.List, v.Fraction, r, v.Result)
+		}
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -413,3 +571,187 @@ func TestJsonAgg(t *testing.T) {
 		}
 	}
 }
+
+var bivariateAggregateTestLists = [][]value.Primary{
+	{
+		value.NewInteger(2),
+		value.NewInteger(4),
+		value.NewInteger(5),
+		value.NewNull(),
+		value.NewInteger(4),
+		value.NewString("a"),
+		value.NewInteger(5),
+	},
+	{
+		value.NewInteger(1),
+		value.NewInteger(2),
+		value.NewInteger(3),
+		value.NewInteger(6),
+		value.NewInteger(4),
+		value.NewInteger(7),
+		value.NewFloat(5),
+	},
+}
+
+var bivariateAggregateTests = []struct {
+	Name   string
+	Func   func([]value.Primary, []value.Primary, *cmd.Flags) value.Primary
+	List1  []value.Primary
+	List2  []value.Primary
+	Result value.Primary
+}{
+	{
+		Name:   "Corr",
+		Func:   Corr,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewFloat(0.7745966692414833),
+	},
+	{
+		Name:   "Corr Constant Values",
+		Func:   Corr,
+		List1:  []value.Primary{value.NewInteger(1), value.NewInteger(1)},
+		List2:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "Corr Empty",
+		Func:   Corr,
+		List1:  []value.Primary{},
+		List2:  []value.Primary{},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "CovarPop",
+		Func:   CovarPop,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewFloat(1.2),
+	},
+	{
+		Name:   "CovarPop Empty",
+		Func:   CovarPop,
+		List1:  []value.Primary{value.NewNull()},
+		List2:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "CovarSamp",
+		Func:   CovarSamp,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewFloat(1.5),
+	},
+	{
+		Name:   "CovarSamp Single Pair",
+		Func:   CovarSamp,
+		List1:  []value.Primary{value.NewInteger(1)},
+		List2:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "RegrSlope",
+		Func:   RegrSlope,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewFloat(0.6),
+	},
+	{
+		Name:   "RegrSlope Constant Independent Values",
+		Func:   RegrSlope,
+		List1:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		List2:  []value.Primary{value.NewInteger(1), value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "RegrIntercept",
+		Func:   RegrIntercept,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewFloat(2.2),
+	},
+	{
+		Name:   "RegrIntercept Empty",
+		Func:   RegrIntercept,
+		List1:  []value.Primary{},
+		List2:  []value.Primary{},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "RegrR2",
+		Func:   RegrR2,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewFloat(0.6),
+	},
+	{
+		Name:   "RegrR2 Constant Dependent Values",
+		Func:   RegrR2,
+		List1:  []value.Primary{value.NewInteger(1), value.NewInteger(1)},
+		List2:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name:   "RegrR2 Constant Independent Values",
+		Func:   RegrR2,
+		List1:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		List2:  []value.Primary{value.NewInteger(1), value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "RegrCount",
+		Func:   RegrCount,
+		List1:  bivariateAggregateTestLists[0],
+		List2:  bivariateAggregateTestLists[1],
+		Result: value.NewInteger(5),
+	},
+	{
+		Name:   "RegrCount Empty",
+		Func:   RegrCount,
+		List1:  []value.Primary{},
+		List2:  []value.Primary{},
+		Result: value.NewInteger(0),
+	},
+}
+
+func TestBivariateAggregateFunctions(t *testing.T) {
+	for _, v := range bivariateAggregateTests {
+		r := v.Func(v.List1, v.List2, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, r, v.Result)
+		}
+	}
+}
+
+var aggregateFunctionsTests = []struct {
+	Name    string
+	ArgsLen int
+	Lists   [][]value.Primary
+	Result  value.Primary
+}{
+	{
+		Name:    "COUNT",
+		ArgsLen: 1,
+		Lists:   bivariateAggregateTestLists[:1],
+		Result:  value.NewInteger(6),
+	},
+	{
+		Name:    "CORR",
+		ArgsLen: 2,
+		Lists:   bivariateAggregateTestLists,
+		Result:  value.NewFloat(0.7745966692414833),
+	},
+}
+
+func TestAggregateFunctions(t *testing.T) {
+	for _, v := range aggregateFunctionsTests {
+		fn := AggregateFunctions[v.Name]
+		if fn.ArgsLen != v.ArgsLen {
+			t.Errorf("%s: ArgsLen = %d, want %d", v.Name, fn.ArgsLen, v.ArgsLen)
+		}
+		r := fn.Fn(v.Lists, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, r, v.Result)
+		}
+	}
+}
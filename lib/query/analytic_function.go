@@ -11,19 +11,21 @@ import (
 )
 
 var AnalyticFunctions = map[string]AnalyticFunction{
-	"ROW_NUMBER":   RowNumber{},
-	"RANK":         Rank{},
-	"DENSE_RANK":   DenseRank{},
-	"CUME_DIST":    CumeDist{},
-	"PERCENT_RANK": PercentRank{},
-	"NTILE":        NTile{},
-	"FIRST_VALUE":  FirstValue{},
-	"LAST_VALUE":   LastValue{},
-	"NTH_VALUE":    NthValue{},
-	"LAG":          Lag{},
-	"LEAD":         Lead{},
-	"LISTAGG":      AnalyticListAgg{},
-	"JSON_AGG":     AnalyticJsonAgg{},
+	"ROW_NUMBER":      RowNumber{},
+	"RANK":            Rank{},
+	"DENSE_RANK":      DenseRank{},
+	"CUME_DIST":       CumeDist{},
+	"PERCENT_RANK":    PercentRank{},
+	"NTILE":           NTile{},
+	"FIRST_VALUE":     FirstValue{},
+	"LAST_VALUE":      LastValue{},
+	"NTH_VALUE":       NthValue{},
+	"LAG":             Lag{},
+	"LEAD":            Lead{},
+	"LISTAGG":         AnalyticListAgg{},
+	"JSON_AGG":        AnalyticJsonAgg{},
+	"PERCENTILE_CONT": AnalyticPercentileCont{},
+	"PERCENTILE_DISC": AnalyticPercentileDisc{},
 }
 
 type AnalyticFunction interface {
@@ -48,6 +50,8 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 	uname := strings.ToUpper(fn.Name)
 	if f, ok := AnalyticFunctions[uname]; ok {
 		anfn = f
+	} else if uname == "MODE" && fn.OrderBy != nil {
+		anfn = AnalyticModeWithinGroup{}
 	} else if f, ok := AggregateFunctions[uname]; ok {
 		aggfn = f
 	} else {
@@ -60,9 +64,11 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 		if err := anfn.CheckArgsLen(fn); err != nil {
 			return err
 		}
-	} else if aggfn != nil {
-		if len(fn.Args) != 1 {
-			return NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	} else if fn.OrderBy != nil {
+		return NewFunctionInvalidArgumentError(fn, fn.Name, "the WITHIN GROUP clause cannot be used")
+	} else if aggfn.Fn != nil {
+		if err := checkArgsForAggregateFunction(fn, fn.Name, fn.Args, fn.IsDistinct(), aggfn); err != nil {
+			return err
 		}
 
 		if _, ok := fn.Args[0].(parser.AllColumns); ok {
@@ -74,6 +80,11 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 		}
 	}
 
+	listsLen := 1
+	if aggfn.Fn != nil {
+		listsLen = aggfn.ArgsLen
+	}
+
 	if view.sortValuesInEachCell == nil {
 		view.sortValuesInEachCell = make([][]*SortValue, view.RecordLen())
 	}
@@ -151,20 +162,27 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 			} else {
 				partition := partitions[partitionMapKeys[i]]
 				frameSet := WindowFrameSet(partition, fn.AnalyticClause)
-				valueCache := make(map[int]value.Primary, len(partition))
+				valueCaches := make([]map[int]value.Primary, listsLen)
+				for j := range valueCaches {
+					valueCaches[j] = make(map[int]value.Primary, len(partition))
+				}
+				lists := make([][]value.Primary, listsLen)
 
 				udfnArgsExprs := fn.Args[1:]
 				udfnArgs := make([]value.Primary, len(udfnArgsExprs))
 
 				for _, frame := range frameSet {
-					values, e := windowValues(ctx, seqScope, frame, partition, fn, valueCache)
-					if e != nil {
-						gm.SetError(e)
-						break AnalyzeLoop
+					for j := range lists {
+						values, e := windowValues(ctx, seqScope, frame, partition, fn.Args[j], fn.IsDistinct(), valueCaches[j])
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
+						lists[j] = values
 					}
 
-					if aggfn != nil {
-						val := aggfn(values, scope.Tx.Flags)
+					if aggfn.Fn != nil {
+						val := aggfn.Fn(lists, scope.Tx.Flags)
 
 						for _, idx := range frame.Records {
 							view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
@@ -182,7 +200,7 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 								udfnArgs[i] = arg
 							}
 
-							val, e := udfn.ExecuteAggregate(ctx, seqScope, values, udfnArgs)
+							val, e := udfn.ExecuteAggregate(ctx, seqScope, lists[0], udfnArgs)
 							if e != nil {
 								gm.SetError(e)
 								break AnalyzeLoop
@@ -304,7 +322,7 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 	return frameSet
 }
 
-func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame, partition Partition, expr parser.AnalyticFunction, valueCache map[int]value.Primary) ([]value.Primary, error) {
+func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame, partition Partition, expr parser.QueryExpression, isDistinct bool, valueCache map[int]value.Primary) ([]value.Primary, error) {
 	values := make([]value.Primary, 0, frame.High-frame.Low+1)
 
 	anScope := scope.CreateScopeForAnalytics()
@@ -318,7 +336,7 @@ func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame,
 			values = append(values, v)
 		} else {
 			anScope.Records[0].recordIndex = recordIdx
-			p, e := Evaluate(ctx, anScope, expr.Args[0])
+			p, e := Evaluate(ctx, anScope, expr)
 			if e != nil {
 				return nil, e
 			}
@@ -327,7 +345,7 @@ func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame,
 		}
 	}
 
-	if expr.IsDistinct() {
+	if isDistinct {
 		values = Distinguish(values, scope.Tx.Flags)
 	}
 	return values, nil
@@ -778,3 +796,91 @@ func (fn AnalyticJsonAgg) Execute(ctx context.Context, scope *ReferenceScope, pa
 
 	return list, nil
 }
+
+type AnalyticPercentileCont struct{}
+
+func (fn AnalyticPercentileCont) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileCont) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	return setPercentile(ctx, scope, partition, expr, func(values []value.Primary, fraction float64) value.Primary {
+		return PercentileCont(values, fraction, scope.Tx.Flags)
+	})
+}
+
+type AnalyticModeWithinGroup struct{}
+
+func (fn AnalyticModeWithinGroup) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{0})
+}
+
+func (fn AnalyticModeWithinGroup) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	sortKey, err := checkArgsForMode(expr, expr.Name, expr.Args, expr.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		val, e := Evaluate(ctx, anScope, sortKey)
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+	}
+	if expr.IsDistinct() {
+		values = Distinguish(values, scope.Tx.Flags)
+	}
+
+	val := Mode(values, scope.Tx.Flags)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticPercentileDisc struct{}
+
+func (fn AnalyticPercentileDisc) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileDisc) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	return setPercentile(ctx, scope, partition, expr, PercentileDisc)
+}
+
+func setPercentile(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction, percentileFn func([]value.Primary, float64) value.Primary) (map[int]value.Primary, error) {
+	fraction, sortKey, err := checkArgsForPercentile(ctx, scope, expr, expr.Name, expr.Args, expr.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		val, e := Evaluate(ctx, anScope, sortKey)
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+	}
+	if expr.IsDistinct() {
+		values = Distinguish(values, scope.Tx.Flags)
+	}
+
+	val := percentileFn(values, fraction)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
@@ -491,6 +491,134 @@ var analyzeTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Analyze Mode Within Group",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze AggregateFunction Within Group Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "the WITHIN GROUP clause cannot be used for function sum",
+	},
 	{
 		Name: "Analyze AggregateFunction Argument Length Error",
 		View: &View{
@@ -548,6 +676,128 @@ var analyzeTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "Analyze AggregateFunction with Two Arguments",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(2),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "regr_slope",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(4),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(3),
+					value.NewInteger(1),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil, nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil, nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil, nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil, nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil, nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze AggregateFunction with Two Arguments Argument Length Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(4),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "corr",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "function corr takes exactly 2 arguments",
+	},
 	{
 		Name: "Analyze UserDefinedFunction",
 		View: &View{
@@ -2266,3 +2516,174 @@ var analyticJsonAggExecuteTests = []analyticFunctionExecuteTests{
 func TestAnalyticJsonAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticJsonAgg{}, analyticJsonAggExecuteTests)
 }
+
+var analyticPercentileContExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileCont Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(175),
+			1: value.NewInteger(175),
+			2: value.NewInteger(175),
+			3: value.NewInteger(175),
+			4: value.NewInteger(175),
+		},
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Without Order By Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+		},
+		Error: "the WITHIN GROUP clause must have exactly one sort key for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Fraction Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(1.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Sort Key Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticPercentileCont_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileCont{}, analyticPercentileContExecuteTests)
+}
+
+var analyticPercentileDiscExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileDisc Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(200),
+			1: value.NewInteger(200),
+			2: value.NewInteger(200),
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "AnalyticPercentileDisc Execute With Distinct",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name:     "percentile_disc",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.9),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(300),
+			1: value.NewInteger(300),
+			2: value.NewInteger(300),
+			3: value.NewInteger(300),
+			4: value.NewInteger(300),
+		},
+	},
+}
+
+func TestAnalyticPercentileDisc_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileDisc{}, analyticPercentileDiscExecuteTests)
+}
+
+var analyticModeWithinGroupExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticModeWithinGroup Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(200),
+			1: value.NewInteger(200),
+			2: value.NewInteger(200),
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "AnalyticModeWithinGroup Execute Without Order By Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+		},
+		Error: "the WITHIN GROUP clause must have exactly one sort key for function mode",
+	},
+	{
+		Name:  "AnalyticModeWithinGroup Execute Sort Key Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticModeWithinGroup_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticModeWithinGroup{}, analyticModeWithinGroupExecuteTests)
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -217,13 +218,15 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag:
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.XmlRootElementFlag, cmd.XmlRowElementFlag,
+		cmd.SqlTableFlag, cmd.SqlDialectFlag, cmd.BorderStyleFlag, cmd.ExpandedFlag, cmd.RejectFileFlag,
+		cmd.CommentFlag, cmd.QuoteFlag, cmd.QuoteEscapeFlag, cmd.LocaleFlag, cmd.ExportQuoteFlag, cmd.ExportQuoteEscapeFlag:
 		p = value.ToString(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.String).Raw()
-	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
+	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LenientFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
 		cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag:
@@ -238,7 +241,7 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.Float).Raw()
-	case cmd.LimitRecursion, cmd.CPUFlag:
+	case cmd.LimitRecursion, cmd.CPUFlag, cmd.SkipLinesFlag:
 		p = value.ToInteger(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
@@ -268,8 +271,9 @@ func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddF
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LenientFlag, cmd.RejectFileFlag, cmd.WithoutHeaderFlag,
+		cmd.SkipLinesFlag, cmd.CommentFlag, cmd.QuoteFlag, cmd.QuoteEscapeFlag, cmd.LocaleFlag, cmd.ExportQuoteFlag, cmd.ExportQuoteEscapeFlag,
+		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.XmlRootElementFlag, cmd.XmlRowElementFlag, cmd.SqlTableFlag, cmd.SqlDialectFlag, cmd.BorderStyleFlag, cmd.ExpandedFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag,
 		cmd.WaitTimeoutFlag,
@@ -316,8 +320,9 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LenientFlag, cmd.RejectFileFlag, cmd.WithoutHeaderFlag,
+		cmd.SkipLinesFlag, cmd.CommentFlag, cmd.QuoteFlag, cmd.QuoteEscapeFlag, cmd.LocaleFlag, cmd.ExportQuoteFlag, cmd.ExportQuoteEscapeFlag,
+		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.XmlRootElementFlag, cmd.XmlRowElementFlag, cmd.SqlTableFlag, cmd.SqlDialectFlag, cmd.BorderStyleFlag, cmd.ExpandedFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag,
 		cmd.WaitTimeoutFlag,
@@ -371,6 +376,20 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
 		}
+	case cmd.RejectFileFlag:
+		p := val.(*value.String)
+		switch {
+		case !tx.Flags.ImportOptions.Lenient:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		case len(p.Raw()) < 1:
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		default:
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
 	case cmd.ExportEncodingFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case cmd.JSON:
@@ -385,6 +404,36 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).String())
 		}
+	case cmd.ExportQuoteFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).String())
+		}
+	case cmd.ExportQuoteEscapeFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.CommentFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.String())
+		}
+	case cmd.LocaleFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
+	case cmd.QuoteFlag:
+		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
 	case cmd.ExportDelimiterPositionsFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case cmd.FIXED:
@@ -394,7 +443,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.WithoutHeaderFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.SQL:
 			if tx.Flags.ExportOptions.Format == cmd.FIXED && tx.Flags.ExportOptions.SingleLine {
 				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 			} else {
@@ -425,11 +474,46 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.PrettyPrintFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.XML:
 			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
+	case cmd.XmlRootElementFlag, cmd.XmlRowElementFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.XML:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.SqlTableFlag:
+		p := val.(*value.String)
+		switch {
+		case tx.Flags.ExportOptions.Format != cmd.SQL:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(auto)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		case len(p.Raw()) < 1:
+			s = tx.Palette.Render(cmd.NullEffect, "(auto)")
+		default:
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
+	case cmd.SqlDialectFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.SQL:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.BorderStyleFlag, cmd.ExpandedFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.TEXT:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
 	case cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case cmd.GFM, cmd.ORG, cmd.TEXT:
@@ -438,8 +522,12 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
 	case cmd.DelimiterFlag:
-		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
-	case cmd.TimezoneFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.FormatFlag:
+		if tx.Flags.ImportOptions.Delimiter == cmd.AutoDelimiter {
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
+		}
+	case cmd.TimezoneFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.QuoteEscapeFlag, cmd.FormatFlag:
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 	case cmd.LimitRecursion:
 		p := val.(*value.Integer)
@@ -448,11 +536,11 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(cmd.NumberEffect, p.String())
 		}
-	case cmd.CPUFlag:
+	case cmd.CPUFlag, cmd.SkipLinesFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Integer).String())
 	case cmd.WaitTimeoutFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Float).String())
-	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StripEndingLineBreakFlag,
+	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LenientFlag, cmd.StripEndingLineBreakFlag,
 		cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 		s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
 	}
@@ -714,7 +802,7 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 }
 
 func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
-	encWidth := cmd.TextWidth(info.Encoding.String(), flags)
+	encWidth := cmd.TextWidth(charset.String(info.Encoding), flags)
 
 	w.WriteColor("Format: ", cmd.LableEffect)
 	w.WriteWithoutLineBreak(info.Format.String())
@@ -761,6 +849,33 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.EncloseAll))
 	}
 
+	if (info.Format == cmd.CSV || info.Format == cmd.TSV) && (info.Quote != 0 || info.QuoteEscape != cmd.QuoteEscapeDouble) {
+		w.NewLine()
+		w.WriteColor("Quote: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak("'" + cmd.EscapeString(string(info.QuoteMark())) + "'")
+		w.WriteSpaces(4 - (cmd.TextWidth(cmd.EscapeString(string(info.QuoteMark())), flags)))
+		w.WriteColorWithoutLineBreak("Quote Escape: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.QuoteEscape.String())
+	}
+
+	if info.DetectedDialect != nil {
+		w.NewLine()
+		w.WriteColor("Detected Dialect: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak("Delimiter '" + cmd.EscapeString(string(info.DetectedDialect.Delimiter)) + "'")
+		if info.DetectedDialect.Quoted {
+			w.WriteWithoutLineBreak(", Quote '" + cmd.EscapeString(string(info.DetectedDialect.Quote)) + "'")
+		} else {
+			w.WriteWithoutLineBreak(", Quote (none)")
+		}
+		w.WriteWithoutLineBreak(", Header " + strconv.FormatBool(info.DetectedDialect.HasHeader))
+	}
+
+	if 0 < len(info.Locale) && info.importLocale() != nil {
+		w.NewLine()
+		w.WriteColor("Locale: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.Locale)
+	}
+
 	w.NewLine()
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
@@ -768,11 +883,11 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 	case cmd.JSON:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
-		w.WriteWithoutLineBreak(info.Encoding.String())
+		w.WriteWithoutLineBreak(charset.String(info.Encoding))
 	}
 
 	if !(info.Format == cmd.FIXED && info.SingleLine) {
-		w.WriteSpaces(encWidth + 2 - (cmd.TextWidth(info.Encoding.String(), flags)))
+		w.WriteSpaces(encWidth + 2 - (cmd.TextWidth(charset.String(info.Encoding), flags)))
 		w.WriteColorWithoutLineBreak("LineBreak: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(info.LineBreak.String())
 	}
@@ -816,6 +931,15 @@ func writeFunctions(w *ObjectWriter, funcs UserDefinedFunctionMap) {
 	for _, key := range keys {
 		if fn, ok := funcs.Load(key); ok {
 			w.WriteColor(fn.Name.String(), cmd.ObjectEffect)
+
+			if fn.IsExternal() {
+				w.WriteWithoutLineBreak(" EXTERNAL ")
+				w.WriteColorWithoutLineBreak(cmd.QuoteString(strings.Join(fn.External.Command, " ")), cmd.ValueEffect)
+				w.ClearBlock()
+				w.NewLine()
+				continue
+			}
+
 			w.WriteWithoutLineBreak(" (")
 
 			if fn.IsAggregate {
@@ -1030,6 +1154,11 @@ func Reload(ctx context.Context, tx *Transaction, expr parser.Reload) error {
 		for _, v := range tx.Environment.DatetimeFormat {
 			tx.Flags.DatetimeFormat = cmd.AppendStrIfNotExist(tx.Flags.DatetimeFormat, v)
 		}
+		if 0 < len(tx.Environment.Locale) {
+			if err := tx.Flags.SetLocale(tx.Environment.Locale); err != nil {
+				return NewLoadConfigurationError(expr, err.Error())
+			}
+		}
 
 		palette, err := color.GeneratePalette(tx.Environment.Palette)
 		if err != nil {
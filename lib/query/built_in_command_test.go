@@ -15,6 +15,7 @@ import (
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/sniffer"
 	"github.com/mithrandie/csvq/lib/syntax"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -507,6 +508,65 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set XmlRootElement",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "xml_root_element"},
+			Value: parser.NewStringValue("items"),
+		},
+	},
+	{
+		Name: "Set XmlRowElement Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "xml_row_element"},
+			Value: parser.NewStringValue("1item"),
+		},
+		Error: "xml-row-element must be a valid xml name: \"1item\"",
+	},
+	{
+		Name: "Set Lenient",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "lenient"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set RejectFile",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "reject_file"},
+			Value: parser.NewStringValue("rejected.csv"),
+		},
+	},
+	{
+		Name: "Set SqlTable",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "sql_table"},
+			Value: parser.NewStringValue("users"),
+		},
+	},
+	{
+		Name: "Set SqlDialect Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "sql_dialect"},
+			Value: parser.NewStringValue("oracle"),
+		},
+		Error: "sql dialect must be one of POSTGRES|MYSQL|SQLITE",
+	},
+	{
+		Name: "Set BorderStyle",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "border_style"},
+			Value: parser.NewStringValue("double"),
+		},
+	},
+	{
+		Name: "Set Expanded Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "expanded"},
+			Value: parser.NewStringValue("always"),
+		},
+		Error: "expanded must be one of OFF|ON|AUTO",
+	},
 	{
 		Name: "Set Strip Ending Line Break",
 		Expr: parser.SetFlag{
@@ -963,6 +1023,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@DELIMITER:\033[0m \033[32m'\\t'\033[0m",
 	},
+	{
+		Name: "Show Delimiter as auto",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "delimiter"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "delimiter"},
+				Value: parser.NewStringValue("auto"),
+			},
+		},
+		Result: "\033[34;1m@@DELIMITER:\033[0m \033[32mAUTO\033[0m",
+	},
 	{
 		Name: "Show Delimiter Positions",
 		Expr: parser.ShowFlag{
@@ -1364,6 +1437,242 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@STRIP_ENDING_LINE_BREAK:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show XmlRootElement",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "xml_root_element"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "xml_root_element"},
+				Value: parser.NewStringValue("items"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("XML"),
+			},
+		},
+		Result: "\033[34;1m@@XML_ROOT_ELEMENT:\033[0m \033[32mitems\033[0m",
+	},
+	{
+		Name: "Show XmlRowElement Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "xml_row_element"},
+		},
+		Result: "\033[34;1m@@XML_ROW_ELEMENT:\033[0m \033[90m(ignored) row\033[0m",
+	},
+	{
+		Name: "Show Lenient",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "lenient"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "lenient"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@LENIENT:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show RejectFile",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "reject_file"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "lenient"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "reject_file"},
+				Value: parser.NewStringValue("rejected.csv"),
+			},
+		},
+		Result: "\033[34;1m@@REJECT_FILE:\033[0m \033[32mrejected.csv\033[0m",
+	},
+	{
+		Name: "Show RejectFile Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "reject_file"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "reject_file"},
+				Value: parser.NewStringValue("rejected.csv"),
+			},
+		},
+		Result: "\033[34;1m@@REJECT_FILE:\033[0m \033[90m(ignored) rejected.csv\033[0m",
+	},
+	{
+		Name: "Show SkipLines",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "skip_lines"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "skip_lines"},
+				Value: parser.NewIntegerValue(2),
+			},
+		},
+		Result: "\033[34;1m@@SKIP_LINES:\033[0m \033[35m2\033[0m",
+	},
+	{
+		Name: "Show Comment",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "comment"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "comment"},
+				Value: parser.NewStringValue("#"),
+			},
+		},
+		Result: "\033[34;1m@@COMMENT:\033[0m \033[32m'#'\033[0m",
+	},
+	{
+		Name: "Show Comment Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "comment"},
+		},
+		Result: "\033[34;1m@@COMMENT:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show Quote",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "quote"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "quote"},
+				Value: parser.NewStringValue("'"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE:\033[0m \033[32m'\\''\033[0m",
+	},
+	{
+		Name: "Show QuoteEscape",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "quote_escape"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "quote_escape"},
+				Value: parser.NewStringValue("backslash"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_ESCAPE:\033[0m \033[32mBACKSLASH\033[0m",
+	},
+	{
+		Name: "Show Locale",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "locale"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "locale"},
+				Value: parser.NewStringValue("de"),
+			},
+		},
+		Result: "\033[34;1m@@LOCALE:\033[0m \033[32mde-DE\033[0m",
+	},
+	{
+		Name: "Show Locale Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "locale"},
+		},
+		Result: "\033[34;1m@@LOCALE:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show WriteQuote Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_quote"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_QUOTE:\033[0m \033[90m(ignored) '\"'\033[0m",
+	},
+	{
+		Name: "Show WriteQuoteEscape",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_quote_escape"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_quote_escape"},
+				Value: parser.NewStringValue("BACKSLASH"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_QUOTE_ESCAPE:\033[0m \033[32mBACKSLASH\033[0m",
+	},
+	{
+		Name: "Show SqlTable",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "sql_table"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("SQL"),
+			},
+		},
+		Result: "\033[34;1m@@SQL_TABLE:\033[0m \033[90m(auto)\033[0m",
+	},
+	{
+		Name: "Show SqlDialect",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "sql_dialect"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "sql_dialect"},
+				Value: parser.NewStringValue("sqlite"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("SQL"),
+			},
+		},
+		Result: "\033[34;1m@@SQL_DIALECT:\033[0m \033[32mSQLITE\033[0m",
+	},
+	{
+		Name: "Show BorderStyle",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "border_style"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "border_style"},
+				Value: parser.NewStringValue("single"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("TEXT"),
+			},
+		},
+		Result: "\033[34;1m@@BORDER_STYLE:\033[0m \033[32mSINGLE\033[0m",
+	},
+	{
+		Name: "Show Expanded Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "expanded"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@EXPANDED:\033[0m \033[90m(ignored) OFF\033[0m",
+	},
 	{
 		Name: "Show PrettyPrint Ignored",
 		Expr: parser.ShowFlag{
@@ -1723,6 +2032,61 @@ var showObjectsTests = []struct {
 			"     Encoding: UTF8\n" +
 			"\n",
 	},
+	{
+		Name: "ShowObjects Tables Detected Dialect",
+		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
+		ViewCache: GenerateViewMap([]*View{
+			{
+				Header: NewHeader("table1", []string{"col1", "col2"}),
+				FileInfo: &FileInfo{
+					Path:      "table1.csv",
+					Delimiter: ';',
+					Quote:     '\'',
+					Format:    cmd.CSV,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					DetectedDialect: &sniffer.Dialect{
+						Delimiter: ';',
+						Quote:     '\'',
+						Quoted:    true,
+						HasHeader: true,
+					},
+				},
+			},
+			{
+				Header: NewHeader("table2", []string{"col1", "col2"}),
+				FileInfo: &FileInfo{
+					Path:      "table2.tsv",
+					Delimiter: '\t',
+					Format:    cmd.TSV,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					NoHeader:  true,
+					DetectedDialect: &sniffer.Dialect{
+						Delimiter: '\t',
+						Quote:     '"',
+						Quoted:    false,
+						HasHeader: false,
+					},
+				},
+			},
+		}),
+		Expect: "\n" +
+			"                          Loaded Tables\n" +
+			"------------------------------------------------------------------\n" +
+			" table1.csv\n" +
+			"     Fields: col1, col2\n" +
+			"     Format: CSV     Delimiter: ';'   Enclose All: false\n" +
+			"     Quote: '\\''  Quote Escape: DOUBLE\n" +
+			"     Detected Dialect: Delimiter ';', Quote '\\'', Header true\n" +
+			"     Encoding: UTF8  LineBreak: LF    Header: true\n" +
+			" table2.tsv\n" +
+			"     Fields: col1, col2\n" +
+			"     Format: TSV     Delimiter: '\\t'  Enclose All: false\n" +
+			"     Detected Dialect: Delimiter '\\t', Quote (none), Header false\n" +
+			"     Encoding: UTF8  LineBreak: LF    Header: false\n" +
+			"\n",
+	},
 	{
 		Name: "ShowObjects Tables Uncommitted",
 		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
@@ -2170,16 +2534,31 @@ var showObjectsTests = []struct {
 			"                  @@ENCODING: AUTO\n" +
 			"                 @@NO_HEADER: false\n" +
 			"              @@WITHOUT_NULL: false\n" +
+			"                   @@LENIENT: false\n" +
+			"               @@REJECT_FILE: (ignored) (not set)\n" +
+			"                @@SKIP_LINES: 0\n" +
+			"                   @@COMMENT: (not set)\n" +
+			"                     @@QUOTE: '\"'\n" +
+			"              @@QUOTE_ESCAPE: DOUBLE\n" +
+			"                    @@LOCALE: (not set)\n" +
 			"   @@STRIP_ENDING_LINE_BREAK: false\n" +
 			"                    @@FORMAT: CSV\n" +
 			"            @@WRITE_ENCODING: UTF8\n" +
 			"           @@WRITE_DELIMITER: ','\n" +
 			" @@WRITE_DELIMITER_POSITIONS: (ignored) SPACES\n" +
+			"               @@WRITE_QUOTE: '\"'\n" +
+			"        @@WRITE_QUOTE_ESCAPE: DOUBLE\n" +
 			"            @@WITHOUT_HEADER: false\n" +
 			"                @@LINE_BREAK: LF\n" +
 			"               @@ENCLOSE_ALL: false\n" +
 			"               @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
 			"              @@PRETTY_PRINT: (ignored) false\n" +
+			"          @@XML_ROOT_ELEMENT: (ignored) rows\n" +
+			"           @@XML_ROW_ELEMENT: (ignored) row\n" +
+			"                 @@SQL_TABLE: (ignored) (auto)\n" +
+			"               @@SQL_DIALECT: (ignored) POSTGRES\n" +
+			"              @@BORDER_STYLE: (ignored) ASCII\n" +
+			"                  @@EXPANDED: (ignored) OFF\n" +
 			"       @@EAST_ASIAN_ENCODING: (ignored) false\n" +
 			"    @@COUNT_DIACRITICAL_SIGN: (ignored) false\n" +
 			"         @@COUNT_FORMAT_CODE: (ignored) false\n" +
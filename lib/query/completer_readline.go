@@ -10,6 +10,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 
@@ -63,6 +64,17 @@ var delimiterCandidates = []string{
 	"'\\t'",
 }
 
+var importDelimiterCandidates = []string{
+	"','",
+	"'\\t'",
+	"'AUTO'",
+}
+
+var quoteEscapeCandidates = []string{
+	cmd.QuoteEscapeBackslash.String(),
+	cmd.QuoteEscapeDouble.String(),
+}
+
 var delimiterPositionsCandidates = []string{
 	"'SPACES'",
 	"'S[]'",
@@ -84,9 +96,17 @@ var tableObjectCandidates = []string{
 	"FIXED()",
 	"JSON()",
 	"LTSV()",
+	"XML()",
+	"YAML()",
 }
 
 var exportEncodingsCandidates = []string{
+	"BIG5",
+	"EUCJP",
+	"EUCKR",
+	"GB18030",
+	"ISO2022JP",
+	"LATIN1",
 	"SJIS",
 	"UTF16",
 	"UTF16BE",
@@ -95,6 +115,15 @@ var exportEncodingsCandidates = []string{
 	"UTF16LEM",
 	"UTF8",
 	"UTF8M",
+	"WINDOWS1250",
+	"WINDOWS1251",
+	"WINDOWS1252",
+	"WINDOWS1253",
+	"WINDOWS1254",
+	"WINDOWS1255",
+	"WINDOWS1256",
+	"WINDOWS1257",
+	"WINDOWS1258",
 }
 
 type ReadlineListener struct {
@@ -193,7 +222,7 @@ func NewCompleter(scope *ReferenceScope) *Completer {
 	completer.funcs = append(completer.funcs, "NOW")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+4)
 	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
@@ -201,6 +230,8 @@ func NewCompleter(scope *ReferenceScope) *Completer {
 	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_CONT")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_DISC")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -487,7 +518,7 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 			if c.tokens[c.lastIdx].Token == '(' {
 				switch strings.ToUpper(c.tokens[0].Literal) {
 				case cmd.CSV.String():
-					cands = c.candidateList(delimiterCandidates, false)
+					cands = c.candidateList(importDelimiterCandidates, false)
 				case cmd.FIXED.String():
 					cands = c.candidateList(delimiterPositionsCandidates, false)
 				}
@@ -499,7 +530,7 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 		case 2:
 			if c.tokens[c.lastIdx].Token == ',' {
 				switch strings.ToUpper(c.tokens[0].Literal) {
-				case cmd.CSV.String(), cmd.FIXED.String():
+				case cmd.CSV.String(), cmd.FIXED.String(), cmd.XML.String(), cmd.YAML.String():
 					cands = c.candidateList(c.encodingList(), false)
 				}
 			}
@@ -656,7 +687,7 @@ func (c *Completer) functionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								(funcName != "LISTAGG" && funcName != "JSON_AGG" && funcName != "PERCENTILE_CONT" && funcName != "PERCENTILE_DISC" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
 								customList = append(customList, c.candidate("ROWS", true))
@@ -1720,6 +1751,8 @@ func (c *Completer) AlterArgs(line string, origLine string, index int) readline.
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case TableJsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case TableQuoteEscape:
+						return nil, c.candidateList(quoteEscapeCandidates, false), true
 					case TableHeader, TableEncloseAll, TablePrettyPrint:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
 					}
@@ -1888,7 +1921,9 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList([]string{"Local", "UTC"}, false), true
 					case cmd.ImportFormatFlag:
 						return nil, c.candidateList(c.importFormatList(), false), true
-					case cmd.DelimiterFlag, cmd.ExportDelimiterFlag:
+					case cmd.DelimiterFlag:
+						return nil, c.candidateList(importDelimiterCandidates, false), true
+					case cmd.ExportDelimiterFlag:
 						return nil, c.candidateList(delimiterCandidates, false), true
 					case cmd.DelimiterPositionsFlag, cmd.ExportDelimiterPositionsFlag:
 						return nil, c.candidateList(delimiterPositionsCandidates, false), true
@@ -1896,7 +1931,7 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.encodingList(), false), true
 					case cmd.ExportEncodingFlag:
 						return nil, c.candidateList(exportEncodingsCandidates, false), true
-					case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
+					case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LenientFlag,
 						cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag,
 						cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag,
 						cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
@@ -1908,6 +1943,14 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case cmd.JsonEscapeFlag:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case cmd.QuoteEscapeFlag, cmd.ExportQuoteEscapeFlag:
+						return nil, c.candidateList(quoteEscapeCandidates, false), true
+					case cmd.SqlDialectFlag:
+						return nil, c.candidateList(c.sqlDialectList(), false), true
+					case cmd.BorderStyleFlag:
+						return nil, c.candidateList(c.borderStyleList(), false), true
+					case cmd.ExpandedFlag:
+						return nil, c.candidateList(c.expandedDisplayList(), false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -2098,7 +2141,7 @@ func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index
 
 func (c *Completer) SearchAllTables(line string, origLine string, index int) readline.CandidateList {
 	tableKeys := c.scope.Tx.cachedViews.SortedKeys()
-	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt}, c.scope.Tx.Flags.Repository)
+	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.XmlExt, cmd.YamlExt, cmd.YmlExt, cmd.TextExt}, c.scope.Tx.Flags.Repository)
 
 	defaultDir := c.scope.Tx.Flags.Repository
 	if len(defaultDir) < 1 {
@@ -2694,7 +2737,7 @@ func (c *Completer) combineFunction() {
 
 func (c *Completer) isTableObject(token parser.Token) bool {
 	switch token.Token {
-	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.JSON_TABLE:
+	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.XML, parser.YAML, parser.JSON_TABLE:
 		return true
 	}
 	return false
@@ -2825,10 +2868,13 @@ func (c *Completer) importFormatList() []string {
 }
 
 func (c *Completer) encodingList() []string {
-	list := make([]string, 0, len(text.EncodingLiteral))
+	list := make([]string, 0, len(text.EncodingLiteral)+len(charset.EncodingLiteral))
 	for _, v := range text.EncodingLiteral {
 		list = append(list, v)
 	}
+	for _, v := range charset.EncodingLiteral {
+		list = append(list, v)
+	}
 	sort.Strings(list)
 	return list
 }
@@ -2850,3 +2896,30 @@ func (c *Completer) jsonEscapeTypeList() []string {
 	sort.Strings(list)
 	return list
 }
+
+func (c *Completer) sqlDialectList() []string {
+	list := make([]string, 0, len(cmd.SqlDialectLiteral))
+	for _, v := range cmd.SqlDialectLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (c *Completer) borderStyleList() []string {
+	list := make([]string, 0, len(cmd.BorderStyleLiteral))
+	for _, v := range cmd.BorderStyleLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (c *Completer) expandedDisplayList() []string {
+	list := make([]string, 0, len(cmd.ExpandedDisplayLiteral))
+	for _, v := range cmd.ExpandedDisplayLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
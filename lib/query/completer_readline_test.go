@@ -1,3 +1,4 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || windows
 // +build darwin dragonfly freebsd linux netbsd openbsd solaris windows
 
 package query
@@ -138,7 +139,7 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.funcs) != len(Functions)+3 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+4 {
 		t.Error("aggregate functions are not set correctly")
 	}
 	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
@@ -161,7 +162,7 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.funcList) != len(Functions)+3+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list is not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+4+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list is not set correctly")
 	}
 	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
@@ -625,6 +626,7 @@ var completerStatementsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("','")},
 			{Name: []rune("'\\t'")},
+			{Name: []rune("'AUTO'")},
 		},
 	},
 	{
@@ -651,6 +653,7 @@ var completerTableObjectArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("','")},
 			{Name: []rune("'\\t'")},
+			{Name: []rune("'AUTO'")},
 		},
 	},
 	{
@@ -686,6 +689,12 @@ var completerTableObjectArgsTests = []completerTest{
 		Index:    19,
 		Expect: readline.CandidateList{
 			{Name: []rune("AUTO")},
+			{Name: []rune("BIG5")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("EUCKR")},
+			{Name: []rune("GB18030")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -694,6 +703,15 @@ var completerTableObjectArgsTests = []completerTest{
 			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
 			{Name: []rune("UTF8M")},
+			{Name: []rune("WINDOWS1250")},
+			{Name: []rune("WINDOWS1251")},
+			{Name: []rune("WINDOWS1252")},
+			{Name: []rune("WINDOWS1253")},
+			{Name: []rune("WINDOWS1254")},
+			{Name: []rune("WINDOWS1255")},
+			{Name: []rune("WINDOWS1256")},
+			{Name: []rune("WINDOWS1257")},
+			{Name: []rune("WINDOWS1258")},
 		},
 	},
 	{
@@ -724,6 +742,7 @@ var completerTableObjectArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("','")},
 			{Name: []rune("'\\t'")},
+			{Name: []rune("'AUTO'")},
 			{Name: []rune("@var1")},
 			{Name: []rune("@var2")},
 		},
@@ -750,6 +769,12 @@ var completerTableObjectArgsTests = []completerTest{
 		Index:    15,
 		Expect: readline.CandidateList{
 			{Name: []rune("AUTO")},
+			{Name: []rune("BIG5")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("EUCKR")},
+			{Name: []rune("GB18030")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -758,6 +783,15 @@ var completerTableObjectArgsTests = []completerTest{
 			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
 			{Name: []rune("UTF8M")},
+			{Name: []rune("WINDOWS1250")},
+			{Name: []rune("WINDOWS1251")},
+			{Name: []rune("WINDOWS1252")},
+			{Name: []rune("WINDOWS1253")},
+			{Name: []rune("WINDOWS1254")},
+			{Name: []rune("WINDOWS1255")},
+			{Name: []rune("WINDOWS1256")},
+			{Name: []rune("WINDOWS1257")},
+			{Name: []rune("WINDOWS1258")},
 		},
 	},
 	{
@@ -1312,6 +1346,8 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1386,6 +1422,8 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1570,6 +1608,8 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1591,6 +1631,8 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1612,6 +1654,8 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1632,6 +1676,8 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2070,6 +2116,8 @@ var completerInsertArgsTests = []completerTest{
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2155,6 +2203,8 @@ var completerUpdateArgsTests = []completerTest{
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2239,6 +2289,8 @@ var completerReplaceArgsTests = []completerTest{
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2349,6 +2401,8 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2378,6 +2432,8 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2528,6 +2584,8 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2677,7 +2735,10 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("HEADER"), AppendSpace: true},
 			{Name: []rune("JSON_ESCAPE"), AppendSpace: true},
 			{Name: []rune("LINE_BREAK"), AppendSpace: true},
+			{Name: []rune("LOCALE"), AppendSpace: true},
 			{Name: []rune("PRETTY_PRINT"), AppendSpace: true},
+			{Name: []rune("QUOTE"), AppendSpace: true},
+			{Name: []rune("QUOTE_ESCAPE"), AppendSpace: true},
 		},
 	},
 	{
@@ -2719,11 +2780,15 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("SQL")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -2732,6 +2797,12 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set encoding to ",
 		Index:    42,
 		Expect: readline.CandidateList{
+			{Name: []rune("BIG5")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("EUCKR")},
+			{Name: []rune("GB18030")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -2740,6 +2811,15 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
 			{Name: []rune("UTF8M")},
+			{Name: []rune("WINDOWS1250")},
+			{Name: []rune("WINDOWS1251")},
+			{Name: []rune("WINDOWS1252")},
+			{Name: []rune("WINDOWS1253")},
+			{Name: []rune("WINDOWS1254")},
+			{Name: []rune("WINDOWS1255")},
+			{Name: []rune("WINDOWS1256")},
+			{Name: []rune("WINDOWS1257")},
+			{Name: []rune("WINDOWS1258")},
 		},
 	},
 	{
@@ -3080,6 +3160,8 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3090,6 +3172,7 @@ var completerSetArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("','")},
 			{Name: []rune("'\\t'")},
+			{Name: []rune("'AUTO'")},
 		},
 	},
 	{
@@ -3110,6 +3193,12 @@ var completerSetArgsTests = []completerTest{
 		Index:    18,
 		Expect: readline.CandidateList{
 			{Name: []rune("AUTO")},
+			{Name: []rune("BIG5")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("EUCKR")},
+			{Name: []rune("GB18030")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -3118,6 +3207,15 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
 			{Name: []rune("UTF8M")},
+			{Name: []rune("WINDOWS1250")},
+			{Name: []rune("WINDOWS1251")},
+			{Name: []rune("WINDOWS1252")},
+			{Name: []rune("WINDOWS1253")},
+			{Name: []rune("WINDOWS1254")},
+			{Name: []rune("WINDOWS1255")},
+			{Name: []rune("WINDOWS1256")},
+			{Name: []rune("WINDOWS1257")},
+			{Name: []rune("WINDOWS1258")},
 		},
 	},
 	{
@@ -3126,6 +3224,12 @@ var completerSetArgsTests = []completerTest{
 		OrigLine: "set @@write_encoding to ",
 		Index:    24,
 		Expect: readline.CandidateList{
+			{Name: []rune("BIG5")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("EUCKR")},
+			{Name: []rune("GB18030")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -3134,6 +3238,15 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
 			{Name: []rune("UTF8M")},
+			{Name: []rune("WINDOWS1250")},
+			{Name: []rune("WINDOWS1251")},
+			{Name: []rune("WINDOWS1252")},
+			{Name: []rune("WINDOWS1253")},
+			{Name: []rune("WINDOWS1254")},
+			{Name: []rune("WINDOWS1255")},
+			{Name: []rune("WINDOWS1256")},
+			{Name: []rune("WINDOWS1257")},
+			{Name: []rune("WINDOWS1258")},
 		},
 	},
 	{
@@ -3155,11 +3268,15 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("SQL")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3184,6 +3301,29 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("HEXALL")},
 		},
 	},
+	{
+		Name:     "SetArgs After TO for Sql Dialect Flag",
+		Line:     "",
+		OrigLine: "set @@sql_dialect to ",
+		Index:    21,
+		Expect: readline.CandidateList{
+			{Name: []rune("MYSQL")},
+			{Name: []rune("POSTGRES")},
+			{Name: []rune("SQLITE")},
+		},
+	},
+	{
+		Name:     "SetArgs After TO for Border Style Flag",
+		Line:     "",
+		OrigLine: "set @@border_style to ",
+		Index:    22,
+		Expect: readline.CandidateList{
+			{Name: []rune("ASCII")},
+			{Name: []rune("DOUBLE")},
+			{Name: []rune("MINIMAL")},
+			{Name: []rune("SINGLE")},
+		},
+	},
 	{
 		Name:     "SetArgs After TO",
 		Line:     "@",
@@ -3491,6 +3631,8 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -0,0 +1,416 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	ConstraintNotNull    = "NOT NULL"
+	ConstraintUnique     = "UNIQUE"
+	ConstraintPrimaryKey = "PRIMARY KEY"
+	ConstraintCheck      = "CHECK"
+)
+
+// ConstraintsFileSuffix is appended to the path of a data file to make the path of
+// the file in which the constraints of the table are stored.
+const ConstraintsFileSuffix = ".constraints.json"
+
+type Constraint struct {
+	Name      string
+	Type      string
+	Columns   []string
+	Condition parser.QueryExpression
+}
+
+func (c *Constraint) String() string {
+	s := "CONSTRAINT " + c.Name + " " + c.Type
+	switch c.Type {
+	case ConstraintCheck:
+		s = s + " (" + c.Condition.String() + ")"
+	default:
+		s = s + " (" + strings.Join(c.Columns, ", ") + ")"
+	}
+	return s
+}
+
+func (c *Constraint) HasColumn(column string) bool {
+	for _, col := range c.Columns {
+		if strings.EqualFold(col, column) {
+			return true
+		}
+	}
+	return false
+}
+
+func ConstraintsFilePath(path string) string {
+	return path + ConstraintsFileSuffix
+}
+
+type constraintsJson struct {
+	Constraints []constraintJson `json:"constraints"`
+}
+
+type constraintJson struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Columns []string `json:"columns,omitempty"`
+	Check   string   `json:"check,omitempty"`
+}
+
+// LoadConstraints reads the constraints of the table stored in the file at the path.
+// If the constraints file does not exist, then it returns nil.
+func LoadConstraints(path string, datetimeFormats []string) ([]*Constraint, error) {
+	fpath := ConstraintsFilePath(path)
+	if !file.Exists(fpath) {
+		return nil, nil
+	}
+
+	buf, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &constraintsJson{}
+	if err = json.Unmarshal(bytes.TrimSuffix(buf, []byte{0x00}), data); err != nil {
+		return nil, err
+	}
+
+	constraints := make([]*Constraint, 0, len(data.Constraints))
+	for _, c := range data.Constraints {
+		if len(c.Name) < 1 {
+			return nil, errors.New("constraint name is empty")
+		}
+
+		constraint := &Constraint{
+			Name:    c.Name,
+			Type:    strings.ToUpper(c.Type),
+			Columns: c.Columns,
+		}
+
+		switch constraint.Type {
+		case ConstraintNotNull, ConstraintUnique, ConstraintPrimaryKey:
+			if len(constraint.Columns) < 1 {
+				return nil, errors.New(fmt.Sprintf("columns of constraint %s are not specified", c.Name))
+			}
+		case ConstraintCheck:
+			if constraint.Condition, err = parseConstraintCondition(c.Check, datetimeFormats); err != nil {
+				return nil, errors.New(fmt.Sprintf("condition of constraint %s is invalid: %s", c.Name, err.Error()))
+			}
+		default:
+			return nil, errors.New(fmt.Sprintf("type %q of constraint %s is invalid", c.Type, c.Name))
+		}
+
+		constraints = append(constraints, constraint)
+	}
+	return constraints, nil
+}
+
+func parseConstraintCondition(s string, datetimeFormats []string) (parser.QueryExpression, error) {
+	if len(strings.TrimSpace(s)) < 1 {
+		return nil, errors.New("condition is empty")
+	}
+
+	program, _, err := parser.Parse("SELECT "+s, "", datetimeFormats, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(program) == 1 {
+		if query, ok := program[0].(parser.SelectQuery); ok && query.OrderByClause == nil && query.LimitClause == nil {
+			if entity, ok := query.SelectEntity.(parser.SelectEntity); ok && entity.FromClause == nil && entity.WhereClause == nil && entity.GroupByClause == nil && entity.HavingClause == nil {
+				if fields := entity.SelectClause.(parser.SelectClause).Fields; len(fields) == 1 {
+					if field, ok := fields[0].(parser.Field); ok && field.Alias == nil {
+						return field.Object, nil
+					}
+				}
+			}
+		}
+	}
+	return nil, errors.New(fmt.Sprintf("%q is not a value expression", s))
+}
+
+// SaveConstraints writes the constraints of the table stored in the file at the path.
+// If the table has no constraint, then the constraints file is removed.
+func SaveConstraints(path string, constraints []*Constraint) error {
+	fpath := ConstraintsFilePath(path)
+
+	if len(constraints) < 1 {
+		if err := os.Remove(fpath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	data := constraintsJson{
+		Constraints: make([]constraintJson, 0, len(constraints)),
+	}
+	for _, c := range constraints {
+		cj := constraintJson{
+			Name: c.Name,
+			Type: c.Type,
+		}
+		if c.Type == ConstraintCheck {
+			cj.Check = c.Condition.String()
+		} else {
+			cj.Columns = c.Columns
+		}
+		data.Constraints = append(data.Constraints, cj)
+	}
+
+	buf := &bytes.Buffer{}
+	e := json.NewEncoder(buf)
+	e.SetEscapeHTML(false)
+	e.SetIndent("", "  ")
+	if err := e.Encode(data); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fpath, buf.Bytes(), 0644)
+}
+
+// NewConstraint creates a constraint of the table from the expression and
+// validates it against the header and the constraints already defined on the table.
+func NewConstraint(expr parser.TableConstraint, table string, header Header, constraints []*Constraint) (*Constraint, error) {
+	constraint := &Constraint{
+		Name:      expr.Name.Literal,
+		Condition: expr.Condition,
+	}
+
+	switch expr.Type.Token {
+	case parser.NOT:
+		constraint.Type = ConstraintNotNull
+	case parser.UNIQUE:
+		constraint.Type = ConstraintUnique
+	case parser.PRIMARY:
+		constraint.Type = ConstraintPrimaryKey
+	default: //parser.CHECK
+		constraint.Type = ConstraintCheck
+	}
+
+	columnNames := header.TableColumnNames()
+	constraint.Columns = make([]string, 0, len(expr.Columns))
+	for _, c := range expr.Columns {
+		ident := c.(parser.Identifier)
+
+		idx := -1
+		for i := range columnNames {
+			if strings.EqualFold(columnNames[i], ident.Literal) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, NewFieldNotExistError(ident)
+		}
+		if constraint.HasColumn(columnNames[idx]) {
+			return nil, NewDuplicateFieldNameError(ident)
+		}
+		constraint.Columns = append(constraint.Columns, columnNames[idx])
+	}
+
+	if expr.IsNamed() {
+		for _, c := range constraints {
+			if strings.EqualFold(c.Name, constraint.Name) {
+				return nil, NewDuplicateConstraintNameError(expr.Name)
+			}
+		}
+	} else {
+		constraint.Name = uniqueConstraintName(constraintNamePrefix(table, constraint), constraints)
+	}
+
+	if constraint.Type == ConstraintPrimaryKey {
+		for _, c := range constraints {
+			if c.Type == ConstraintPrimaryKey {
+				return nil, NewInvalidConstraintError(expr, constraint.Name, fmt.Sprintf("table %s already has a primary key %s", table, c.Name))
+			}
+		}
+	}
+
+	return constraint, nil
+}
+
+func constraintNamePrefix(table string, constraint *Constraint) string {
+	switch constraint.Type {
+	case ConstraintPrimaryKey:
+		return table + "_pkey"
+	case ConstraintUnique:
+		return table + "_" + strings.Join(constraint.Columns, "_") + "_key"
+	case ConstraintNotNull:
+		return table + "_" + strings.Join(constraint.Columns, "_") + "_not_null"
+	default:
+		return table + "_check"
+	}
+}
+
+func uniqueConstraintName(name string, constraints []*Constraint) string {
+	exists := func(s string) bool {
+		for _, c := range constraints {
+			if strings.EqualFold(c.Name, s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !exists(name) {
+		return name
+	}
+	for i := 1; ; i++ {
+		s := name + strconv.Itoa(i)
+		if !exists(s) {
+			return s
+		}
+	}
+}
+
+// ValidateConstraintCondition checks that the condition of a check constraint can be evaluated
+// against the header by evaluating it on a record filled with nulls.
+func ValidateConstraintCondition(ctx context.Context, scope *ReferenceScope, header Header, constraint *Constraint) error {
+	if constraint.Type != ConstraintCheck {
+		return nil
+	}
+
+	record := make(Record, header.Len())
+	for i := range record {
+		record[i] = NewCell(value.NewNull())
+	}
+	view := &View{
+		Header:    header,
+		RecordSet: RecordSet{record},
+	}
+
+	if _, err := Evaluate(ctx, scope.CreateScopeForRecordEvaluation(view, 0), constraint.Condition); err != nil {
+		if e, ok := err.(Error); ok {
+			return NewInvalidConstraintError(constraint.Condition, constraint.Name, e.Message())
+		}
+		return NewInvalidConstraintError(constraint.Condition, constraint.Name, err.Error())
+	}
+	return nil
+}
+
+// CheckConstraints validates the records of the view against the constraints of the table.
+// If targets is nil, then all the records are validated.
+// Otherwise, only the records at the indices in targets are validated for the constraints
+// that can be checked with each record alone.
+func CheckConstraints(ctx context.Context, scope *ReferenceScope, expr parser.Expression, view *View, targets []int) error {
+	if view.FileInfo == nil || len(view.FileInfo.Constraints) < 1 {
+		return nil
+	}
+	return checkConstraints(ctx, scope, expr, view, view.FileInfo.Constraints, targets)
+}
+
+func checkConstraints(ctx context.Context, scope *ReferenceScope, expr parser.Expression, view *View, constraints []*Constraint, targets []int) error {
+
+	if targets == nil {
+		targets = make([]int, view.RecordLen())
+		for i := range targets {
+			targets[i] = i
+		}
+	}
+
+	table := parser.FormatTableName(view.FileInfo.Path)
+
+	for _, c := range constraints {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		switch c.Type {
+		case ConstraintCheck:
+			for _, i := range targets {
+				p, err := Evaluate(ctx, scope.CreateScopeForRecordEvaluation(view, i), c.Condition)
+				if err != nil {
+					return err
+				}
+				if p.Ternary() == ternary.FALSE {
+					return NewConstraintViolationError(expr, c.Name, table, fmt.Sprintf("record %d does not satisfy the condition %s", i+1, c.Condition))
+				}
+			}
+		default:
+			indices, err := constraintColumnIndices(view.Header, c)
+			if err != nil {
+				return NewInvalidConstraintError(expr, c.Name, err.Error())
+			}
+
+			if c.Type == ConstraintNotNull || c.Type == ConstraintPrimaryKey {
+				for _, i := range targets {
+					for j, idx := range indices {
+						if value.IsNull(view.RecordSet[i][idx][0]) {
+							return NewConstraintViolationError(expr, c.Name, table, fmt.Sprintf("record %d has a null in column %s", i+1, c.Columns[j]))
+						}
+					}
+				}
+			}
+
+			if c.Type == ConstraintUnique || c.Type == ConstraintPrimaryKey {
+				if err := checkUniqueness(ctx, scope, expr, view, c, table, indices); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func constraintColumnIndices(header Header, constraint *Constraint) ([]int, error) {
+	indices := make([]int, len(constraint.Columns))
+	for i, col := range constraint.Columns {
+		indices[i] = -1
+		for j := range header {
+			if header[j].IsFromTable && strings.EqualFold(header[j].Column, col) {
+				indices[i] = j
+				break
+			}
+		}
+		if indices[i] < 0 {
+			return nil, errors.New(fmt.Sprintf("column %s does not exist", col))
+		}
+	}
+	return indices, nil
+}
+
+func checkUniqueness(ctx context.Context, scope *ReferenceScope, expr parser.Expression, view *View, constraint *Constraint, table string, indices []int) error {
+	keys := make(map[string]int, view.RecordLen())
+	values := make([]value.Primary, len(indices))
+	buf := GetComparisonKeysBuf()
+	defer PutComparisonkeysBuf(buf)
+
+	for i := range view.RecordSet {
+		if i&255 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		hasNull := false
+		for j, idx := range indices {
+			values[j] = view.RecordSet[i][idx][0]
+			if value.IsNull(values[j]) {
+				hasNull = true
+				break
+			}
+		}
+		if hasNull {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, values, scope.Tx.Flags)
+		key := buf.String()
+		if first, ok := keys[key]; ok {
+			return NewConstraintViolationError(expr, constraint.Name, table, fmt.Sprintf("record %d has the same value of (%s) as record %d", i+1, strings.Join(constraint.Columns, ", "), first+1))
+		}
+		keys[key] = i
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var newConstraintTests = []struct {
+	Name        string
+	Expr        parser.TableConstraint
+	Constraints []*Constraint
+	Result      *Constraint
+	Error       string
+}{
+	{
+		Name: "NewConstraint Primary Key",
+		Expr: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.PRIMARY, Literal: "primary"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "COLUMN1"}},
+		},
+		Result: &Constraint{
+			Name:    "table1_pkey",
+			Type:    ConstraintPrimaryKey,
+			Columns: []string{"column1"},
+		},
+	},
+	{
+		Name: "NewConstraint Unique with Generated Name Collision",
+		Expr: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.UNIQUE, Literal: "unique"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "column1"}, parser.Identifier{Literal: "column2"}},
+		},
+		Constraints: []*Constraint{
+			{Name: "table1_column1_column2_key", Type: ConstraintUnique, Columns: []string{"column1"}},
+		},
+		Result: &Constraint{
+			Name:    "table1_column1_column2_key1",
+			Type:    ConstraintUnique,
+			Columns: []string{"column1", "column2"},
+		},
+	},
+	{
+		Name: "NewConstraint Named Check",
+		Expr: parser.TableConstraint{
+			Name: parser.Identifier{Literal: "positive"},
+			Type: parser.Token{Token: parser.CHECK, Literal: "check"},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("0"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+			},
+		},
+		Result: &Constraint{
+			Name:    "positive",
+			Type:    ConstraintCheck,
+			Columns: []string{},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("0"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+			},
+		},
+	},
+	{
+		Name: "NewConstraint Field Not Exist Error",
+		Expr: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.NOT, Literal: "not"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "NewConstraint Duplicate Name Error",
+		Expr: parser.TableConstraint{
+			Name:    parser.Identifier{Literal: "PK"},
+			Type:    parser.Token{Token: parser.UNIQUE, Literal: "unique"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "column1"}},
+		},
+		Constraints: []*Constraint{
+			{Name: "pk", Type: ConstraintPrimaryKey, Columns: []string{"column1"}},
+		},
+		Error: "constraint PK is a duplicate",
+	},
+	{
+		Name: "NewConstraint Multiple Primary Keys Error",
+		Expr: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.PRIMARY, Literal: "primary"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "column2"}},
+		},
+		Constraints: []*Constraint{
+			{Name: "pk", Type: ConstraintPrimaryKey, Columns: []string{"column1"}},
+		},
+		Error: "invalid constraint table1_pkey: table table1 already has a primary key pk",
+	},
+}
+
+func TestNewConstraint(t *testing.T) {
+	header := NewHeader("table1", []string{"column1", "column2"})
+
+	for _, v := range newConstraintTests {
+		result, err := NewConstraint(v.Expr, "table1", header, v.Constraints)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var checkConstraintsTests = []struct {
+	Name        string
+	Expr        parser.Expression
+	Constraints []*Constraint
+	Records     RecordSet
+	Targets     []int
+	Error       string
+}{
+	{
+		Name: "CheckConstraints",
+		Constraints: []*Constraint{
+			{Name: "pk", Type: ConstraintPrimaryKey, Columns: []string{"column1"}},
+			{Name: "uq", Type: ConstraintUnique, Columns: []string{"column2"}},
+			{
+				Name: "ck",
+				Type: ConstraintCheck,
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("0"),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+				},
+			},
+		},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewInteger(3), value.NewNull()}),
+		},
+	},
+	{
+		Name: "CheckConstraints Not Null Violation",
+		Constraints: []*Constraint{
+			{Name: "pk", Type: ConstraintPrimaryKey, Columns: []string{"column1"}},
+		},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("b")}),
+		},
+		Error: "constraint pk on table1 is violated: record 2 has a null in column column1",
+	},
+	{
+		Name: "CheckConstraints Not Null Violation Out of Targets",
+		Constraints: []*Constraint{
+			{Name: "nn", Type: ConstraintNotNull, Columns: []string{"column2"}},
+		},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
+		},
+		Targets: []int{1},
+	},
+	{
+		Name: "CheckConstraints Unique Violation",
+		Constraints: []*Constraint{
+			{Name: "uq", Type: ConstraintUnique, Columns: []string{"column1", "column2"}},
+		},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("a")}),
+		},
+		Error: "constraint uq on table1 is violated: record 3 has the same value of (column1, column2) as record 1",
+	},
+	{
+		Name: "CheckConstraints Check Violation",
+		Constraints: []*Constraint{
+			{
+				Name: "ck",
+				Type: ConstraintCheck,
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("0"),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+				},
+			},
+		},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(0), value.NewString("b")}),
+		},
+		Error: "constraint ck on table1 is violated: record 2 does not satisfy the condition column1 > 0",
+	},
+	{
+		Name: "CheckConstraints Violation in Insert Query",
+		Expr: parser.InsertQuery{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}),
+			Table:    parser.Table{Object: parser.Identifier{Literal: "table1"}},
+		},
+		Constraints: []*Constraint{
+			{Name: "pk", Type: ConstraintPrimaryKey, Columns: []string{"column1"}},
+		},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("b")}),
+		},
+		Error: "[L:1 C:1] constraint pk on table1 is violated: record 2 has the same value of (column1) as record 1",
+	},
+}
+
+func TestCheckConstraints(t *testing.T) {
+	scope := NewReferenceScope(TestTx)
+	ctx := context.Background()
+
+	for _, v := range checkConstraintsTests {
+		view := &View{
+			Header:    NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: v.Records,
+			FileInfo: &FileInfo{
+				Path:        GetTestFilePath("table1.csv"),
+				Constraints: v.Constraints,
+			},
+		}
+
+		err := CheckConstraints(ctx, scope, v.Expr, view, v.Targets)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+func TestSaveConstraints(t *testing.T) {
+	path := GetTestFilePath("save_constraints.csv")
+	constraints := []*Constraint{
+		{Name: "pk", Type: ConstraintPrimaryKey, Columns: []string{"column1"}},
+		{
+			Name: "ck",
+			Type: ConstraintCheck,
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("0"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+			},
+		},
+	}
+
+	if err := SaveConstraints(path, constraints); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	result, err := LoadConstraints(path, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if len(result) != len(constraints) {
+		t.Fatalf("constraints length = %d, want %d", len(result), len(constraints))
+	}
+	for i := range constraints {
+		if result[i].String() != constraints[i].String() {
+			t.Errorf("constraint = %s, want %s", result[i], constraints[i])
+		}
+	}
+
+	if err = SaveConstraints(path, nil); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	result, err = LoadConstraints(path, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if result != nil {
+		t.Errorf("constraints = %v, want nil", result)
+	}
+}
@@ -0,0 +1,394 @@
+package query
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/charset"
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+const (
+	errorUnexpectedQuote = "unexpected quotation mark in field"
+	errorExtraneousQuote = "extraneous quotation mark in field"
+)
+
+type RejectedLine struct {
+	Line   int
+	Reason string
+	Text   string
+}
+
+// LenientLoadSummary holds the rows that are adjusted or skipped while loading a file in lenient mode.
+type LenientLoadSummary struct {
+	Padded    int
+	Truncated int
+	Rejected  []RejectedLine
+}
+
+func (s *LenientLoadSummary) IsEmpty() bool {
+	return s.Padded < 1 && s.Truncated < 1 && len(s.Rejected) < 1
+}
+
+func (s *LenientLoadSummary) String() string {
+	list := make([]string, 0, 3)
+	if 0 < s.Padded {
+		list = append(list, FormatCount(s.Padded, "row")+" padded")
+	}
+	if 0 < s.Truncated {
+		list = append(list, FormatCount(s.Truncated, "row")+" truncated")
+	}
+	if 0 < len(s.Rejected) {
+		list = append(list, FormatCount(len(s.Rejected), "row")+" rejected")
+	}
+	return strings.Join(list, ", ")
+}
+
+type physicalLine struct {
+	number    int
+	text      string
+	lineBreak text.LineBreak
+}
+
+// csvReader reads CSV in the same way as csv.Reader, and also supports a custom quotation mark,
+// backslash escaping, leading lines to be skipped and comment lines.
+//
+// In lenient mode, ragged rows are padded or truncated to the number of fields of the first row,
+// and rows that cannot be parsed are skipped and recorded in the summary.
+type csvReader struct {
+	Delimiter   rune
+	Quote       rune
+	QuoteEscape cmd.QuoteEscape
+	SkipLines   int
+	Comment     string
+	WithoutNull bool
+	Lenient     bool
+
+	reader  *bufio.Reader
+	pending []physicalLine
+	line    int
+
+	FieldsPerRecord   int
+	DetectedLineBreak text.LineBreak
+	EnclosedAll       bool
+
+	Summary LenientLoadSummary
+}
+
+func newCSVReader(r io.Reader, enc text.Encoding) (*csvReader, error) {
+	decoder, err := charset.GetTransformDecoder(r, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csvReader{
+		Delimiter:   ',',
+		Quote:       '"',
+		QuoteEscape: cmd.QuoteEscapeDouble,
+		reader:      bufio.NewReader(decoder),
+		EnclosedAll: true,
+	}, nil
+}
+
+func (r *csvReader) newError(line int, s string) error {
+	return errors.New(fmt.Sprintf("line %d: %s", line, s))
+}
+
+func (r *csvReader) ReadHeader() ([]string, error) {
+	record, err := r.parseRecord(true)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]string, len(record))
+	for i, v := range record {
+		header[i] = string(v)
+	}
+	return header, nil
+}
+
+func (r *csvReader) Read() ([]text.RawText, error) {
+	return r.parseRecord(r.WithoutNull)
+}
+
+func (r *csvReader) readLine() (physicalLine, error) {
+	if 0 < len(r.pending) {
+		l := r.pending[0]
+		r.pending = r.pending[1:]
+		return l, nil
+	}
+
+	for r.line < r.SkipLines {
+		if _, err := r.readPhysicalLine(); err != nil {
+			return physicalLine{}, err
+		}
+	}
+	return r.readPhysicalLine()
+}
+
+func (r *csvReader) readPhysicalLine() (physicalLine, error) {
+	var buf strings.Builder
+	var lineBreak text.LineBreak
+	for {
+		ch, _, err := r.reader.ReadRune()
+		if err != nil {
+			if err == io.EOF && 0 < buf.Len() {
+				break
+			}
+			return physicalLine{}, err
+		}
+
+		if ch == '\r' {
+			if nxt, _, err := r.reader.ReadRune(); err == nil {
+				if nxt == '\n' {
+					lineBreak = text.CRLF
+					break
+				}
+				if err = r.reader.UnreadRune(); err != nil {
+					return physicalLine{}, err
+				}
+			}
+			lineBreak = text.CR
+			break
+		}
+		if ch == '\n' {
+			lineBreak = text.LF
+			break
+		}
+		buf.WriteRune(ch)
+	}
+
+	r.line++
+	return physicalLine{number: r.line, text: buf.String(), lineBreak: lineBreak}, nil
+}
+
+func (r *csvReader) parseRecord(withoutNull bool) ([]text.RawText, error) {
+	for {
+		fields, quoted, err := r.parseFields()
+		if err != nil {
+			return nil, err
+		}
+		if fields == nil || (len(fields) == 1 && len(fields[0]) < 1) {
+			continue
+		}
+
+		if r.FieldsPerRecord < 1 {
+			r.FieldsPerRecord = len(fields)
+		} else if len(fields) != r.FieldsPerRecord {
+			if !r.Lenient {
+				return nil, r.newError(r.line, "wrong number of fields in line")
+			}
+			if len(fields) < r.FieldsPerRecord {
+				r.Summary.Padded++
+			} else {
+				r.Summary.Truncated++
+			}
+		}
+
+		record := make([]text.RawText, r.FieldsPerRecord)
+		for i := 0; i < r.FieldsPerRecord; i++ {
+			if len(fields) <= i || (len(fields[i]) < 1 && !quoted[i]) {
+				if withoutNull {
+					record[i] = text.RawText{}
+				}
+			} else {
+				record[i] = text.RawText(fields[i])
+			}
+		}
+		return record, nil
+	}
+}
+
+// parseFields returns the fields of the next record.
+// If the record cannot be parsed in lenient mode, then the lines are recorded as rejected and nil is returned.
+func (r *csvReader) parseFields() ([]string, []bool, error) {
+	var first physicalLine
+	var err error
+	for {
+		if first, err = r.readLine(); err != nil {
+			return nil, nil, err
+		}
+		if len(r.Comment) < 1 || !strings.HasPrefix(first.text, r.Comment) {
+			break
+		}
+	}
+	lines := []physicalLine{first}
+
+	fields := make([]string, 0, r.FieldsPerRecord)
+	quoted := make([]bool, 0, r.FieldsPerRecord)
+	var field strings.Builder
+	fieldQuoted := false
+	inQuotes := false
+	closed := false
+	backslash := false
+
+	endField := func() {
+		fields = append(fields, field.String())
+		quoted = append(quoted, fieldQuoted)
+		field.Reset()
+		fieldQuoted = false
+		inQuotes = false
+		closed = false
+	}
+
+	line := first
+	for {
+		for _, ch := range line.text {
+			if inQuotes {
+				if backslash {
+					backslash = false
+					if ch != r.Quote && ch != '\\' {
+						field.WriteRune('\\')
+					}
+					field.WriteRune(ch)
+					continue
+				}
+
+				if closed {
+					switch ch {
+					case r.Quote:
+						closed = false
+						field.WriteRune(ch)
+					case r.Delimiter:
+						endField()
+					default:
+						return r.parseError(lines, errorUnexpectedQuote)
+					}
+					continue
+				}
+
+				switch {
+				case ch == r.Quote:
+					closed = true
+				case ch == '\\' && r.QuoteEscape == cmd.QuoteEscapeBackslash:
+					backslash = true
+				default:
+					field.WriteRune(ch)
+				}
+				continue
+			}
+
+			switch ch {
+			case r.Delimiter:
+				endField()
+			case r.Quote:
+				if field.Len() < 1 && !fieldQuoted {
+					fieldQuoted = true
+					inQuotes = true
+				} else {
+					field.WriteRune(ch)
+				}
+			default:
+				if r.EnclosedAll && unicode.IsLetter(ch) {
+					r.EnclosedAll = false
+				}
+				field.WriteRune(ch)
+			}
+		}
+
+		if !inQuotes || closed {
+			break
+		}
+		if backslash {
+			backslash = false
+			field.WriteRune('\\')
+		}
+
+		next, err := r.readLine()
+		if err != nil {
+			if err != io.EOF {
+				return nil, nil, err
+			}
+			r.pending = append(lines[1:], r.pending...)
+			return r.parseError(lines[:1], errorExtraneousQuote)
+		}
+		field.WriteString(line.lineBreak.Value())
+		lines = append(lines, next)
+		line = next
+	}
+	endField()
+
+	if r.DetectedLineBreak == "" && line.lineBreak != "" {
+		r.DetectedLineBreak = line.lineBreak
+	}
+	return fields, quoted, nil
+}
+
+func (r *csvReader) parseError(lines []physicalLine, message string) ([]string, []bool, error) {
+	if !r.Lenient {
+		return nil, nil, r.newError(lines[len(lines)-1].number, message)
+	}
+
+	var buf strings.Builder
+	for i, l := range lines {
+		if 0 < i {
+			buf.WriteString(lines[i-1].lineBreak.Value())
+		}
+		buf.WriteString(l.text)
+	}
+
+	r.Summary.Rejected = append(r.Summary.Rejected, RejectedLine{
+		Line:   lines[0].number,
+		Reason: message,
+		Text:   buf.String(),
+	})
+	return nil, nil, nil
+}
+
+// appendRejectedLines appends the rejected lines to the reject file in CSV.
+// The header is written only when the reject file is empty, and each line is terminated by a line break.
+func appendRejectedLines(rejectFile string, path string, lines []RejectedLine) error {
+	fp, err := os.OpenFile(rejectFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	stat, err := fp.Stat()
+	if err != nil {
+		return err
+	}
+
+	w, err := csv.NewWriter(fp, text.LF, text.UTF8)
+	if err != nil {
+		return err
+	}
+
+	if stat.Size() < 1 {
+		if err = w.Write([]csv.Field{
+			csv.NewField("file", false),
+			csv.NewField("line", false),
+			csv.NewField("reason", false),
+			csv.NewField("text", false),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range lines {
+		if err = w.Write([]csv.Field{
+			csv.NewField(path, true),
+			csv.NewField(strconv.Itoa(l.Line), false),
+			csv.NewField(l.Reason, true),
+			csv.NewField(l.Text, true),
+		}); err != nil {
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	_, err = fp.WriteString(text.LF.Value())
+	return err
+}
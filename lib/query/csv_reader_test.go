@@ -0,0 +1,263 @@
+package query
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+var csvReaderTests = []struct {
+	Name              string
+	Input             string
+	Delimiter         rune
+	Quote             rune
+	QuoteEscape       cmd.QuoteEscape
+	SkipLines         int
+	Comment           string
+	WithoutNull       bool
+	Lenient           bool
+	NoHeader          bool
+	Header            []string
+	Result            [][]text.RawText
+	Summary           LenientLoadSummary
+	DetectedLineBreak text.LineBreak
+	EnclosedAll       bool
+	Error             string
+}{
+	{
+		Name:   "Well-Formed Rows",
+		Input:  "c1,c2\n1,\"a\nb\"\n2,\"c\"\"d\"\n",
+		Header: []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a\nb")},
+			{text.RawText("2"), text.RawText("c\"d")},
+		},
+		DetectedLineBreak: text.LF,
+	},
+	{
+		Name:    "Ragged Rows",
+		Input:   "c1,c2,c3\r\n1,2\r\n\r\n3,4,5,6\r\n7,8,9",
+		Lenient: true,
+		Header:  []string{"c1", "c2", "c3"},
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("2"), nil},
+			{text.RawText("3"), text.RawText("4"), text.RawText("5")},
+			{text.RawText("7"), text.RawText("8"), text.RawText("9")},
+		},
+		Summary: LenientLoadSummary{
+			Padded:    1,
+			Truncated: 1,
+		},
+		DetectedLineBreak: text.CRLF,
+	},
+	{
+		Name:        "Ragged Rows WithoutNull",
+		Input:       "1;2\n3\n",
+		Delimiter:   ';',
+		WithoutNull: true,
+		Lenient:     true,
+		NoHeader:    true,
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("2")},
+			{text.RawText("3"), text.RawText{}},
+		},
+		Summary: LenientLoadSummary{
+			Padded: 1,
+		},
+		DetectedLineBreak: text.LF,
+		EnclosedAll:       true,
+	},
+	{
+		Name:    "Unexpected Quotation Mark",
+		Input:   "c1,c2\n1,\"a\"b\n2,\"c\nd\"e\n3,f\n",
+		Lenient: true,
+		Header:  []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("3"), text.RawText("f")},
+		},
+		Summary: LenientLoadSummary{
+			Rejected: []RejectedLine{
+				{Line: 2, Reason: "unexpected quotation mark in field", Text: "1,\"a\"b"},
+				{Line: 3, Reason: "unexpected quotation mark in field", Text: "2,\"c\nd\"e"},
+			},
+		},
+		DetectedLineBreak: text.LF,
+	},
+	{
+		Name:    "Extraneous Quotation Mark",
+		Input:   "c1,c2\n1,\"a\n2,b\n3,c\n",
+		Lenient: true,
+		Header:  []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("2"), text.RawText("b")},
+			{text.RawText("3"), text.RawText("c")},
+		},
+		Summary: LenientLoadSummary{
+			Rejected: []RejectedLine{
+				{Line: 2, Reason: "extraneous quotation mark in field", Text: "1,\"a"},
+			},
+		},
+		DetectedLineBreak: text.LF,
+	},
+	{
+		Name:   "Wrong Number of Fields Error",
+		Input:  "c1,c2\n1,2\n3\n",
+		Header: []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("2")},
+		},
+		Error: "line 3: wrong number of fields in line",
+	},
+	{
+		Name:   "Unexpected Quotation Mark Error",
+		Input:  "c1,c2\n1,\"a\"b\n",
+		Header: []string{"c1", "c2"},
+		Result: [][]text.RawText{},
+		Error:  "line 2: unexpected quotation mark in field",
+	},
+	{
+		Name:   "Quotation Mark",
+		Input:  "c1,c2\n1,'a,''b'''\n2,\"c\"\n",
+		Quote:  '\'',
+		Header: []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a,'b'")},
+			{text.RawText("2"), text.RawText("\"c\"")},
+		},
+		DetectedLineBreak: text.LF,
+	},
+	{
+		Name:        "Backslash Escape",
+		Input:       "c1,c2\n1,\"a\\\"b\\\\c\\nd\"\n",
+		QuoteEscape: cmd.QuoteEscapeBackslash,
+		Header:      []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a\"b\\c\\nd")},
+		},
+		DetectedLineBreak: text.LF,
+	},
+	{
+		Name:      "Skip Lines and Comment Lines",
+		Input:     "exported at 2012-02-03\n\n# header\nc1,c2\n1,a\n# comment,line\n2,b\n",
+		SkipLines: 2,
+		Comment:   "#",
+		Header:    []string{"c1", "c2"},
+		Result: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a")},
+			{text.RawText("2"), text.RawText("b")},
+		},
+		DetectedLineBreak: text.LF,
+	},
+}
+
+func TestCSVReader(t *testing.T) {
+	for _, v := range csvReaderTests {
+		r, _ := newCSVReader(strings.NewReader(v.Input), text.UTF8)
+		if v.Delimiter != 0 {
+			r.Delimiter = v.Delimiter
+		}
+		if v.Quote != 0 {
+			r.Quote = v.Quote
+		}
+		r.QuoteEscape = v.QuoteEscape
+		r.SkipLines = v.SkipLines
+		r.Comment = v.Comment
+		r.WithoutNull = v.WithoutNull
+		r.Lenient = v.Lenient
+
+		if !v.NoHeader {
+			header, err := r.ReadHeader()
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				continue
+			}
+			if !reflect.DeepEqual(header, v.Header) {
+				t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+			}
+		}
+
+		result := make([][]text.RawText, 0, len(v.Result))
+		var err error
+		for {
+			var record []text.RawText
+			if record, err = r.Read(); err != nil {
+				break
+			}
+			result = append(result, record)
+		}
+
+		if err != io.EOF {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+		if !reflect.DeepEqual(r.Summary, v.Summary) {
+			t.Errorf("%s: summary = %v, want %v", v.Name, r.Summary, v.Summary)
+		}
+		if r.DetectedLineBreak != v.DetectedLineBreak {
+			t.Errorf("%s: line break = %q, want %q", v.Name, r.DetectedLineBreak, v.DetectedLineBreak)
+		}
+		if r.EnclosedAll != v.EnclosedAll {
+			t.Errorf("%s: enclosed all = %t, want %t", v.Name, r.EnclosedAll, v.EnclosedAll)
+		}
+	}
+}
+
+func TestLenientLoadSummary_String(t *testing.T) {
+	summary := LenientLoadSummary{
+		Padded: 2,
+		Rejected: []RejectedLine{
+			{Line: 3, Reason: "unexpected quotation mark in field", Text: "1,\"a\"b"},
+		},
+	}
+	expect := "2 rows padded, 1 row rejected"
+
+	if summary.String() != expect {
+		t.Errorf("result = %q, want %q", summary.String(), expect)
+	}
+}
+
+func TestAppendRejectedLines(t *testing.T) {
+	rejectFile := filepath.Join(TestDir, "append_rejected_lines.csv")
+	defer func() {
+		_ = os.Remove(rejectFile)
+	}()
+
+	_ = appendRejectedLines(rejectFile, "/path/to/table1.csv", []RejectedLine{
+		{Line: 2, Reason: "unexpected quotation mark in field", Text: "1,\"a\"b"},
+	})
+	_ = appendRejectedLines(rejectFile, "/path/to/table2.csv", []RejectedLine{
+		{Line: 5, Reason: "extraneous quotation mark in field", Text: "2,\"c"},
+	})
+
+	expect := "file,line,reason,text\n" +
+		"\"/path/to/table1.csv\",2,\"unexpected quotation mark in field\",\"1,\"\"a\"\"b\"\n" +
+		"\"/path/to/table2.csv\",5,\"extraneous quotation mark in field\",\"2,\"\"c\"\n"
+
+	b, err := ioutil.ReadFile(rejectFile)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if string(b) != expect {
+		t.Errorf("result = %q, want %q", string(b), expect)
+	}
+}
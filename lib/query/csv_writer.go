@@ -0,0 +1,111 @@
+package query
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/charset"
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+type csvRecordWriter interface {
+	Write(record []csv.Field) error
+	Flush() error
+}
+
+// csvWriter writes CSV with a custom quotation mark and escaping.
+//
+// Fields are enclosed if they are specified to be quoted, or if they contain the delimiter, the quotation mark or line breaks.
+type csvWriter struct {
+	Delimiter   rune
+	Quote       rune
+	QuoteEscape cmd.QuoteEscape
+
+	writer    *bufio.Writer
+	lineBreak string
+	appended  bool
+}
+
+func newCSVWriter(w io.Writer, lineBreak text.LineBreak, enc text.Encoding) (*csvWriter, error) {
+	writer, err := charset.GetTransformWriter(w, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{
+		Delimiter:   ',',
+		Quote:       '"',
+		QuoteEscape: cmd.QuoteEscapeDouble,
+		writer:      bufio.NewWriter(writer),
+		lineBreak:   lineBreak.Value(),
+	}, nil
+}
+
+func (e *csvWriter) Write(record []csv.Field) error {
+	if e.appended {
+		if _, err := e.writer.WriteString(e.lineBreak); err != nil {
+			return err
+		}
+	} else {
+		e.appended = true
+	}
+
+	for i := range record {
+		if 0 < i {
+			if _, err := e.writer.WriteRune(e.Delimiter); err != nil {
+				return err
+			}
+		}
+
+		if !record[i].Quote && !e.needsQuote(record[i].Contents) {
+			if _, err := e.writer.WriteString(record[i].Contents); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := e.writer.WriteRune(e.Quote); err != nil {
+			return err
+		}
+		for _, r := range record[i].Contents {
+			if esc := e.escapeCharacter(r); esc != 0 {
+				if _, err := e.writer.WriteRune(esc); err != nil {
+					return err
+				}
+			}
+			if _, err := e.writer.WriteRune(r); err != nil {
+				return err
+			}
+		}
+		if _, err := e.writer.WriteRune(e.Quote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *csvWriter) Flush() error {
+	return e.writer.Flush()
+}
+
+// escapeCharacter returns the character to be written before r in enclosed fields, or 0 if r is not escaped.
+func (e *csvWriter) escapeCharacter(r rune) rune {
+	if e.QuoteEscape == cmd.QuoteEscapeBackslash {
+		if r == e.Quote || r == '\\' {
+			return '\\'
+		}
+		return 0
+	}
+	if r == e.Quote {
+		return e.Quote
+	}
+	return 0
+}
+
+func (e *csvWriter) needsQuote(s string) bool {
+	return strings.ContainsAny(s, string([]rune{e.Delimiter, e.Quote, '\r', '\n'}))
+}
@@ -0,0 +1,447 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text/color"
+)
+
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+
+	DiffColumn    = "diff"
+	DiffOldSuffix = "_old"
+	DiffNewSuffix = "_new"
+)
+
+// DiffResult is the result of a key-based comparison of two tables.
+//
+// The view has the diff column, the key columns, and an old and a new column
+// for each of the other columns of the tables.
+// Records that exist in both tables and have the same values are not included in the view.
+//
+// AddedColumns are the columns that exist only in the second table,
+// and RemovedColumns are the columns that exist only in the first table.
+type DiffResult struct {
+	Keys           []string
+	Columns        []string
+	AddedColumns   []string
+	RemovedColumns []string
+	View           *View
+
+	Added   int
+	Removed int
+	Changed int
+}
+
+func (r *DiffResult) Count() int {
+	return r.Added + r.Removed + r.Changed + len(r.AddedColumns) + len(r.RemovedColumns)
+}
+
+func (r *DiffResult) Summary() string {
+	s := fmt.Sprintf("%d added, %d removed, %d changed", r.Added, r.Removed, r.Changed)
+	if 0 < len(r.AddedColumns) {
+		s = s + ", " + FormatCount(len(r.AddedColumns), "column") + " added"
+	}
+	if 0 < len(r.RemovedColumns) {
+		s = s + ", " + FormatCount(len(r.RemovedColumns), "column") + " removed"
+	}
+	return s
+}
+
+func DiffTable(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction) (*View, error) {
+	if len(expr.Args) < 3 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(expr, expr.Name, "at least "+FormatCount(3, "argument"))
+	}
+
+	table1, err := evalTableFunctionTableArgument(ctx, scope, expr, expr.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	table2, err := evalTableFunctionTableArgument(ctx, scope, expr, expr.Args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(expr.Args)-2)
+	for _, arg := range expr.Args[2:] {
+		key, err := evalTableFunctionColumnArgument(ctx, scope, expr, arg)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	result, err := Diff(ctx, scope, expr, table1, table2, keys)
+	if err != nil {
+		return nil, err
+	}
+	return result.View, nil
+}
+
+// Diff compares the records of two tables that have the same values in the key columns.
+//
+// Records are matched only if the key values are exactly the same in the same manner as DiffValuesEqual.
+// A record is changed if any value of the columns that exist in both tables is not exactly the same.
+func Diff(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, table1 parser.QueryExpression, table2 parser.QueryExpression, keys []string) (*DiffResult, error) {
+	funcName := "DIFF"
+	if fn, ok := expr.(parser.TableFunction); ok {
+		funcName = fn.Name
+	}
+
+	if len(keys) < 1 {
+		return nil, NewFunctionInvalidArgumentError(expr, funcName, "key columns must be specified")
+	}
+
+	view1, err := loadDiffTable(ctx, scope, table1)
+	if err != nil {
+		return nil, err
+	}
+	view2, err := loadDiffTable(ctx, scope, table2)
+	if err != nil {
+		return nil, err
+	}
+
+	columns1 := view1.Header.TableColumnNames()
+	columns2 := view2.Header.TableColumnNames()
+
+	keys = append(make([]string, 0, len(keys)), keys...)
+	keyIndices1 := make([]int, len(keys))
+	keyIndices2 := make([]int, len(keys))
+	for i, key := range keys {
+		if keyIndices1[i] = diffColumnIndex(columns1, key); keyIndices1[i] < 0 {
+			return nil, NewFunctionInvalidArgumentError(expr, funcName, fmt.Sprintf("key column %s does not exist in %s", key, table1))
+		}
+		if keyIndices2[i] = diffColumnIndex(columns2, key); keyIndices2[i] < 0 {
+			return nil, NewFunctionInvalidArgumentError(expr, funcName, fmt.Sprintf("key column %s does not exist in %s", key, table2))
+		}
+		keys[i] = columns1[keyIndices1[i]]
+	}
+
+	isKey := func(idx int, keyIndices []int) bool {
+		for _, k := range keyIndices {
+			if k == idx {
+				return true
+			}
+		}
+		return false
+	}
+
+	var addedColumns []string
+	var removedColumns []string
+	columns := make([]string, 0, len(columns1)+len(columns2))
+	indices1 := make([]int, 0, len(columns1)+len(columns2))
+	indices2 := make([]int, 0, len(columns1)+len(columns2))
+	for i, c := range columns1 {
+		if isKey(i, keyIndices1) {
+			continue
+		}
+		idx2 := diffColumnIndex(columns2, c)
+		if idx2 < 0 {
+			removedColumns = append(removedColumns, c)
+		}
+		columns = append(columns, c)
+		indices1 = append(indices1, i)
+		indices2 = append(indices2, idx2)
+	}
+	for i, c := range columns2 {
+		if isKey(i, keyIndices2) || -1 < diffColumnIndex(columns1, c) {
+			continue
+		}
+		addedColumns = append(addedColumns, c)
+		columns = append(columns, c)
+		indices1 = append(indices1, -1)
+		indices2 = append(indices2, i)
+	}
+
+	buf := GetComparisonKeysBuf()
+	defer PutComparisonkeysBuf(buf)
+
+	serializeKey := func(record Record, keyIndices []int) string {
+		buf.Reset()
+		for i, idx := range keyIndices {
+			if 0 < i {
+				buf.WriteByte(58)
+			}
+			serializeDiffKey(buf, record[idx][0])
+		}
+		return buf.String()
+	}
+
+	keyMap2 := make(map[string]int, view2.RecordLen())
+	for i := range view2.RecordSet {
+		if i&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		k := serializeKey(view2.RecordSet[i], keyIndices2)
+		if _, ok := keyMap2[k]; ok {
+			return nil, NewFunctionInvalidArgumentError(expr, funcName, fmt.Sprintf("key (%s) is duplicated at record %d in %s", strings.Join(keys, ", "), i+1, table2))
+		}
+		keyMap2[k] = i
+	}
+
+	header := make([]string, 0, 1+len(keys)+len(columns)*2)
+	header = append(header, DiffColumn)
+	header = append(header, keys...)
+	for _, c := range columns {
+		header = append(header, c+DiffOldSuffix, c+DiffNewSuffix)
+	}
+
+	result := &DiffResult{
+		Keys:           keys,
+		Columns:        columns,
+		AddedColumns:   addedColumns,
+		RemovedColumns: removedColumns,
+		View: &View{
+			Header:    NewHeader("", header),
+			RecordSet: make(RecordSet, 0, 16),
+		},
+	}
+
+	cellValue := func(record Record, idx int) value.Primary {
+		if idx < 0 || record == nil {
+			return value.NewNull()
+		}
+		return record[idx][0]
+	}
+
+	newRecord := func(status string, keyRecord Record, keyIndices []int, record1 Record, record2 Record) Record {
+		values := make([]value.Primary, 0, len(header))
+		values = append(values, value.NewString(status))
+		for _, idx := range keyIndices {
+			values = append(values, keyRecord[idx][0])
+		}
+		for i := range columns {
+			values = append(values, cellValue(record1, indices1[i]), cellValue(record2, indices2[i]))
+		}
+		return NewRecord(values)
+	}
+
+	keyMap1 := make(map[string]bool, view1.RecordLen())
+	for i, record1 := range view1.RecordSet {
+		if i&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		k := serializeKey(record1, keyIndices1)
+		if _, ok := keyMap1[k]; ok {
+			return nil, NewFunctionInvalidArgumentError(expr, funcName, fmt.Sprintf("key (%s) is duplicated at record %d in %s", strings.Join(keys, ", "), i+1, table1))
+		}
+		keyMap1[k] = true
+
+		idx2, ok := keyMap2[k]
+		if !ok {
+			result.View.RecordSet = append(result.View.RecordSet, newRecord(DiffRemoved, record1, keyIndices1, record1, nil))
+			result.Removed++
+			continue
+		}
+
+		record2 := view2.RecordSet[idx2]
+		for j := range columns {
+			if indices1[j] < 0 || indices2[j] < 0 {
+				continue
+			}
+			if !DiffValuesEqual(cellValue(record1, indices1[j]), cellValue(record2, indices2[j])) {
+				result.View.RecordSet = append(result.View.RecordSet, newRecord(DiffChanged, record1, keyIndices1, record1, record2))
+				result.Changed++
+				break
+			}
+		}
+	}
+
+	for i, record2 := range view2.RecordSet {
+		if i&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		if _, ok := keyMap1[serializeKey(record2, keyIndices2)]; !ok {
+			result.View.RecordSet = append(result.View.RecordSet, newRecord(DiffAdded, record2, keyIndices2, nil, record2))
+			result.Added++
+		}
+	}
+
+	return result, nil
+}
+
+func loadDiffTable(ctx context.Context, scope *ReferenceScope, table parser.QueryExpression) (*View, error) {
+	tableScope := scope.CreateNode()
+	defer tableScope.CloseCurrentNode()
+
+	return LoadViewFromTableIdentifier(ctx, tableScope, table, false, false)
+}
+
+func diffColumnIndex(columns []string, column string) int {
+	for i := range columns {
+		if strings.EqualFold(columns[i], column) {
+			return i
+		}
+	}
+	return -1
+}
+
+// DiffValuesEqual reports whether two values are exactly the same in comparisons of tables.
+//
+// Unlike comparisons in queries, values of different types are not equal, and strings are compared
+// without case folding, trimming or conversion to numbers, so that any change in the cell contents is detected.
+// Nulls are equal to each other.
+func DiffValuesEqual(v1 value.Primary, v2 value.Primary) bool {
+	if value.IsNull(v1) || value.IsNull(v2) {
+		return value.IsNull(v1) && value.IsNull(v2)
+	}
+
+	switch v1.(type) {
+	case *value.String:
+		s2, ok := v2.(*value.String)
+		return ok && v1.(*value.String).Raw() == s2.Raw()
+	case *value.Integer:
+		i2, ok := v2.(*value.Integer)
+		return ok && v1.(*value.Integer).Raw() == i2.Raw()
+	case *value.Float:
+		f2, ok := v2.(*value.Float)
+		return ok && v1.(*value.Float).Raw() == f2.Raw()
+	case *value.Boolean:
+		b2, ok := v2.(*value.Boolean)
+		return ok && v1.(*value.Boolean).Raw() == b2.Raw()
+	case *value.Ternary:
+		t2, ok := v2.(*value.Ternary)
+		return ok && v1.(*value.Ternary).Ternary() == t2.Ternary()
+	case *value.Datetime:
+		dt2, ok := v2.(*value.Datetime)
+		return ok && v1.(*value.Datetime).Raw().Format(time.RFC3339Nano) == dt2.Raw().Format(time.RFC3339Nano)
+	}
+	return false
+}
+
+// serializeDiffKey writes a key value so that serialized keys are the same only if DiffValuesEqual reports
+// that the values are equal.
+func serializeDiffKey(buf *bytes.Buffer, val value.Primary) {
+	if value.IsNull(val) {
+		serializeNull(buf)
+		return
+	}
+
+	switch val.(type) {
+	case *value.String:
+		s := val.(*value.String).Raw()
+		buf.Write([]byte{91, 83, 93})
+		buf.WriteString(strconv.Itoa(len(s)))
+		buf.WriteByte(44)
+		buf.WriteString(s)
+	case *value.Integer:
+		buf.Write([]byte{91, 73, 93})
+		buf.WriteString(strconv.FormatInt(val.(*value.Integer).Raw(), 10))
+	case *value.Float:
+		buf.Write([]byte{91, 70, 93})
+		if f := val.(*value.Float).Raw(); f == 0 {
+			buf.WriteByte(48)
+		} else {
+			buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case *value.Boolean:
+		buf.Write([]byte{91, 66, 93})
+		buf.WriteString(strconv.FormatBool(val.(*value.Boolean).Raw()))
+	case *value.Ternary:
+		buf.Write([]byte{91, 84, 93})
+		buf.WriteString(val.(*value.Ternary).Ternary().String())
+	case *value.Datetime:
+		buf.Write([]byte{91, 68, 93})
+		buf.WriteString(val.(*value.Datetime).Raw().Format(time.RFC3339Nano))
+	default:
+		serializeNull(buf)
+	}
+}
+
+// WriteDiffText writes the result of a comparison of tables in a readable form.
+// Added, removed and changed records are highlighted with the notice, error and warn effects of the palette.
+func WriteDiffText(ctx context.Context, w io.Writer, result *DiffResult, flags *cmd.Flags, palette *color.Palette) error {
+	lineBreak := flags.ExportOptions.LineBreak.Value()
+	keyLen := len(result.Keys)
+
+	var buf bytes.Buffer
+	for _, c := range result.RemovedColumns {
+		buf.WriteString(palette.Render(cmd.ErrorEffect, "- column "+c))
+		buf.WriteString(lineBreak)
+	}
+	for _, c := range result.AddedColumns {
+		buf.WriteString(palette.Render(cmd.NoticeEffect, "+ column "+c))
+		buf.WriteString(lineBreak)
+	}
+	if 0 < buf.Len() {
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	for i, record := range result.View.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		buf.Reset()
+
+		status := record[0][0].(*value.String).Raw()
+		var mark string
+		var effect string
+		switch status {
+		case DiffAdded:
+			mark, effect = "+", cmd.NoticeEffect
+		case DiffRemoved:
+			mark, effect = "-", cmd.ErrorEffect
+		default:
+			mark, effect = "~", cmd.WarnEffect
+		}
+
+		keys := make([]string, keyLen)
+		for j := 0; j < keyLen; j++ {
+			keys[j] = result.Keys[j] + ": " + diffText(record[j+1][0])
+		}
+		buf.WriteString(palette.Render(effect, mark+" "+strings.Join(keys, ", ")))
+		buf.WriteString(lineBreak)
+
+		if status == DiffChanged {
+			for j, c := range result.Columns {
+				if -1 < diffColumnIndex(result.AddedColumns, c) || -1 < diffColumnIndex(result.RemovedColumns, c) {
+					continue
+				}
+				oldValue := record[1+keyLen+j*2][0]
+				newValue := record[2+keyLen+j*2][0]
+				if DiffValuesEqual(oldValue, newValue) {
+					continue
+				}
+				buf.WriteString("    ")
+				buf.WriteString(palette.Render(cmd.LableEffect, c+":"))
+				buf.WriteString(" ")
+				buf.WriteString(palette.Render(cmd.ErrorEffect, diffText(oldValue)))
+				buf.WriteString(" -> ")
+				buf.WriteString(palette.Render(cmd.NoticeEffect, diffText(newValue)))
+				buf.WriteString(lineBreak)
+			}
+		}
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	return nil
+}
+
+func diffText(p value.Primary) string {
+	if _, ok := p.(*value.String); ok {
+		return cmd.QuoteString(p.(*value.String).Raw())
+	}
+	s, _, _ := ConvertFieldContents(p, true)
+	return s
+}
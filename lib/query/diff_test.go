@@ -0,0 +1,236 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var diffTests = []struct {
+	Name   string
+	Table1 parser.QueryExpression
+	Table2 parser.QueryExpression
+	Keys   []string
+	Result *DiffResult
+	Error  string
+}{
+	{
+		Name:   "Diff",
+		Table1: parser.Identifier{Literal: "table4"},
+		Table2: parser.Identifier{Literal: "table2"},
+		Keys:   []string{"COLUMN3"},
+		Result: &DiffResult{
+			Keys:    []string{"column3"},
+			Columns: []string{"column4"},
+			View: &View{
+				Header: NewHeader("", []string{"diff", "column3", "column4_old", "column4_new"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{value.NewString("changed"), value.NewString("2"), value.NewString("str2"), value.NewString("str22")}),
+					NewRecord([]value.Primary{value.NewString("changed"), value.NewString("3"), value.NewString("str3"), value.NewString("str33")}),
+					NewRecord([]value.Primary{value.NewString("changed"), value.NewString("4"), value.NewString("str4"), value.NewString("str44")}),
+				},
+			},
+			Changed: 3,
+		},
+	},
+	{
+		Name:   "Diff Added and Removed Records",
+		Table1: parser.Identifier{Literal: "table1"},
+		Table2: parser.Identifier{Literal: "table1b"},
+		Keys:   []string{"column1"},
+		Result: &DiffResult{
+			Keys:           []string{"column1"},
+			Columns:        []string{"column2", "column2b"},
+			AddedColumns:   []string{"column2b"},
+			RemovedColumns: []string{"column2"},
+			View: &View{
+				Header: NewHeader("", []string{"diff", "column1", "column2_old", "column2_new", "column2b_old", "column2b_new"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{value.NewString("removed"), value.NewString("1"), value.NewString("str1"), value.NewNull(), value.NewNull(), value.NewNull()}),
+					NewRecord([]value.Primary{value.NewString("added"), value.NewString("4"), value.NewNull(), value.NewNull(), value.NewNull(), value.NewString("str4b")}),
+				},
+			},
+			Added:   1,
+			Removed: 1,
+		},
+	},
+	{
+		Name:   "Diff Exact Values",
+		Table1: parser.Identifier{Literal: "table_diff_a"},
+		Table2: parser.Identifier{Literal: "table_diff_b"},
+		Keys:   []string{"id"},
+		Result: &DiffResult{
+			Keys:           []string{"id"},
+			Columns:        []string{"name", "amount", "note", "extra"},
+			AddedColumns:   []string{"extra"},
+			RemovedColumns: []string{"note"},
+			View: &View{
+				Header: NewHeader("", []string{"diff", "id", "name_old", "name_new", "amount_old", "amount_new", "note_old", "note_new", "extra_old", "extra_new"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{value.NewString("changed"), value.NewString("1"), value.NewString("b"), value.NewString("B"), value.NewString("1"), value.NewString("1"), value.NewString("n1"), value.NewNull(), value.NewNull(), value.NewString("e1")}),
+					NewRecord([]value.Primary{value.NewString("changed"), value.NewString("2"), value.NewString("c"), value.NewString("c"), value.NewString("2"), value.NewString("2.0"), value.NewString("n2"), value.NewNull(), value.NewNull(), value.NewString("e2")}),
+					NewRecord([]value.Primary{value.NewString("changed"), value.NewString("3"), value.NewString("d"), value.NewString("d"), value.NewString("3"), value.NewString(" 3"), value.NewString("n3"), value.NewNull(), value.NewNull(), value.NewString("e3")}),
+				},
+			},
+			Changed: 3,
+		},
+	},
+	{
+		Name:   "Diff Exact Keys",
+		Table1: parser.Identifier{Literal: "table_diff_key_a"},
+		Table2: parser.Identifier{Literal: "table_diff_key_b"},
+		Keys:   []string{"id"},
+		Result: &DiffResult{
+			Keys:    []string{"id"},
+			Columns: []string{"name"},
+			View: &View{
+				Header: NewHeader("", []string{"diff", "id", "name_old", "name_new"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{value.NewString("removed"), value.NewString("01"), value.NewString("a"), value.NewNull()}),
+					NewRecord([]value.Primary{value.NewString("removed"), value.NewString("x"), value.NewString("b"), value.NewNull()}),
+					NewRecord([]value.Primary{value.NewString("added"), value.NewString("1"), value.NewNull(), value.NewString("a")}),
+					NewRecord([]value.Primary{value.NewString("added"), value.NewString("X"), value.NewNull(), value.NewString("b")}),
+				},
+			},
+			Added:   2,
+			Removed: 2,
+		},
+	},
+	{
+		Name:   "Diff Same Tables",
+		Table1: parser.Identifier{Literal: "table1"},
+		Table2: parser.Identifier{Literal: "table1.csv"},
+		Keys:   []string{"column1"},
+		Result: &DiffResult{
+			Keys:    []string{"column1"},
+			Columns: []string{"column2"},
+			View: &View{
+				Header:    NewHeader("", []string{"diff", "column1", "column2_old", "column2_new"}),
+				RecordSet: RecordSet{},
+			},
+		},
+	},
+	{
+		Name:   "Diff Key Not Exist Error",
+		Table1: parser.Identifier{Literal: "table1"},
+		Table2: parser.Identifier{Literal: "table2"},
+		Keys:   []string{"column1"},
+		Error:  "key column column1 does not exist in table2 for function DIFF",
+	},
+	{
+		Name:   "Diff Duplicate Key Error",
+		Table1: parser.Identifier{Literal: "group_table"},
+		Table2: parser.Identifier{Literal: "table1"},
+		Keys:   []string{"column1"},
+		Error:  "key (column1) is duplicated at record 2 in group_table for function DIFF",
+	},
+	{
+		Name:   "Diff File Not Exist Error",
+		Table1: parser.Identifier{Literal: "notexist"},
+		Table2: parser.Identifier{Literal: "table1"},
+		Keys:   []string{"column1"},
+		Error:  "file notexist does not exist",
+	},
+}
+
+func TestDiff(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range diffTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+		keys := append([]string{}, v.Keys...)
+		result, err := Diff(ctx, NewReferenceScope(TestTx), parser.Identifier{Literal: "DIFF"}, v.Table1, v.Table2, keys)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(keys, v.Keys) {
+			t.Errorf("%s: keys = %v, want to keep %v", v.Name, keys, v.Keys)
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var diffValuesEqualTests = []struct {
+	Value1 value.Primary
+	Value2 value.Primary
+	Result bool
+}{
+	{Value1: value.NewString("abc"), Value2: value.NewString("abc"), Result: true},
+	{Value1: value.NewString("b"), Value2: value.NewString("B"), Result: false},
+	{Value1: value.NewString("2"), Value2: value.NewString(" 2"), Result: false},
+	{Value1: value.NewString("1"), Value2: value.NewString("1.0"), Result: false},
+	{Value1: value.NewString("1"), Value2: value.NewInteger(1), Result: false},
+	{Value1: value.NewInteger(1), Value2: value.NewInteger(1), Result: true},
+	{Value1: value.NewInteger(1), Value2: value.NewFloat(1), Result: false},
+	{Value1: value.NewNull(), Value2: value.NewNull(), Result: true},
+	{Value1: value.NewNull(), Value2: value.NewString(""), Result: false},
+}
+
+func TestDiffValuesEqual(t *testing.T) {
+	for _, v := range diffValuesEqualTests {
+		result := DiffValuesEqual(v.Value1, v.Value2)
+		if result != v.Result {
+			t.Errorf("result = %t, want %t for %s and %s", result, v.Result, v.Value1, v.Value2)
+		}
+	}
+}
+
+func TestWriteDiffText(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	result, err := Diff(ctx, NewReferenceScope(TestTx), parser.Identifier{Literal: "DIFF"}, parser.Identifier{Literal: "table_diff_a"}, parser.Identifier{Literal: "table_diff_b"}, []string{"id"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	result.View.RecordSet = append(result.View.RecordSet,
+		NewRecord([]value.Primary{value.NewString("removed"), value.NewString("5"), value.NewString("f"), value.NewNull(), value.NewString("5"), value.NewNull(), value.NewString("n5"), value.NewNull(), value.NewNull(), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewString("added"), value.NewString("6"), value.NewNull(), value.NewString("g"), value.NewNull(), value.NewString("6"), value.NewNull(), value.NewNull(), value.NewNull(), value.NewString("e6")}),
+	)
+
+	expect := "- column note\n" +
+		"+ column extra\n" +
+		"~ id: '1'\n" +
+		"    name: 'b' -> 'B'\n" +
+		"~ id: '2'\n" +
+		"    amount: '2' -> '2.0'\n" +
+		"~ id: '3'\n" +
+		"    amount: '3' -> ' 3'\n" +
+		"- id: '5'\n" +
+		"+ id: '6'\n"
+
+	buf := new(bytes.Buffer)
+	if err := WriteDiffText(ctx, buf, result, TestTx.Flags, TestTx.Palette); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if buf.String() != expect {
+		t.Errorf("output = %q, want %q", buf.String(), expect)
+	}
+}
@@ -5,13 +5,21 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/html"
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/sql"
+	"github.com/mithrandie/csvq/lib/texttable"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xml"
+	"github.com/mithrandie/csvq/lib/yaml"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
@@ -34,6 +42,14 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		return "", encodeJson(ctx, fp, view, options, palette)
 	case cmd.LTSV:
 		return "", encodeLTSV(ctx, fp, view, options)
+	case cmd.XML:
+		return "", encodeXML(ctx, fp, view, options)
+	case cmd.YAML:
+		return "", encodeYAML(ctx, fp, view, options)
+	case cmd.HTML:
+		return "", encodeHTML(ctx, fp, view, options)
+	case cmd.SQL:
+		return "", encodeSQL(ctx, fp, view, options)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
 		return encodeText(ctx, fp, view, options, palette)
 	case cmd.TSV:
@@ -45,12 +61,29 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 }
 
 func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
-	w, err := csv.NewWriter(fp, options.LineBreak, options.Encoding)
-	if err != nil {
-		return NewDataEncodingError(err.Error())
+	fp, options.Encoding = charset.ConvertWriter(fp, options.Encoding)
+
+	var w csvRecordWriter
+	var err error
+	if (options.Quote != 0 && options.Quote != '"') || options.QuoteEscape != cmd.QuoteEscapeDouble {
+		cw, e := newCSVWriter(fp, options.LineBreak, options.Encoding)
+		if e != nil {
+			return NewDataEncodingError(e.Error())
+		}
+		cw.Delimiter = options.Delimiter
+		cw.Quote = options.Quote
+		cw.QuoteEscape = options.QuoteEscape
+		w = cw
+	} else {
+		cw, e := csv.NewWriter(fp, options.LineBreak, options.Encoding)
+		if e != nil {
+			return NewDataEncodingError(e.Error())
+		}
+		cw.Delimiter = options.Delimiter
+		w = cw
 	}
-	w.Delimiter = options.Delimiter
 
+	locale, _ := cmd.ParseLocale(options.Locale)
 	fields := make([]csv.Field, view.FieldLen())
 
 	if !options.WithoutHeader {
@@ -71,7 +104,7 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 		}
 
 		for j := range view.RecordSet[i] {
-			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
+			str, effect, _ := convertCellContentsInLocale(view.RecordSet[i][j], locale)
 			quote := false
 			if options.EncloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
 				quote = true
@@ -89,6 +122,12 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 }
 
 func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if charset.IsExtended(options.Encoding) {
+		return NewDataEncodingError(fmt.Sprintf("fixed-length format does not support %s", charset.String(options.Encoding)))
+	}
+
+	locale, _ := cmd.ParseLocale(options.Locale)
+
 	if options.DelimiterPositions == nil {
 		m := fixedlen.NewMeasure()
 		m.Encoding = options.Encoding
@@ -121,7 +160,7 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 
 			fields := make([]fixedlen.Field, fieldLen)
 			for j := range view.RecordSet[i] {
-				str, _, a := ConvertFieldContents(view.RecordSet[i][j][0], false)
+				str, _, a := convertCellContentsInLocale(view.RecordSet[i][j], locale)
 				fields[j] = fixedlen.NewField(str, a)
 			}
 			fieldList[i+recordStartPos] = fields
@@ -175,7 +214,7 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 			}
 
 			for j := range view.RecordSet[i] {
-				str, _, a := ConvertFieldContents(view.RecordSet[i][j][0], false)
+				str, _, a := convertCellContentsInLocale(view.RecordSet[i][j], locale)
 				fields[j] = fixedlen.NewField(str, a)
 			}
 			if err := w.Write(fields); err != nil {
@@ -240,6 +279,8 @@ func encodeJson(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 }
 
 func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) (string, error) {
+	fp, options.Encoding = charset.ConvertWriter(fp, options.Encoding)
+
 	isPlainTable := false
 
 	var tableFormat = table.PlainTable
@@ -258,27 +299,20 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		isPlainTable = true
 	}
 
-	e := table.NewEncoder(tableFormat, view.RecordLen())
-	e.LineBreak = options.LineBreak
-	e.EastAsianEncoding = options.EastAsianEncoding
-	e.CountDiacriticalSign = options.CountDiacriticalSign
-	e.CountFormatCode = options.CountFormatCode
-	e.WithoutHeader = options.WithoutHeader
-	e.Encoding = options.Encoding
-
 	fieldLen := view.FieldLen()
 
+	var hfields []table.Field
 	if !options.WithoutHeader {
-		hfields := make([]table.Field, fieldLen)
+		hfields = make([]table.Field, fieldLen)
 		for i := range view.Header {
 			hfields[i] = table.NewField(view.Header[i].Column, text.Centering)
 		}
-		e.SetHeader(hfields)
 	} else if view.RecordLen() < 1 {
 		return "", DataEmpty
 	}
 
 	aligns := make([]text.FieldAlignment, fieldLen)
+	recordSet := make([][]table.Field, 0, view.RecordLen())
 
 	var textStrBuf bytes.Buffer
 	var textLineBuf bytes.Buffer
@@ -331,14 +365,34 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 				aligns[j] = align
 			}
 		}
-		e.AppendRecord(rfields)
+		recordSet = append(recordSet, rfields)
 	}
 
-	if options.Format == cmd.GFM {
-		e.SetFieldAlignments(aligns)
-	}
+	var s string
+	var err error
+	if isPlainTable {
+		s, err = encodePlainTable(hfields, recordSet, options)
+	} else {
+		e := table.NewEncoder(tableFormat, view.RecordLen())
+		e.LineBreak = options.LineBreak
+		e.EastAsianEncoding = options.EastAsianEncoding
+		e.CountDiacriticalSign = options.CountDiacriticalSign
+		e.CountFormatCode = options.CountFormatCode
+		e.WithoutHeader = options.WithoutHeader
+		e.Encoding = options.Encoding
+
+		if hfields != nil {
+			e.SetHeader(hfields)
+		}
+		for i := range recordSet {
+			e.AppendRecord(recordSet[i])
+		}
+		if options.Format == cmd.GFM {
+			e.SetFieldAlignments(aligns)
+		}
 
-	s, err := e.Encode()
+		s, err = e.Encode()
+	}
 	if err != nil {
 		return "", NewDataEncodingError(err.Error())
 	}
@@ -352,6 +406,37 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	return "", nil
 }
 
+func encodePlainTable(header []table.Field, recordSet [][]table.Field, options cmd.ExportOptions) (string, error) {
+	e := texttable.NewEncoder(options.BorderStyle, len(recordSet))
+	e.LineBreak = options.LineBreak
+	e.EastAsianEncoding = options.EastAsianEncoding
+	e.CountDiacriticalSign = options.CountDiacriticalSign
+	e.CountFormatCode = options.CountFormatCode
+	e.WithoutHeader = options.WithoutHeader
+	e.Encoding = options.Encoding
+
+	switch options.Expanded {
+	case cmd.ExpandedOn:
+		e.Expanded = true
+	case cmd.ExpandedAuto:
+		e.MaxWidth = options.ScreenWidth
+	}
+
+	e.SetHeader(convertToTextTableFields(header))
+	for i := range recordSet {
+		e.AppendRecord(convertToTextTableFields(recordSet[i]))
+	}
+	return e.Encode()
+}
+
+func convertToTextTableFields(fields []table.Field) []texttable.Field {
+	tfields := make([]texttable.Field, len(fields))
+	for i := range fields {
+		tfields[i] = texttable.NewField(fields[i].Contents, fields[i].Alignment)
+	}
+	return tfields
+}
+
 func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
 	if view.RecordLen() < 1 {
 		return DataEmpty
@@ -362,11 +447,13 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		hfields[i] = view.Header[i].Column
 	}
 
+	fp, options.Encoding = charset.ConvertWriter(fp, options.Encoding)
 	w, err := ltsv.NewWriter(fp, hfields, options.LineBreak, options.Encoding)
 	if err != nil {
 		return NewDataEncodingError(err.Error())
 	}
 
+	locale, _ := cmd.ParseLocale(options.Locale)
 	fields := make([]string, view.FieldLen())
 	for i := range view.RecordSet {
 		if i&15 == 0 && ctx.Err() != nil {
@@ -374,7 +461,7 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		}
 
 		for j := range view.RecordSet[i] {
-			fields[j], _, _ = ConvertFieldContents(view.RecordSet[i][j][0], false)
+			fields[j], _, _ = convertCellContentsInLocale(view.RecordSet[i][j], locale)
 		}
 		if err := w.Write(fields); err != nil {
 			return NewDataEncodingError(err.Error())
@@ -386,6 +473,227 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	return nil
 }
 
+func encodeXML(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	w, err := xml.NewWriter(fp, view.Header.TableColumnNames(), options.XmlRootElement, options.XmlRowElement, options.LineBreak, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	w.PrettyPrint = options.PrettyPrint
+
+	fields := make([]xml.Field, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			if value.IsNull(view.RecordSet[i][j][0]) {
+				fields[j] = xml.NewNullField()
+			} else {
+				str, _, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
+				fields[j] = xml.NewField(str)
+			}
+		}
+		if err := w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeYAML(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	header := view.Header.TableColumnNames()
+	records := make([][]value.Primary, view.RecordLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		row := make([]value.Primary, view.FieldLen())
+		for j := range view.RecordSet[i] {
+			row[j] = view.RecordSet[i][j][0]
+		}
+		records[i] = row
+	}
+
+	data, err := json.ConvertTableValueToJsonStructure(ctx, header, records)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+		return NewDataEncodingError(err.Error())
+	}
+
+	s, err := yaml.Encode(data, options.LineBreak)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	return writeEncodedString(fp, s, options.Encoding)
+}
+
+func encodeHTML(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	var header []string
+	if !options.WithoutHeader {
+		header = make([]string, view.FieldLen())
+		for i := range view.Header {
+			header[i] = view.Header[i].Column
+		}
+	} else if view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	w, err := html.NewWriter(fp, header, options.LineBreak, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+
+	fields := make([]html.Field, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			str, effect, align := ConvertFieldContents(view.RecordSet[i][j][0], true)
+			fields[j] = html.NewField(str, effect, align)
+		}
+		if err := w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeSQL(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if options.WithoutHeader && view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	columns := make([]string, view.FieldLen())
+	for i := range view.Header {
+		columns[i] = view.Header[i].Column
+	}
+
+	records := make([][]sql.Field, view.RecordLen())
+	types := make([]sql.FieldType, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		records[i] = make([]sql.Field, view.FieldLen())
+		for j := range view.RecordSet[i] {
+			records[i][j] = convertToSQLField(view.RecordSet[i][j][0])
+			types[j] = sql.MergeType(types[j], records[i][j].Type)
+		}
+	}
+
+	w, err := sql.NewWriter(fp, options.SqlDialect, sqlTableName(view, options), columns, options.LineBreak, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+
+	if !options.WithoutHeader {
+		for i := range types {
+			if types[i] == sql.NullType {
+				types[i] = sql.StringType
+			}
+		}
+		if err = w.WriteCreateTable(types); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	for i := range records {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		if err = w.Write(records[i]); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func sqlTableName(view *View, options cmd.ExportOptions) string {
+	if 0 < len(options.SqlTable) {
+		return options.SqlTable
+	}
+
+	name := ""
+	for i := range view.Header {
+		if len(view.Header[i].View) < 1 || (0 < i && view.Header[i].View != name) {
+			return "results"
+		}
+		name = view.Header[i].View
+	}
+	if len(name) < 1 {
+		return "results"
+	}
+	return name
+}
+
+func convertToSQLField(val value.Primary) sql.Field {
+	switch val.(type) {
+	case *value.String:
+		return sql.NewStringField(val.(*value.String).Raw())
+	case *value.Integer:
+		return sql.NewIntegerField(val.(*value.Integer).Raw())
+	case *value.Float:
+		return sql.NewFloatField(val.(*value.Float).Raw())
+	case *value.Boolean:
+		return sql.NewBooleanField(val.(*value.Boolean).Raw())
+	case *value.Ternary:
+		if t := val.(*value.Ternary).Ternary(); t != ternary.UNKNOWN {
+			return sql.NewBooleanField(t.ParseBool())
+		}
+		return sql.NewNullField()
+	case *value.Datetime:
+		return sql.NewDatetimeField(val.(*value.Datetime).Raw())
+	default:
+		return sql.NewNullField()
+	}
+}
+
+// EncodeHTMLDocumentHeader writes the beginning of an HTML document that contains result sets in HTML format.
+func EncodeHTMLDocumentHeader(fp io.Writer, title string, options cmd.ExportOptions, palette *color.Palette) error {
+	var config color.PaletteConfig
+	if palette != nil {
+		config = palette.ExportConfig()
+	}
+	return writeEncodedString(fp, html.DocumentHeader(title, options.Encoding, config, options.LineBreak), options.Encoding)
+}
+
+// EncodeHTMLDocumentFooter writes the end of an HTML document that begins with EncodeHTMLDocumentHeader.
+func EncodeHTMLDocumentFooter(fp io.Writer, options cmd.ExportOptions) error {
+	return writeEncodedString(fp, html.DocumentFooter(options.LineBreak), options.Encoding)
+}
+
+func writeEncodedString(fp io.Writer, s string, enc text.Encoding) error {
+	tw, err := charset.GetTransformWriter(fp, enc)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+	if _, err = w.WriteString(s); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
 func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string, text.FieldAlignment) {
 	var s string
 	var effect = cmd.NoEffect
@@ -431,3 +739,52 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 
 	return s, effect, align
 }
+
+// convertFieldContentsInLocale converts a value to a string in the same way as ConvertFieldContents,
+// and writes floats and datetimes in the locale if it is not nil.
+func convertFieldContentsInLocale(val value.Primary, locale *cmd.Locale) (string, string, text.FieldAlignment) {
+	s, effect, align := ConvertFieldContents(val, false)
+	if locale == nil {
+		return s, effect, align
+	}
+
+	switch val.(type) {
+	case *value.Float:
+		if locale.DecimalPoint != '.' {
+			s = strings.Replace(s, ".", string(locale.DecimalPoint), 1)
+		}
+	case *value.Datetime:
+		s = formatDatetimeInLocale(val.(*value.Datetime).Raw(), locale)
+	}
+	return s, effect, align
+}
+
+// convertCellContentsInLocale converts the value of a cell in the same way as convertFieldContentsInLocale,
+// but returns the original text of the field written in the same locale for the cell that has not been replaced
+// since it was converted in loading, so that writing back a file does not change the fields that are not updated.
+func convertCellContentsInLocale(cell Cell, locale *cmd.Locale) (string, string, text.FieldAlignment) {
+	s, effect, align := convertFieldContentsInLocale(cell[0], locale)
+	if locale != nil {
+		if original, ok := cell.localeText(locale.Name); ok {
+			s = original
+		}
+	}
+	return s, effect, align
+}
+
+func formatDatetimeInLocale(t time.Time, locale *cmd.Locale) string {
+	var layout string
+	switch locale.DateOrder {
+	case cmd.DateOrderDMY:
+		layout = "02" + string(locale.DateSeparator) + "01" + string(locale.DateSeparator) + "2006"
+	case cmd.DateOrderMDY:
+		layout = "01" + string(locale.DateSeparator) + "02" + string(locale.DateSeparator) + "2006"
+	default:
+		layout = "2006" + string(locale.DateSeparator) + "01" + string(locale.DateSeparator) + "02"
+	}
+
+	if t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 || t.Nanosecond() != 0 {
+		layout = layout + " 15:04:05.999999999"
+	}
+	return t.Format(layout)
+}
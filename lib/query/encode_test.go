@@ -3,9 +3,16 @@ package query
 import (
 	"bytes"
 	"context"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/sql"
+	"github.com/mithrandie/csvq/lib/texttable"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
@@ -23,10 +30,20 @@ var encodeViewTests = []struct {
 	WriteDelimiter          rune
 	WriteDelimiterPositions []int
 	WriteAsSingleLine       bool
+	WriteQuote              rune
+	WriteQuoteEscape        cmd.QuoteEscape
+	Locale                  string
 	WithoutHeader           bool
 	EncloseAll              bool
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
+	XmlRootElement          string
+	XmlRowElement           string
+	SqlTable                string
+	SqlDialect              sql.Dialect
+	BorderStyle             texttable.BorderStyle
+	Expanded                cmd.ExpandedDisplay
+	ScreenWidth             int
 	UseColor                bool
 	Result                  string
 	Error                   string
@@ -93,6 +110,142 @@ var encodeViewTests = []struct {
 			"|        | \033[32mghijkl\033[0m |\n" +
 			"+--------+--------+",
 	},
+	{
+		Name: "Text Single Line Border",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abcde")}),
+			},
+		},
+		Format:      cmd.TEXT,
+		BorderStyle: texttable.SingleLine,
+		Result: "" +
+			"┌────┬────────┐\n" +
+			"│ c1 │   c2   │\n" +
+			"├────┼────────┤\n" +
+			"│ -1 │ abcde  │\n" +
+			"└────┴────────┘",
+	},
+	{
+		Name: "Text Expanded",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abc\ndef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull()}),
+			},
+		},
+		Format:   cmd.TEXT,
+		Expanded: cmd.ExpandedOn,
+		Result: "" +
+			"-[ RECORD 1 ]---\n" +
+			"c1      | -1\n" +
+			"column2 | abc\n" +
+			"        | def\n" +
+			"-[ RECORD 2 ]---\n" +
+			"c1      | 2.0123\n" +
+			"column2 | NULL",
+	},
+	{
+		Name: "Text Expanded Automatically",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abcdefghij")}),
+			},
+		},
+		Format:      cmd.TEXT,
+		BorderStyle: texttable.Minimal,
+		Expanded:    cmd.ExpandedAuto,
+		ScreenWidth: 10,
+		Result: "" +
+			"-[ RECORD 1 ]-\n" +
+			"c1  1\n" +
+			"c2  abcdefghij",
+	},
+	{
+		Name: "Text Not Expanded Automatically",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abcdefghij")}),
+			},
+		},
+		Format:      cmd.TEXT,
+		BorderStyle: texttable.Minimal,
+		Expanded:    cmd.ExpandedAuto,
+		ScreenWidth: 80,
+		Result: "" +
+			"c1      c2\n" +
+			"--  ----------\n" +
+			" 1  abcdefghij",
+	},
+	{
+		Name: "Text WithoutHeader",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abcde")}),
+			},
+		},
+		Format:        cmd.TEXT,
+		WithoutHeader: true,
+		Result: "" +
+			"+----+-------+\n" +
+			"| -1 | abcde |\n" +
+			"+----+-------+",
+	},
+	{
+		Name: "Text Single Line Border WithoutHeader",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abcde")}),
+			},
+		},
+		Format:        cmd.TEXT,
+		BorderStyle:   texttable.SingleLine,
+		WithoutHeader: true,
+		Result: "" +
+			"┌────┬───────┐\n" +
+			"│ -1 │ abcde │\n" +
+			"└────┴───────┘",
+	},
+	{
+		Name: "Text Minimal Border WithoutHeader",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abcdefghij")}),
+			},
+		},
+		Format:        cmd.TEXT,
+		BorderStyle:   texttable.Minimal,
+		WithoutHeader: true,
+		Result:        "1  abcdefghij",
+	},
+	{
+		Name: "Text Expanded WithoutHeader",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abc\ndef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull()}),
+			},
+		},
+		Format:        cmd.TEXT,
+		Expanded:      cmd.ExpandedOn,
+		WithoutHeader: true,
+		Result: "" +
+			"-[ RECORD 1 ]\n" +
+			"-1\n" +
+			"abc\n" +
+			"def\n" +
+			"-[ RECORD 2 ]\n" +
+			"2.0123\n" +
+			"NULL",
+	},
 	{
 		Name: "Fixed-Length Format",
 		View: &View{
@@ -281,6 +434,37 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\r\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Quotation Mark",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("a'b\"c")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("d,e")}),
+			},
+		},
+		Format:     cmd.CSV,
+		WriteQuote: '\'',
+		EncloseAll: true,
+		Result: "'c1','c2'\n" +
+			"-1,'a''b\"c'\n" +
+			"2,'d,e'",
+	},
+	{
+		Name: "CSV Backslash Escape",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("a\\b\"c")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("d\ne")}),
+			},
+		},
+		Format:           cmd.CSV,
+		WriteQuoteEscape: cmd.QuoteEscapeBackslash,
+		Result: "c1,c2\n" +
+			"-1,\"a\\\\b\\\"c\"\n" +
+			"2,\"d\ne\"",
+	},
 	{
 		Name: "JSON",
 		View: &View{
@@ -411,6 +595,183 @@ var encodeViewTests = []struct {
 		Format: cmd.LTSV,
 		Error:  "data empty",
 	},
+	{
+		Name: "XML",
+		View: &View{
+			Header: NewHeader("test", []string{"@id", "c1", "c2 name", "#text"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a<b&c"), value.NewNull(), value.NewString("text")}),
+				NewRecord([]value.Primary{value.NewNull(), value.NewBoolean(true), value.NewString("\"quoted\""), value.NewNull()}),
+			},
+		},
+		Format: cmd.XML,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<rows><row id=\"1\">text<c1>a&lt;b&amp;c</c1><c2_name/></row><row><c1>true</c1><c2_name>&#34;quoted&#34;</c2_name></row></rows>",
+	},
+	{
+		Name: "XML Pretty Print",
+		View: &View{
+			Header: NewHeader("test", []string{"@id", "c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("def")}),
+			},
+		},
+		Format:         cmd.XML,
+		WriteEncoding:  text.SJIS,
+		LineBreak:      text.CRLF,
+		PrettyPrint:    true,
+		XmlRootElement: "items",
+		XmlRowElement:  "item",
+		Result: "<?xml version=\"1.0\" encoding=\"Shift_JIS\"?>\r\n" +
+			"<items>\r\n" +
+			"  <item id=\"1\">\r\n" +
+			"    <c1>abc</c1>\r\n" +
+			"  </item>\r\n" +
+			"  <item id=\"2\">\r\n" +
+			"    <c1>def</c1>\r\n" +
+			"  </item>\r\n" +
+			"</items>",
+	},
+	{
+		Name: "XML EUC-JP",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("日本語")}),
+			},
+		},
+		Format:        cmd.XML,
+		WriteEncoding: charset.EUCJP,
+		Result: "<?xml version=\"1.0\" encoding=\"EUC-JP\"?>\n" +
+			"<rows><row><c1>" + string([]byte{0xc6, 0xfc, 0xcb, 0xdc, 0xb8, 0xec}) + "</c1></row></rows>",
+	},
+	{
+		Name: "XML Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.XML,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<rows></rows>",
+	},
+	{
+		Name: "XML Invalid Root Element Name",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format:         cmd.XML,
+		XmlRootElement: "1rows",
+		Error:          "data encode error: invalid root element name: \"1rows\"",
+	},
+	{
+		Name: "YAML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2.sub", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(false)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil), value.NewString("abc\ndef")}),
+			},
+		},
+		Format:    cmd.YAML,
+		LineBreak: text.CRLF,
+		Result: "- c1: -1\r\n" +
+			"  c2:\r\n" +
+			"    sub: null\r\n" +
+			"  c3: false\r\n" +
+			"- c1: 2.0123\r\n" +
+			"  c2:\r\n" +
+			"    sub: \"2016-02-01T16:00:00.123456-07:00\"\r\n" +
+			"  c3: |-\r\n" +
+			"    abc\r\n" +
+			"    def\r\n",
+	},
+	{
+		Name: "YAML Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.YAML,
+		Result: "[]\n",
+	},
+	{
+		Name: "HTML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(false)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("<abc>")}),
+			},
+		},
+		Format: cmd.HTML,
+		Result: "<table class=\"csvq\">\n" +
+			"<thead>\n" +
+			"<tr><th>c1</th><th>c2</th><th>c3</th></tr>\n" +
+			"</thead>\n" +
+			"<tbody>\n" +
+			"<tr><td class=\"number\" style=\"text-align: right\">-1</td><td class=\"ternary\" style=\"text-align: center\">UNKNOWN</td><td class=\"boolean\" style=\"text-align: center\">false</td></tr>\n" +
+			"<tr><td class=\"number\" style=\"text-align: right\">2.0123</td><td class=\"null\" style=\"text-align: center\">NULL</td><td class=\"string\">&lt;abc&gt;</td></tr>\n" +
+			"</tbody>\n" +
+			"</table>",
+	},
+	{
+		Name: "HTML Without Header",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format:        cmd.HTML,
+		WithoutHeader: true,
+		Error:         "data empty",
+	},
+	{
+		Name: "SQL",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewString("a'b"), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewBoolean(true), value.NewString("c"), value.NewNull()}),
+			},
+		},
+		Format: cmd.SQL,
+		Result: "CREATE TABLE \"test\" (\n" +
+			"  \"c1\" DOUBLE PRECISION,\n" +
+			"  \"c2\" BOOLEAN,\n" +
+			"  \"c3\" TEXT,\n" +
+			"  \"c4\" TEXT\n" +
+			");\n" +
+			"INSERT INTO \"test\" (\"c1\", \"c2\", \"c3\", \"c4\") VALUES\n" +
+			"  (-1, NULL, 'a''b', NULL),\n" +
+			"  (2.0123, TRUE, 'c', NULL);",
+	},
+	{
+		Name: "SQL MySQL",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil), value.NewString("a\\b")}),
+			},
+		},
+		Format:        cmd.SQL,
+		SqlTable:      "items",
+		SqlDialect:    sql.MySQL,
+		WithoutHeader: true,
+		Result: "INSERT INTO `items` (`c1`, `c2`) VALUES\n" +
+			"  ('2016-02-01 16:00:00.123456', 'a\\\\b');",
+	},
+	{
+		Name: "SQL Without Header",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format:        cmd.SQL,
+		WithoutHeader: true,
+		Error:         "data empty",
+	},
 	{
 		Name: "Fixed-Length Format Invalid Positions",
 		View: &View{
@@ -482,6 +843,65 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" " + string([]byte{0x93, 0xfa, 0x96, 0x7b, 0x8c, 0xea}) + "ghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Windows-1252",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("Café")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: charset.WINDOWS1252,
+		Result: "c1,c2\n" +
+			"1,Caf\xe9",
+	},
+	{
+		Name: "CSV with Locale",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1234),
+					value.NewFloat(1234.56),
+					value.NewDatetime(time.Date(2026, 12, 31, 0, 0, 0, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2026, 12, 31, 13, 45, 30, 0, GetTestLocation())),
+				}),
+			},
+		},
+		Format:         cmd.CSV,
+		WriteDelimiter: ';',
+		Locale:         "de-DE",
+		Result: "c1;c2;c3;c4\n" +
+			"1234;1234,56;31.12.2026;31.12.2026 13:45:30",
+	},
+	{
+		Name: "LTSV with Locale",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewFloat(0.5),
+					value.NewDatetime(time.Date(2026, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+		},
+		Format: cmd.LTSV,
+		Locale: "en-US",
+		Result: "c1:0.5\tc2:01/02/2026",
+	},
+	{
+		Name: "Fixed-Length Format Unsupported Encoding",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abc")}),
+			},
+		},
+		Format:        cmd.FIXED,
+		WriteEncoding: charset.EUCJP,
+		Error:         "data encode error: fixed-length format does not support EUCJP",
+	},
 }
 
 func TestEncodeView(t *testing.T) {
@@ -513,6 +933,22 @@ func TestEncodeView(t *testing.T) {
 		options.JsonEscape = v.JsonEscape
 		options.PrettyPrint = v.PrettyPrint
 		options.SingleLine = v.WriteAsSingleLine
+		if v.WriteQuote != 0 {
+			options.Quote = v.WriteQuote
+		}
+		options.QuoteEscape = v.WriteQuoteEscape
+		options.Locale = v.Locale
+		if 0 < len(v.XmlRootElement) {
+			options.XmlRootElement = v.XmlRootElement
+		}
+		if 0 < len(v.XmlRowElement) {
+			options.XmlRowElement = v.XmlRowElement
+		}
+		options.SqlTable = v.SqlTable
+		options.SqlDialect = v.SqlDialect
+		options.BorderStyle = v.BorderStyle
+		options.Expanded = v.Expanded
+		options.ScreenWidth = v.ScreenWidth
 
 		buf.Reset()
 		_, err := EncodeView(ctx, buf, v.View, options, TestTx.Palette)
@@ -535,3 +971,64 @@ func TestEncodeView(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeView_LocaleRoundTrip(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		_ = TestTx.Session.SetStdin(os.Stdin)
+		initFlag(TestTx.Flags)
+	}()
+
+	_ = TestTx.Flags.SetLocation(TestLocation)
+	TestTx.Flags.ImportOptions.Delimiter = ';'
+	TestTx.Flags.ImportOptions.Locale = "de-DE"
+
+	input := "id;amount;rate;date\n" +
+		"1;1.234,56;0,50;01.01.2026 10:00\n" +
+		"2;-12;1.5;2026-12-31"
+	_ = TestTx.Session.SetStdin(NewInput(strings.NewReader(input)))
+
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx).CreateNode()
+	view, err := LoadView(ctx, scope, []parser.QueryExpression{
+		parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+	}, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	view.RecordSet[0][2] = NewCell(value.NewFloat(0.75))
+
+	records := make(RecordSet, view.RecordLen())
+	for i := range view.RecordSet {
+		records[i] = append(make(Record, 0, view.FieldLen()), view.RecordSet[i]...)
+	}
+	view = &View{Header: view.Header.Copy(), RecordSet: records, FileInfo: view.FileInfo}
+
+	buf := &bytes.Buffer{}
+	if _, err = EncodeView(ctx, buf, view, view.FileInfo.ExportOptions(TestTx), TestTx.Palette); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := "id;amount;rate;date\n" +
+		"1;1.234,56;0,75;01.01.2026 10:00\n" +
+		"2;-12;1.5;2026-12-31"
+	if buf.String() != expect {
+		t.Errorf("result = %q, want %q", buf.String(), expect)
+	}
+
+	options := view.FileInfo.ExportOptions(TestTx)
+	options.Locale = "fr-FR"
+	buf.Reset()
+	if _, err = EncodeView(ctx, buf, view, options, TestTx.Palette); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect = "id;amount;rate;date\n" +
+		"1;1234,56;0,75;01/01/2026 10:00:00\n" +
+		"2;-12;1.5;2026-12-31"
+	if buf.String() != expect {
+		t.Errorf("result = %q, want %q for another locale", buf.String(), expect)
+	}
+}
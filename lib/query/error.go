@@ -40,6 +40,7 @@ const (
 	ErrMsgFunctionNotExist                     = "function %s does not exist"
 	ErrMsgFunctionArgumentsLength              = "function %s takes %s"
 	ErrMsgFunctionInvalidArgument              = "%s for function %s"
+	ErrMsgExternalFunction                     = "external function %s: %s"
 	ErrMsgNestedAggregateFunctions             = "aggregate functions are nested at %s"
 	ErrMsgFunctionRedeclared                   = "function %s is redeclared"
 	ErrMsgBuiltInFunctionDeclared              = "function %s is a built-in function"
@@ -49,6 +50,9 @@ const (
 	ErrMsgJsonQueryTooManyRecords              = "json query returns too many records, should return only one record"
 	ErrMsgLoadJson                             = "json loading error: %s"
 	ErrMsgEmptyJsonQuery                       = "json query is empty"
+	ErrMsgLoadXml                              = "xml loading error: %s"
+	ErrMsgLoadYaml                             = "yaml loading error: %s"
+	ErrMsgXmlEncodingMismatch                  = "xml loading error: encoding %s is specified, but %s is declared in the document"
 	ErrMsgEmptyJsonTable                       = "json table is empty"
 	ErrMsgIncorrectLateralUsage                = "LATERAL cannot to be used in a RIGHT or FULL outer join"
 	ErrMsgInvalidTableObject                   = "invalid table object: %s"
@@ -58,6 +62,8 @@ const (
 	ErrMsgTableObjectArgumentsLength           = "table object %s takes at most %d arguments"
 	ErrMsgTableObjectJsonArgumentsLength       = "table object %s takes exactly %d arguments"
 	ErrMsgTableObjectInvalidArgument           = "invalid argument for %s: %s"
+	ErrMsgTableObjectInvalidXmlRowPath         = "invalid xml row path: %s"
+	ErrMsgTableObjectXmlArgumentsLength        = "table object %s takes %d or %d arguments"
 	ErrMsgCursorRedeclared                     = "cursor %s is redeclared"
 	ErrMsgUndeclaredCursor                     = "cursor %s is undeclared"
 	ErrMsgCursorClosed                         = "cursor %s is closed"
@@ -127,6 +133,13 @@ const (
 	ErrMsgStatementReplaceValueNotSpecified    = "replace value for %s is not specified"
 	ErrMsgSelectIntoQueryFieldLengthNotMatch   = "select into query should return exactly %s"
 	ErrMsgSelectIntoQueryTooManyRecords        = "select into query returns too many records, should return only one record"
+	ErrMsgConstraintViolation                  = "constraint %s on %s is violated: %s"
+	ErrMsgDuplicateConstraintName              = "constraint %s is a duplicate"
+	ErrMsgConstraintNotExist                   = "constraint %s does not exist on %s"
+	ErrMsgInvalidConstraint                    = "invalid constraint %s: %s"
+	ErrMsgDifferencesFound                     = "differences found: %s"
+	ErrMsgInvalidTimeZone                      = "%s"
+	ErrMsgLenientLoadForUpdate                 = "file %s cannot be updated because it is loaded in lenient mode"
 )
 
 type Error interface {
@@ -573,6 +586,16 @@ func NewNestedAggregateFunctionsError(expr parser.QueryExpression) error {
 	}
 }
 
+type ExternalFunctionError struct {
+	*BaseError
+}
+
+func NewExternalFunctionError(expr parser.QueryExpression, funcname string, message string) error {
+	return &ExternalFunctionError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgExternalFunction, funcname, message), ReturnCodeApplicationError, ErrorExternalFunction),
+	}
+}
+
 type FunctionRedeclaredError struct {
 	*BaseError
 }
@@ -643,6 +666,36 @@ func NewLoadJsonError(expr parser.QueryExpression, message string) error {
 	}
 }
 
+type LoadXmlError struct {
+	*BaseError
+}
+
+func NewLoadXmlError(expr parser.QueryExpression, message string) error {
+	return &LoadXmlError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadXml, message), ReturnCodeApplicationError, ErrorLoadXml),
+	}
+}
+
+type XmlEncodingMismatchError struct {
+	*BaseError
+}
+
+func NewXmlEncodingMismatchError(expr parser.QueryExpression, specified string, declared string) error {
+	return &XmlEncodingMismatchError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgXmlEncodingMismatch, specified, declared), ReturnCodeApplicationError, ErrorXmlEncodingMismatch),
+	}
+}
+
+type LoadYamlError struct {
+	*BaseError
+}
+
+func NewLoadYamlError(expr parser.QueryExpression, message string) error {
+	return &LoadYamlError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadYaml, message), ReturnCodeApplicationError, ErrorLoadYaml),
+	}
+}
+
 type EmptyJsonQueryError struct {
 	*BaseError
 }
@@ -743,6 +796,26 @@ func NewTableObjectInvalidArgumentError(expr parser.TableObject, message string)
 	}
 }
 
+type TableObjectInvalidXmlRowPathError struct {
+	*BaseError
+}
+
+func NewTableObjectInvalidXmlRowPathError(expr parser.TableObject, rowPath string) error {
+	return &TableObjectInvalidXmlRowPathError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgTableObjectInvalidXmlRowPath, rowPath), ReturnCodeApplicationError, ErrorTableObjectInvalidXmlRowPath),
+	}
+}
+
+type TableObjectXmlArgumentsLengthError struct {
+	*BaseError
+}
+
+func NewTableObjectXmlArgumentsLengthError(expr parser.TableObject) error {
+	return &TableObjectXmlArgumentsLengthError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgTableObjectXmlArgumentsLength, expr.Type.Literal, 2, 3), ReturnCodeApplicationError, ErrorTableObjectXmlArgumentsLength),
+	}
+}
+
 type CursorRedeclaredError struct {
 	*BaseError
 }
@@ -1451,6 +1524,76 @@ func NewSelectIntoQueryTooManyRecordsError(query parser.SelectQuery) error {
 	}
 }
 
+type ConstraintViolationError struct {
+	*BaseError
+}
+
+func NewConstraintViolationError(expr parser.Expression, name string, table string, message string) error {
+	return &ConstraintViolationError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgConstraintViolation, name, table, message), ReturnCodeApplicationError, ErrorConstraintViolation),
+	}
+}
+
+type DuplicateConstraintNameError struct {
+	*BaseError
+}
+
+func NewDuplicateConstraintNameError(name parser.Identifier) error {
+	return &DuplicateConstraintNameError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgDuplicateConstraintName, name.Literal), ReturnCodeApplicationError, ErrorDuplicateConstraintName),
+	}
+}
+
+type ConstraintNotExistError struct {
+	*BaseError
+}
+
+func NewConstraintNotExistError(name parser.Identifier, table string) error {
+	return &ConstraintNotExistError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgConstraintNotExist, name.Literal, table), ReturnCodeApplicationError, ErrorConstraintNotExist),
+	}
+}
+
+type InvalidConstraintError struct {
+	*BaseError
+}
+
+func NewInvalidConstraintError(expr parser.Expression, name string, message string) error {
+	return &InvalidConstraintError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidConstraint, name, message), ReturnCodeApplicationError, ErrorInvalidConstraint),
+	}
+}
+
+type DifferencesFoundError struct {
+	*BaseError
+}
+
+func NewDifferencesFoundError(summary string) error {
+	return &DifferencesFoundError{
+		NewBaseErrorWithPrefix("", fmt.Sprintf(ErrMsgDifferencesFound, summary), ReturnCodeApplicationError, ErrorDifferencesFound),
+	}
+}
+
+type InvalidTimeZoneError struct {
+	*BaseError
+}
+
+func NewInvalidTimeZoneError(expr parser.AtTimeZone, message string) error {
+	return &InvalidTimeZoneError{
+		NewBaseError(expr.TimeZone, fmt.Sprintf(ErrMsgInvalidTimeZone, message), ReturnCodeApplicationError, ErrorInvalidTimeZone),
+	}
+}
+
+type LenientLoadForUpdateError struct {
+	*BaseError
+}
+
+func NewLenientLoadForUpdateError(file parser.Identifier) error {
+	return &LenientLoadForUpdateError{
+		NewBaseError(file, fmt.Sprintf(ErrMsgLenientLoadForUpdate, file.Literal), ReturnCodeApplicationError, ErrorLenientLoadForUpdate),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
@@ -26,6 +26,7 @@ const (
 	ErrorFunctionNotExist                     = 10401
 	ErrorFunctionArgumentsLength              = 10402
 	ErrorFunctionInvalidArgument              = 10403
+	ErrorExternalFunction                     = 10404
 	ErrorFunctionRedeclared                   = 10501
 	ErrorBuiltInFunctionDeclared              = 10502
 	ErrorDuplicateParameter                   = 10503
@@ -34,6 +35,9 @@ const (
 	ErrorJsonQueryTooManyRecords              = 10701
 	ErrorLoadJson                             = 10702
 	ErrorEmptyJsonQuery                       = 10703
+	ErrorLoadXml                              = 10704
+	ErrorLoadYaml                             = 10705
+	ErrorXmlEncodingMismatch                  = 10706
 	ErrorEmptyJsonTable                       = 10801
 	ErrorIncorrectLateralUsage                = 10802
 	ErrorInvalidTableObject                   = 10901
@@ -43,6 +47,8 @@ const (
 	ErrorTableObjectArgumentsLength           = 10905
 	ErrorTableObjectJsonArgumentsLength       = 10906
 	ErrorTableObjectInvalidArgument           = 10907
+	ErrorTableObjectInvalidXmlRowPath         = 10908
+	ErrorTableObjectXmlArgumentsLength        = 10909
 	ErrorCursorRedeclared                     = 11001
 	ErrorUndeclaredCursor                     = 11002
 	ErrorCursorClosed                         = 11003
@@ -107,6 +113,13 @@ const (
 	ErrorReplaceKeyNotSet                     = 13901
 	ErrorSelectIntoQueryFieldLengthNotMatch   = 14001
 	ErrorSelectIntoQueryTooManyRecords        = 14002
+	ErrorConstraintViolation                  = 14101
+	ErrorDuplicateConstraintName              = 14102
+	ErrorConstraintNotExist                   = 14103
+	ErrorInvalidConstraint                    = 14104
+	ErrorDifferencesFound                     = 14201
+	ErrorInvalidTimeZone                      = 14301
+	ErrorLenientLoadForUpdate                 = 14401
 
 	//Incorrect Command Usage
 	ErrorIncorrectCommandUsage = 90020
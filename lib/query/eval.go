@@ -36,6 +36,8 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalUnaryArithmetic(ctx, scope, expr.(parser.UnaryArithmetic))
 	case parser.Concat:
 		val, err = evalConcat(ctx, scope, expr.(parser.Concat))
+	case parser.AtTimeZone:
+		val, err = evalAtTimeZone(ctx, scope, expr.(parser.AtTimeZone))
 	case parser.Comparison:
 		val, err = evalComparison(ctx, scope, expr.(parser.Comparison))
 	case parser.Is:
@@ -246,6 +248,38 @@ func evalConcat(ctx context.Context, scope *ReferenceScope, expr parser.Concat)
 	return value.NewString(strings.Join(items, "")), nil
 }
 
+func evalAtTimeZone(ctx context.Context, scope *ReferenceScope, expr parser.AtTimeZone) (value.Primary, error) {
+	p, err := Evaluate(ctx, scope, expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	tz, err := Evaluate(ctx, scope, expr.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := value.ToDatetime(p, scope.Tx.Flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	s := value.ToString(tz)
+	if value.IsNull(s) {
+		value.Discard(dt)
+		return value.NewNull(), nil
+	}
+
+	loc, err := cmd.LoadLocation(s.(*value.String).Raw())
+	value.Discard(s)
+	if err != nil {
+		value.Discard(dt)
+		return nil, NewInvalidTimeZoneError(expr, err.Error())
+	}
+
+	t := dt.(*value.Datetime).Raw().In(loc)
+	value.Discard(dt)
+	return value.NewDatetime(t), nil
+}
+
 func evalRowOrSingleValue(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) (value.RowValue, value.Primary, error) {
 	var rv value.RowValue
 	var sv value.Primary
@@ -579,7 +613,7 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 }
 
 func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr parser.AggregateFunction) (value.Primary, error) {
-	var aggfn func([]value.Primary, *cmd.Flags) value.Primary
+	var aggfn AggregateFunction
 	var udfn *UserDefinedFunction
 	var err error
 
@@ -592,17 +626,19 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 		}
 	}
 
-	if aggfn == nil {
+	listsLen := 1
+	if aggfn.Fn == nil {
 		if err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1); err != nil {
 			return nil, err
 		}
 	} else {
-		if len(expr.Args) != 1 {
-			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+		if err = checkArgsForAggregateFunction(expr, expr.Name, expr.Args, expr.IsDistinct(), aggfn); err != nil {
+			return nil, err
 		}
+		listsLen = aggfn.ArgsLen
 	}
 
-	var list []value.Primary
+	lists := make([][]value.Primary, listsLen)
 	if 0 < len(scope.Records) {
 		if !scope.Records[0].view.isGrouped {
 			return nil, NewNotGroupingRecordsError(expr, expr.Name)
@@ -629,14 +665,19 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			if err != nil {
 				return nil, err
 			}
-			list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
-			if err != nil {
-				return nil, err
+			for i := range lists {
+				if 0 < i {
+					listExpr = expr.Args[i]
+				}
+				lists[i], err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
+				if err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
 
-	if aggfn == nil {
+	if aggfn.Fn == nil {
 		argsExprs := expr.Args[1:]
 		args := make([]value.Primary, len(argsExprs))
 		for i, v := range argsExprs {
@@ -646,26 +687,51 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			}
 			args[i] = arg
 		}
-		return udfn.ExecuteAggregate(ctx, scope, list, args)
+		return udfn.ExecuteAggregate(ctx, scope, lists[0], args)
 	}
 
-	return aggfn(list, scope.Tx.Flags), nil
+	return aggfn.Fn(lists, scope.Tx.Flags), nil
+}
+
+// checkArgsForAggregateFunction checks the number of the arguments of a built-in aggregate function.
+// DISTINCT cannot be specified for the functions that take more than one argument.
+func checkArgsForAggregateFunction(expr parser.QueryExpression, name string, args []parser.QueryExpression, distinct bool, fn AggregateFunction) error {
+	if len(args) != fn.ArgsLen {
+		return NewFunctionArgumentLengthError(expr, name, []int{fn.ArgsLen})
+	}
+	if distinct && 1 < fn.ArgsLen {
+		return NewFunctionInvalidArgumentError(expr, name, "DISTINCT cannot be specified")
+	}
+	return nil
 }
 
 func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (value.Primary, error) {
 	var separator string
+	var fraction float64
+	var listExpr parser.QueryExpression
 	var err error
 
 	switch strings.ToUpper(expr.Name) {
 	case "JSON_AGG":
 		err = checkArgsForJsonAgg(expr)
+	case "PERCENTILE_CONT", "PERCENTILE_DISC":
+		fraction, listExpr, err = checkArgsForPercentile(ctx, scope, expr, expr.Name, expr.Args, expr.OrderBy)
+	case "MODE":
+		listExpr, err = checkArgsForMode(expr, expr.Name, expr.Args, expr.OrderBy)
 	default: // LISTAGG
-		separator, err = checkArgsForListFunction(ctx, scope, expr)
+		if _, ok := AggregateFunctions[strings.ToUpper(expr.Name)]; ok {
+			err = NewFunctionInvalidArgumentError(expr, expr.Name, "the WITHIN GROUP clause cannot be used")
+		} else {
+			separator, err = checkArgsForListFunction(ctx, scope, expr)
+		}
 	}
 
 	if err != nil {
 		return nil, err
 	}
+	if listExpr == nil {
+		listExpr = expr.Args[0]
+	}
 
 	var list []value.Primary
 	if 0 < len(scope.Records) {
@@ -684,7 +750,7 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 			}
 		}
 
-		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, expr.Args[0], expr.IsDistinct())
+		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
 		if err != nil {
 			return nil, err
 		}
@@ -693,6 +759,12 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 	switch strings.ToUpper(expr.Name) {
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "PERCENTILE_CONT":
+		return PercentileCont(list, fraction, scope.Tx.Flags), nil
+	case "PERCENTILE_DISC":
+		return PercentileDisc(list, fraction), nil
+	case "MODE":
+		return Mode(list, scope.Tx.Flags), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -719,6 +791,44 @@ func checkArgsForListFunction(ctx context.Context, scope *ReferenceScope, expr p
 	return separator, nil
 }
 
+func checkArgsForPercentile(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, name string, args []parser.QueryExpression, orderBy parser.QueryExpression) (float64, parser.QueryExpression, error) {
+	if len(args) != 1 {
+		return 0, nil, NewFunctionArgumentLengthError(expr, name, []int{1})
+	}
+
+	if orderBy == nil || len(orderBy.(parser.OrderByClause).Items) != 1 {
+		return 0, nil, NewFunctionInvalidArgumentError(expr, name, "the WITHIN GROUP clause must have exactly one sort key")
+	}
+	sortKey := orderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+
+	p, err := Evaluate(ctx, scope, args[0])
+	if err != nil {
+		return 0, nil, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	f := value.ToFloat(p)
+	if value.IsNull(f) {
+		return 0, nil, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	fraction := f.(*value.Float).Raw()
+	value.Discard(f)
+	if fraction < 0 || 1 < fraction {
+		return 0, nil, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+
+	return fraction, sortKey, nil
+}
+
+// checkArgsForMode checks the arguments of MODE() WITHIN GROUP (ORDER BY sort_key), and returns the sort key.
+func checkArgsForMode(expr parser.QueryExpression, name string, args []parser.QueryExpression, orderBy parser.QueryExpression) (parser.QueryExpression, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(expr, name, []int{0})
+	}
+	if orderBy == nil || len(orderBy.(parser.OrderByClause).Items) != 1 {
+		return nil, NewFunctionInvalidArgumentError(expr, name, "the WITHIN GROUP clause must have exactly one sort key")
+	}
+	return orderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value, nil
+}
+
 func checkArgsForJsonAgg(expr parser.ListFunction) error {
 	if 1 != len(expr.Args) {
 		return NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
@@ -346,6 +346,46 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewNull(),
 	},
+	{
+		Name: "AtTimeZone",
+		Expr: parser.AtTimeZone{
+			Expr:     parser.NewStringValue("2012-02-03T09:18:15Z"),
+			TimeZone: parser.NewStringValue("-05:00"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 4, 18, 15, 0, time.FixedZone("-05:00", -5*3600))),
+	},
+	{
+		Name: "AtTimeZone Datetime Is Null",
+		Expr: parser.AtTimeZone{
+			Expr:     parser.NewStringValue("abc"),
+			TimeZone: parser.NewStringValue("UTC"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AtTimeZone Timezone Is Null",
+		Expr: parser.AtTimeZone{
+			Expr:     parser.NewStringValue("2012-02-03T09:18:15Z"),
+			TimeZone: parser.NewNullValue(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AtTimeZone FieldNotExist Error",
+		Expr: parser.AtTimeZone{
+			Expr:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			TimeZone: parser.NewStringValue("UTC"),
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "AtTimeZone Invalid Timezone Error",
+		Expr: parser.AtTimeZone{
+			Expr:     parser.NewStringValue("2012-02-03T09:18:15Z"),
+			TimeZone: parser.NewStringValue("Mars/Base"),
+		},
+		Error: "timezone \"Mars/Base\" does not exist",
+	},
 	{
 		Name: "Comparison",
 		Expr: parser.Comparison{
@@ -2734,6 +2774,128 @@ var evaluateTests = []struct {
 		},
 		Error: "aggregate functions are nested at AVG(AVG(column1))",
 	},
+	{
+		Name: "Aggregate Function with Two Arguments",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewNull(),
+								value.NewInteger(6),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "regr_slope",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function with Two Arguments Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewNull(),
+								value.NewInteger(6),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "corr",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "function corr takes exactly 2 arguments",
+	},
+	{
+		Name: "Aggregate Function with Two Arguments Distinct Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewNull(),
+								value.NewInteger(6),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "corr",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "DISTINCT cannot be specified for function corr",
+	},
 	{
 		Name: "Aggregate Function Count With AllColumns",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -3288,6 +3450,270 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewString("str1,str2"),
 	},
+	{
+		Name: "PercentileCont Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(2),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Result: value.NewFloat(1.5),
+	},
+	{
+		Name: "PercentileDisc Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("b"),
+								value.NewString("d"),
+								value.NewString("a"),
+								value.NewString("c"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+		},
+		Result: value.NewString("d"),
+	},
+	{
+		Name: "Mode Function Within Group",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("a"),
+								value.NewString("b"),
+								value.NewString("a"),
+								value.NewString("b"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+		},
+		Result: value.NewString("b"),
+	},
+	{
+		Name: "Mode Function Within Group Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("a"),
+								value.NewString("b"),
+								value.NewString("a"),
+								value.NewString("b"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "mode",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Error: "function mode takes no argument",
+	},
+	{
+		Name: "Aggregate Function Within Group Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("a"),
+								value.NewString("b"),
+								value.NewString("a"),
+								value.NewString("b"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Error: "the WITHIN GROUP clause cannot be used for function sum",
+	},
+	{
+		Name: "PercentileCont Function Without Within Group Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+		},
+		Error: "the WITHIN GROUP clause must have exactly one sort key for function percentile_cont",
+	},
+	{
+		Name: "PercentileCont Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Error: "function percentile_cont takes exactly 1 argument",
+	},
 	{
 		Name: "ListAgg Function Null",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -0,0 +1,382 @@
+package query
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+const (
+	DefaultExternalFunctionTimeout = 30 * time.Second
+	ExternalFunctionBatchSize      = 256
+
+	externalFunctionTerminationTimeout = 3 * time.Second
+)
+
+var errExternalFunctionClosed = errors.New("process is already closed")
+
+type externalFunctionResult struct {
+	value value.Primary
+	err   error
+}
+
+type externalFunctionCall struct {
+	values []value.Primary
+	args   []value.Primary
+	result chan externalFunctionResult
+}
+
+// ExternalFunction is a client of a long-lived child process that evaluates function calls.
+//
+// Each request is written to the standard input of the process as a single JSON line,
+// and the process must write exactly one JSON line as the response to the standard output.
+//
+//	request:  {"id":1,"function":"NAME","aggregate":false,"calls":[{"args":[...]},...]}
+//	response: {"id":1,"results":[...]} or {"id":1,"error":"message"}
+//
+// Calls of aggregate functions have the aggregated values in the "values" member.
+// Calls made concurrently are sent together in one request up to ExternalFunctionBatchSize.
+type ExternalFunction struct {
+	Name        string
+	Command     []string
+	IsAggregate bool
+	Timeout     time.Duration
+
+	stderr   io.Writer
+	startErr error
+
+	process *exec.Cmd
+	stdin   io.WriteCloser
+	lines   chan []byte
+
+	calls chan *externalFunctionCall
+	done  chan struct{}
+
+	requestId int64
+	err       error
+
+	closed bool
+	mtx    *sync.Mutex
+}
+
+func NewExternalFunction(name string, command []string, isAggregate bool, timeout time.Duration, stderr io.Writer) *ExternalFunction {
+	if timeout <= 0 {
+		timeout = DefaultExternalFunctionTimeout
+	}
+
+	return &ExternalFunction{
+		Name:        name,
+		Command:     command,
+		IsAggregate: isAggregate,
+		Timeout:     timeout,
+		stderr:      stderr,
+		calls:       make(chan *externalFunctionCall),
+		done:        make(chan struct{}),
+		mtx:         &sync.Mutex{},
+	}
+}
+
+func (fn *ExternalFunction) Call(ctx context.Context, values []value.Primary, args []value.Primary) (value.Primary, error) {
+	if err := fn.start(); err != nil {
+		return nil, err
+	}
+
+	call := &externalFunctionCall{
+		values: values,
+		args:   args,
+		result: make(chan externalFunctionResult, 1),
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ConvertContextError(ctx.Err())
+	case <-fn.done:
+		return nil, errExternalFunctionClosed
+	case fn.calls <- call:
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ConvertContextError(ctx.Err())
+	case res := <-call.result:
+		return res.value, res.err
+	}
+}
+
+func (fn *ExternalFunction) start() error {
+	fn.mtx.Lock()
+	defer fn.mtx.Unlock()
+
+	if fn.closed {
+		return errExternalFunctionClosed
+	}
+	if fn.startErr != nil {
+		return fn.startErr
+	}
+	if fn.process != nil {
+		return nil
+	}
+	if len(fn.Command) < 1 {
+		return errors.New("command is empty")
+	}
+
+	c := exec.Command(fn.Command[0], fn.Command[1:]...)
+	c.Stderr = fn.stderr
+
+	stdin, err := c.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := c.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err = c.Start(); err != nil {
+		fn.startErr = err
+		return err
+	}
+
+	fn.process = c
+	fn.stdin = stdin
+	fn.lines = make(chan []byte)
+
+	go fn.readLines(stdout)
+	go fn.dispatch()
+	return nil
+}
+
+func (fn *ExternalFunction) readLines(r io.Reader) {
+	defer close(fn.lines)
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if 0 < len(line) {
+			select {
+			case fn.lines <- line:
+			case <-fn.done:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (fn *ExternalFunction) dispatch() {
+	for {
+		var call *externalFunctionCall
+
+		select {
+		case <-fn.done:
+			return
+		case call = <-fn.calls:
+		}
+
+		batch := make([]*externalFunctionCall, 1, 16)
+		batch[0] = call
+	Collect:
+		for len(batch) < ExternalFunctionBatchSize {
+			select {
+			case c := <-fn.calls:
+				batch = append(batch, c)
+			default:
+				break Collect
+			}
+		}
+
+		results, err := fn.request(batch)
+		for i := range batch {
+			if err != nil {
+				batch[i].result <- externalFunctionResult{err: err}
+			} else {
+				batch[i].result <- externalFunctionResult{value: results[i]}
+			}
+		}
+	}
+}
+
+func (fn *ExternalFunction) request(batch []*externalFunctionCall) ([]value.Primary, error) {
+	if fn.err != nil {
+		return nil, fn.err
+	}
+
+	fn.requestId++
+	if _, err := io.WriteString(fn.stdin, fn.encodeRequest(fn.requestId, batch)+"\n"); err != nil {
+		return nil, fn.abort(errors.New(fmt.Sprintf("failed to send a request: %s", err.Error())))
+	}
+
+	timer := time.NewTimer(fn.Timeout)
+	defer timer.Stop()
+
+	select {
+	case <-fn.done:
+		return nil, errExternalFunctionClosed
+	case <-timer.C:
+		return nil, fn.abort(errors.New(fmt.Sprintf("no response within %s", fn.Timeout)))
+	case line, ok := <-fn.lines:
+		if !ok {
+			return nil, fn.abort(errors.New("process terminated unexpectedly"))
+		}
+		return fn.decodeResponse(fn.requestId, line, len(batch))
+	}
+}
+
+func (fn *ExternalFunction) abort(err error) error {
+	fn.err = err
+	_ = fn.process.Process.Kill()
+	return err
+}
+
+func (fn *ExternalFunction) encodeRequest(id int64, batch []*externalFunctionCall) string {
+	encodeValues := func(values []value.Primary) txjson.Array {
+		array := make(txjson.Array, len(values))
+		for i := range values {
+			array[i] = json.ParseValueToStructure(values[i])
+		}
+		return array
+	}
+
+	calls := make(txjson.Array, len(batch))
+	for i := range batch {
+		call := txjson.NewObject(2)
+		if fn.IsAggregate {
+			call.Add("values", encodeValues(batch[i].values))
+		}
+		call.Add("args", encodeValues(batch[i].args))
+		calls[i] = call
+	}
+
+	req := txjson.NewObject(4)
+	req.Add("id", txjson.Integer(id))
+	req.Add("function", txjson.String(fn.Name))
+	req.Add("aggregate", txjson.Boolean(fn.IsAggregate))
+	req.Add("calls", calls)
+	return req.Encode()
+}
+
+func (fn *ExternalFunction) decodeResponse(id int64, line []byte, callLen int) ([]value.Primary, error) {
+	d := txjson.NewDecoder()
+	d.UseInteger = true
+
+	structure, _, err := d.Decode(string(line))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid response: %s", err.Error()))
+	}
+	res, ok := structure.(txjson.Object)
+	if !ok {
+		return nil, errors.New("invalid response: response must be an object")
+	}
+
+	if resId, ok := res.Value("id").(txjson.Integer); !ok || int64(resId) != id {
+		return nil, fn.abort(errors.New("invalid response: id does not match the request " + strconv.FormatInt(id, 10)))
+	}
+
+	if res.Exists("error") {
+		switch msg := res.Value("error").(type) {
+		case txjson.Null:
+		case txjson.String:
+			return nil, errors.New(msg.Raw())
+		default:
+			return nil, errors.New(msg.Encode())
+		}
+	}
+
+	results, ok := res.Value("results").(txjson.Array)
+	if !ok {
+		return nil, errors.New("invalid response: results must be an array")
+	}
+	if len(results) != callLen {
+		return nil, errors.New(fmt.Sprintf("invalid response: %s returned for %s", FormatCount(len(results), "result"), FormatCount(callLen, "call")))
+	}
+
+	return json.ConvertToArray(results), nil
+}
+
+func (fn *ExternalFunction) Close() error {
+	fn.mtx.Lock()
+	defer fn.mtx.Unlock()
+
+	if fn.closed {
+		return nil
+	}
+	fn.closed = true
+	close(fn.done)
+
+	if fn.process == nil {
+		return nil
+	}
+
+	_ = fn.stdin.Close()
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- fn.process.Wait()
+	}()
+
+	select {
+	case <-exited:
+	case <-time.After(externalFunctionTerminationTimeout):
+		_ = fn.process.Process.Kill()
+		<-exited
+	}
+	return nil
+}
+
+type ExternalFunctionContainer struct {
+	functions []*ExternalFunction
+	plugins   map[string]*UserDefinedFunction
+	mtx       *sync.Mutex
+}
+
+func NewExternalFunctionContainer() *ExternalFunctionContainer {
+	return &ExternalFunctionContainer{
+		functions: make([]*ExternalFunction, 0, 4),
+		plugins:   make(map[string]*UserDefinedFunction),
+		mtx:       &sync.Mutex{},
+	}
+}
+
+func (c *ExternalFunctionContainer) Add(fn *ExternalFunction) {
+	c.mtx.Lock()
+	c.functions = append(c.functions, fn)
+	c.mtx.Unlock()
+}
+
+func (c *ExternalFunctionContainer) LoadPlugin(name string, fn func() *UserDefinedFunction) *UserDefinedFunction {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if udfn, ok := c.plugins[name]; ok {
+		return udfn
+	}
+
+	udfn := fn()
+	c.plugins[name] = udfn
+	c.functions = append(c.functions, udfn.External)
+	return udfn
+}
+
+func (c *ExternalFunctionContainer) CloseAll() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, fn := range c.functions {
+		_ = fn.Close()
+	}
+	c.functions = c.functions[:0]
+	c.plugins = make(map[string]*UserDefinedFunction)
+	return nil
+}
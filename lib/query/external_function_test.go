@@ -0,0 +1,176 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	externalFunctionEchoArgs   = `while IFS= read -r line; do id=${line#*\"id\":}; id=${id%%,*}; args=${line#*\"args\":}; args=${args%%\}*}; printf '{"id":%s,"results":["%s"]}\n' "$id" "$(printf '%s' "$args" | sed 's/"/\\"/g')"; done`
+	externalFunctionEchoValues = `while IFS= read -r line; do id=${line#*\"id\":}; id=${id%%,*}; values=${line#*\"values\":}; values=${values%%,\"args\"*}; printf '{"id":%s,"results":[%s]}\n' "$id" "$values"; done`
+	externalFunctionError      = `while IFS= read -r line; do id=${line#*\"id\":}; id=${id%%,*}; printf '{"id":%s,"error":"invalid address"}\n' "$id"; done`
+	externalFunctionNoResults  = `while IFS= read -r line; do id=${line#*\"id\":}; id=${id%%,*}; printf '{"id":%s,"results":[]}\n' "$id"; done`
+	externalFunctionNoResponse = `cat > /dev/null`
+	externalFunctionExit       = `read -r line; exit 1`
+)
+
+var userDefinedFunctionExecuteExternalTests = []struct {
+	Name        string
+	Script      string
+	IsAggregate bool
+	Timeout     time.Duration
+	Values      []value.Primary
+	Args        []value.Primary
+	Result      value.Primary
+	Error       string
+}{
+	{
+		Name:   "Execute External Function",
+		Script: externalFunctionEchoArgs,
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("abc"),
+			value.NewNull(),
+			value.NewBoolean(true),
+		},
+		Result: value.NewString("[1,\"abc\",null,true]"),
+	},
+	{
+		Name:        "Execute External Aggregate Function",
+		Script:      externalFunctionEchoValues,
+		IsAggregate: true,
+		Values: []value.Primary{
+			value.NewFloat(1.5),
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewString("[1.5]"),
+	},
+	{
+		Name:   "Execute External Function Error Response",
+		Script: externalFunctionError,
+		Error:  "external function extfn: invalid address",
+	},
+	{
+		Name:   "Execute External Function Results Length Error",
+		Script: externalFunctionNoResults,
+		Error:  "external function extfn: invalid response: no result returned for 1 call",
+	},
+	{
+		Name:    "Execute External Function Timeout Error",
+		Script:  externalFunctionNoResponse,
+		Timeout: 100 * time.Millisecond,
+		Error:   "external function extfn: no response within 100ms",
+	},
+	{
+		Name:   "Execute External Function Process Terminated Error",
+		Script: externalFunctionExit,
+		Error:  "external function extfn: process terminated unexpectedly",
+	},
+}
+
+func TestUserDefinedFunction_ExecuteExternal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("external function tests require a POSIX shell")
+	}
+
+	for _, v := range userDefinedFunctionExecuteExternalTests {
+		fn := &UserDefinedFunction{
+			Name:        parser.Identifier{Literal: "extfn"},
+			IsAggregate: v.IsAggregate,
+			External:    NewExternalFunction("extfn", []string{"sh", "-c", v.Script}, v.IsAggregate, v.Timeout, nil),
+		}
+
+		var result value.Primary
+		var err error
+		if v.IsAggregate {
+			result, err = fn.ExecuteAggregate(context.Background(), NewReferenceScope(TestTx), v.Values, v.Args)
+		} else {
+			result, err = fn.Execute(context.Background(), NewReferenceScope(TestTx), v.Args)
+		}
+		_ = fn.External.Close()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestExternalFunction_Call(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("external function tests require a POSIX shell")
+	}
+
+	fn := NewExternalFunction("extfn", []string{"sh", "-c", externalFunctionEchoArgs}, false, 0, nil)
+
+	for i := 0; i < 3; i++ {
+		result, err := fn.Call(context.Background(), nil, []value.Primary{value.NewInteger(int64(i))})
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		expect := value.NewString("[" + value.NewInteger(int64(i)).String() + "]")
+		if !reflect.DeepEqual(result, expect) {
+			t.Errorf("result = %s, want %s", result, expect)
+		}
+	}
+
+	_ = fn.Close()
+
+	_, err := fn.Call(context.Background(), nil, nil)
+	if err != errExternalFunctionClosed {
+		t.Errorf("error = %v, want %v", err, errExternalFunctionClosed)
+	}
+}
+
+func TestReferenceScope_GetFunction_Plugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("external function tests require a POSIX shell")
+	}
+
+	defer func() {
+		_ = TestTx.ExternalFunctions.CloseAll()
+		delete(TestTx.Environment.Plugins, "PLUGINFN")
+	}()
+
+	TestTx.Environment.Plugins["PLUGINFN"] = cmd.Plugin{Command: "sh -c 'cat > /dev/null'"}
+
+	scope := NewReferenceScope(TestTx)
+	fn, err := scope.GetFunction(parser.Identifier{Literal: "pluginfn"}, "pluginfn")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !fn.IsExternal() || fn.IsAggregate {
+		t.Fatalf("function = %v, want a scalar external function", fn)
+	}
+	if !reflect.DeepEqual(fn.External.Command, []string{"sh", "-c", "cat > /dev/null"}) {
+		t.Errorf("command = %q, want %q", fn.External.Command, []string{"sh", "-c", "cat > /dev/null"})
+	}
+	if fn.External.Timeout != DefaultExternalFunctionTimeout {
+		t.Errorf("timeout = %s, want %s", fn.External.Timeout, DefaultExternalFunctionTimeout)
+	}
+
+	fn2, _ := scope.GetFunction(parser.Identifier{Literal: "PluginFn"}, "PluginFn")
+	if fn != fn2 {
+		t.Error("plugin function is not cached")
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/sniffer"
+	"github.com/mithrandie/csvq/lib/xml"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/fixedlen"
@@ -27,6 +29,9 @@ const (
 	TableEncloseAll         = "ENCLOSE_ALL"
 	TableJsonEscape         = "JSON_ESCAPE"
 	TablePrettyPrint        = "PRETTY_PRINT"
+	TableQuote              = "QUOTE"
+	TableQuoteEscape        = "QUOTE_ESCAPE"
+	TableLocale             = "LOCALE"
 )
 
 type ViewType int
@@ -47,6 +52,9 @@ var FileAttributeList = []string{
 	TableEncloseAll,
 	TableJsonEscape,
 	TablePrettyPrint,
+	TableQuote,
+	TableQuoteEscape,
+	TableLocale,
 }
 
 type TableAttributeUnchangedError struct {
@@ -78,14 +86,31 @@ type FileInfo struct {
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	XmlRootElement     string
+	XmlRowElement      string
 
 	SingleLine bool
 
+	// Quote is the quotation mark of CSV. The zero value means the double quotation mark.
+	Quote       rune
+	QuoteEscape cmd.QuoteEscape
+	SkipLines   int
+	Comment     string
+
+	// Locale is the name of the locale in which numbers and dates are written in the file.
+	Locale string
+
+	DetectedDialect *sniffer.Dialect
+
 	Handler *file.Handler
 
+	Constraints []*Constraint
+
 	ForUpdate bool
 	ViewType  ViewType
 
+	lenientLoadSummary *LenientLoadSummary
+
 	restorePointHeader    Header
 	restorePointRecordSet RecordSet
 }
@@ -109,6 +134,9 @@ func NewFileInfo(
 	case cmd.JSON:
 		encoding = text.UTF8
 	}
+	if delimiter == cmd.AutoDelimiter && format != cmd.CSV {
+		delimiter = ','
+	}
 
 	return &FileInfo{
 		Path:      fpath,
@@ -137,6 +165,7 @@ func (f *FileInfo) SetDelimiter(s string) error {
 
 	f.Delimiter = delimiter
 	f.Format = format
+	f.DetectedDialect = nil
 	return nil
 }
 
@@ -186,13 +215,14 @@ func (f *FileInfo) SetFormat(s string) error {
 	f.JsonEscape = escapeType
 	f.Delimiter = delimiter
 	f.Encoding = encoding
+	f.DetectedDialect = nil
 	return nil
 }
 
 func (f *FileInfo) SetEncoding(s string) error {
 	encoding, err := cmd.ParseEncoding(s)
 	if err != nil || encoding == text.AUTO {
-		return errors.New("encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+		return errors.New("encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258")
 	}
 
 	switch f.Format {
@@ -229,6 +259,7 @@ func (f *FileInfo) SetNoHeader(b bool) error {
 		return NewTableAttributeUnchangedError(f.Path)
 	}
 	f.NoHeader = b
+	f.DetectedDialect = nil
 	return nil
 }
 
@@ -240,6 +271,82 @@ func (f *FileInfo) SetEncloseAll(b bool) error {
 	return nil
 }
 
+func (f *FileInfo) SetQuote(s string) error {
+	quote, err := cmd.ParseQuote(s)
+	if err != nil {
+		return err
+	}
+
+	if quote == f.QuoteMark() {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+
+	f.setQuoteMark(quote)
+	return nil
+}
+
+func (f *FileInfo) SetQuoteEscape(s string) error {
+	escape, err := cmd.ParseQuoteEscape(s)
+	if err != nil {
+		return err
+	}
+
+	if escape == f.QuoteEscape {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+
+	f.QuoteEscape = escape
+	return nil
+}
+
+func (f *FileInfo) SetLocale(s string) error {
+	locale, err := cmd.ParseLocale(s)
+	if err != nil {
+		return err
+	}
+
+	name := ""
+	if locale != nil {
+		name = locale.Name
+	}
+	if name == f.Locale {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+
+	f.Locale = name
+	return nil
+}
+
+// importLocale returns the locale used to convert the fields in loading, or nil if the locale is not set.
+func (f *FileInfo) importLocale() *cmd.Locale {
+	switch f.Format {
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.LTSV:
+		locale, _ := cmd.ParseLocale(f.Locale)
+		return locale
+	}
+	return nil
+}
+
+// QuoteMark returns the quotation mark of CSV.
+func (f *FileInfo) QuoteMark() rune {
+	if f.Quote == 0 {
+		return '"'
+	}
+	return f.Quote
+}
+
+func (f *FileInfo) setQuoteMark(quote rune) {
+	if quote == '"' {
+		quote = 0
+	}
+	f.Quote = quote
+}
+
+// UsesCSVDialectOptions returns whether the file requires the options that are not supported by the standard CSV reader.
+func (f *FileInfo) UsesCSVDialectOptions() bool {
+	return f.Quote != 0 || f.QuoteEscape != cmd.QuoteEscapeDouble || 0 < f.SkipLines || 0 < len(f.Comment)
+}
+
 func (f *FileInfo) SetJsonEscape(s string) error {
 	escape, err := cmd.ParseJsonEscapeType(s)
 	if err != nil {
@@ -284,8 +391,17 @@ func (f *FileInfo) ExportOptions(tx *Transaction) cmd.ExportOptions {
 	ops.LineBreak = f.LineBreak
 	ops.WithoutHeader = f.NoHeader
 	ops.EncloseAll = f.EncloseAll
+	ops.Quote = f.QuoteMark()
+	ops.QuoteEscape = f.QuoteEscape
+	ops.Locale = f.Locale
 	ops.JsonEscape = f.JsonEscape
 	ops.PrettyPrint = f.PrettyPrint
+	if xml.IsName(f.XmlRootElement) {
+		ops.XmlRootElement = f.XmlRootElement
+	}
+	if xml.IsName(f.XmlRowElement) {
+		ops.XmlRowElement = f.XmlRowElement
+	}
 	return ops
 }
 
@@ -304,6 +420,10 @@ func SearchFilePath(filename parser.Identifier, repository string, options cmd.I
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case cmd.XML:
+		fpath, err = SearchXmlFilePath(filename, repository)
+	case cmd.YAML:
+		fpath, err = SearchYamlFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
 			switch strings.ToLower(filepath.Ext(fpath)) {
@@ -315,6 +435,10 @@ func SearchFilePath(filename parser.Identifier, repository string, options cmd.I
 				format = cmd.JSON
 			case cmd.LtsvExt:
 				format = cmd.LTSV
+			case cmd.XmlExt:
+				format = cmd.XML
+			case cmd.YamlExt, cmd.YmlExt:
+				format = cmd.YAML
 			default:
 				format = defaultFormat
 			}
@@ -340,8 +464,16 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.LtsvExt, cmd.TextExt})
 }
 
+func SearchXmlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XmlExt})
+}
+
+func SearchYamlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.YamlExt, cmd.YmlExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.XmlExt, cmd.YamlExt, cmd.YmlExt, cmd.TextExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -407,6 +539,10 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		format = cmd.GFM
 	case cmd.OrgExt:
 		format = cmd.ORG
+	case cmd.XmlExt:
+		format = cmd.XML
+	case cmd.YamlExt, cmd.YmlExt:
+		format = cmd.YAML
 	default:
 		format = cmd.CSV
 	}
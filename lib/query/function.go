@@ -18,12 +18,14 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
@@ -63,6 +65,7 @@ var Functions = map[string]BuiltInFunction{
 	"HEX":              Hex,
 	"ENOTATION":        Enotation,
 	"NUMBER_FORMAT":    NumberFormat,
+	"NUMBER_PARSE":     NumberParse,
 	"RAND":             Rand,
 	"TRIM":             Trim,
 	"LTRIM":            Ltrim,
@@ -85,6 +88,14 @@ var Functions = map[string]BuiltInFunction{
 	"REPLACE":          ReplaceFn,
 	"FORMAT":           Format,
 	"JSON_VALUE":       JsonValue,
+	"JSON_SET":         JsonSet,
+	"JSON_INSERT":      JsonInsert,
+	"JSON_REMOVE":      JsonRemove,
+	"JSON_MERGE_PATCH": JsonMergePatch,
+	"JSON_KEYS":        JsonKeys,
+	"JSON_LENGTH":      JsonLength,
+	"JSON_TYPE":        JsonType,
+	"JSON_VALID":       JsonValid,
 	"MD5":              Md5,
 	"SHA1":             Sha1,
 	"SHA256":           Sha256,
@@ -130,6 +141,8 @@ var Functions = map[string]BuiltInFunction{
 	"TIME_DIFF":        TimeDiff,
 	"TIME_NANO_DIFF":   TimeNanoDiff,
 	"UTC":              UTC,
+	"CONVERT_TZ":       ConvertTZ,
+	"FROM_TZ":          FromTZ,
 	"NANO_TO_DATETIME": NanoToDatetime,
 	"STRING":           String,
 	"INTEGER":          Integer,
@@ -535,6 +548,34 @@ func NumberFormat(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value
 	return value.NewString(s), nil
 }
 
+func NumberParse(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	f := value.ToString(args[1])
+	if value.IsNull(f) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a locale")
+	}
+	locale, err := cmd.ParseLocale(f.(*value.String).Raw())
+	value.Discard(f)
+	if err != nil || locale == nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a locale")
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	p, ok := value.StrToNumberInLocale(s.(*value.String).Raw(), locale)
+	value.Discard(s)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	return p, nil
+}
+
 func Rand(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if 0 < len(args) && len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0, 2})
@@ -715,7 +756,7 @@ func execStringsPadding(fn parser.Function, args []value.Primary, direction Dire
 			e, err := cmd.ParseEncoding(encs.(*value.String).Raw())
 			value.Discard(encs)
 
-			if err != nil || e == text.AUTO {
+			if err != nil || e == text.AUTO || charset.IsExtended(e) {
 				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "encoding must be one of UTF8|UTF16|SJIS")
 			}
 			enc = e
@@ -878,7 +919,7 @@ func ByteLen(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prim
 			e, err := cmd.ParseEncoding(encs.(*value.String).Raw())
 			value.Discard(encs)
 
-			if err != nil || e == text.AUTO {
+			if err != nil || e == text.AUTO || charset.IsExtended(e) {
 				value.Discard(s)
 				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "encoding must be one of UTF8|UTF16|SJIS")
 			}
@@ -1114,6 +1155,188 @@ func JsonValue(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return v, nil
 }
 
+func JsonSet(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execJsonSet(fn, args, true, true)
+}
+
+func JsonInsert(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execJsonSet(fn, args, false, true)
+}
+
+func execJsonSet(fn parser.Function, args []value.Primary, replace bool, insert bool) (value.Primary, error) {
+	if len(args) < 3 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 3 arguments")
+	}
+	if len(args)%2 == 0 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "an odd number of arguments")
+	}
+
+	data, err := jsonDataArgument(fn, args[0])
+	if err != nil || data == nil {
+		return value.NewNull(), err
+	}
+
+	for i := 1; i < len(args); i = i + 2 {
+		path, isNull, err := jsonPathArgument(fn, args[i])
+		if err != nil {
+			return nil, err
+		}
+		if isNull {
+			return value.NewNull(), nil
+		}
+		data = json.SetValue(data, path, json.ParseValueToStructure(args[i+1]), replace, insert)
+	}
+	return value.NewString(data.Encode()), nil
+}
+
+func JsonRemove(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 2 arguments")
+	}
+
+	data, err := jsonDataArgument(fn, args[0])
+	if err != nil || data == nil {
+		return value.NewNull(), err
+	}
+
+	for i := 1; i < len(args); i++ {
+		path, isNull, err := jsonPathArgument(fn, args[i])
+		if err != nil {
+			return nil, err
+		}
+		if isNull {
+			return value.NewNull(), nil
+		}
+		if data, err = json.RemoveValue(data, path); err != nil {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+		}
+	}
+	return value.NewString(data.Encode()), nil
+}
+
+func JsonMergePatch(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 2 arguments")
+	}
+
+	var data txjson.Structure
+	for i := range args {
+		patch, err := jsonDataArgument(fn, args[i])
+		if err != nil || patch == nil {
+			return value.NewNull(), err
+		}
+
+		if i == 0 {
+			data = patch
+		} else {
+			data = json.MergePatch(data, patch)
+		}
+	}
+	return value.NewString(data.Encode()), nil
+}
+
+func JsonKeys(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	data, err := jsonInspectionTarget(fn, args)
+	if err != nil || data == nil {
+		return value.NewNull(), err
+	}
+
+	obj, ok := data.(txjson.Object)
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	keys := make(txjson.Array, 0, len(obj.Members))
+	for _, m := range obj.Members {
+		keys = append(keys, txjson.String(m.Key))
+	}
+	return value.NewString(keys.Encode()), nil
+}
+
+func JsonLength(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	data, err := jsonInspectionTarget(fn, args)
+	if err != nil || data == nil {
+		return value.NewNull(), err
+	}
+
+	switch data.(type) {
+	case txjson.Object:
+		return value.NewInteger(int64(len(data.(txjson.Object).Members))), nil
+	case txjson.Array:
+		return value.NewInteger(int64(len(data.(txjson.Array)))), nil
+	}
+	return value.NewInteger(1), nil
+}
+
+func JsonType(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	data, err := jsonInspectionTarget(fn, args)
+	if err != nil || data == nil {
+		return value.NewNull(), err
+	}
+	return value.NewString(json.TypeName(data)), nil
+}
+
+func JsonValid(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	_, _, err := json.Decode(s.(*value.String).Raw())
+	value.Discard(s)
+	return value.NewBoolean(err == nil), nil
+}
+
+func jsonInspectionTarget(fn parser.Function, args []value.Primary) (txjson.Structure, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	data, err := jsonDataArgument(fn, args[0])
+	if err != nil || data == nil || len(args) < 2 {
+		return data, err
+	}
+
+	path, isNull, err := jsonPathArgument(fn, args[1])
+	if err != nil || isNull {
+		return nil, err
+	}
+	data, _ = json.LookupValue(data, path)
+	return data, nil
+}
+
+func jsonDataArgument(fn parser.Function, arg value.Primary) (txjson.Structure, error) {
+	s := value.ToString(arg)
+	if value.IsNull(s) {
+		return nil, nil
+	}
+
+	data, _, err := json.Decode(s.(*value.String).Raw())
+	value.Discard(s)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return data, nil
+}
+
+func jsonPathArgument(fn parser.Function, arg value.Primary) (json.QueryExpression, bool, error) {
+	s := value.ToString(arg)
+	if value.IsNull(s) {
+		return nil, true, nil
+	}
+
+	path, err := json.ParseModificationPath(s.(*value.String).Raw())
+	value.Discard(s)
+	if err != nil {
+		return nil, false, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return path, false, nil
+}
+
 func Md5(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	return execCrypto(fn, args, md5.New)
 }
@@ -1162,7 +1385,7 @@ func DatetimeFormat(fn parser.Function, args []value.Primary, flags *cmd.Flags)
 		return value.NewNull(), nil
 	}
 
-	str := p.(*value.Datetime).Format(value.DatetimeFormats.Get(format.(*value.String).Raw()))
+	str := value.FormatDatetime(p.(*value.Datetime).Raw(), format.(*value.String).Raw())
 	value.Discard(p)
 	value.Discard(format)
 
@@ -1552,6 +1775,74 @@ func UTC(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Prim
 	return value.NewDatetime(t), nil
 }
 
+func ConvertTZ(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	dt := value.ToDatetime(args[0], flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	t := dt.(*value.Datetime).Raw()
+	value.Discard(dt)
+
+	if len(args) == 3 {
+		from, isNull, err := timeZoneArgument(fn, args[1], "second")
+		if err != nil || isNull {
+			return value.NewNull(), err
+		}
+		t = replaceLocation(t, from)
+	}
+
+	ordinal := "second"
+	if len(args) == 3 {
+		ordinal = "third"
+	}
+	to, isNull, err := timeZoneArgument(fn, args[len(args)-1], ordinal)
+	if err != nil || isNull {
+		return value.NewNull(), err
+	}
+	return value.NewDatetime(t.In(to)), nil
+}
+
+func FromTZ(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	dt := value.ToDatetime(args[0], flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	t := dt.(*value.Datetime).Raw()
+	value.Discard(dt)
+
+	loc, isNull, err := timeZoneArgument(fn, args[1], "second")
+	if err != nil || isNull {
+		return value.NewNull(), err
+	}
+	return value.NewDatetime(replaceLocation(t, loc)), nil
+}
+
+func timeZoneArgument(fn parser.Function, arg value.Primary, ordinal string) (*time.Location, bool, error) {
+	s := value.ToString(arg)
+	if value.IsNull(s) {
+		return nil, true, nil
+	}
+	loc, err := cmd.LoadLocation(s.(*value.String).Raw())
+	value.Discard(s)
+	if err != nil {
+		return nil, false, NewFunctionInvalidArgumentError(fn, fn.Name, "the "+ordinal+" argument must be a timezone")
+	}
+	return loc, false, nil
+}
+
+// replaceLocation returns the time that has the same clock as t in the location.
+func replaceLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
 func NanoToDatetime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -975,6 +975,78 @@ func TestNumberFormat(t *testing.T) {
 	testFunction(t, NumberFormat, numberFormatTests)
 }
 
+var numberParseTests = []functionTest{
+	{
+		Name: "NumberParse",
+		Function: parser.Function{
+			Name: "number_parse",
+		},
+		Args: []value.Primary{
+			value.NewString("1.234,56"),
+			value.NewString("de-DE"),
+		},
+		Result: value.NewFloat(1234.56),
+	},
+	{
+		Name: "NumberParse Integer",
+		Function: parser.Function{
+			Name: "number_parse",
+		},
+		Args: []value.Primary{
+			value.NewString("-1 234 567"),
+			value.NewString("fr"),
+		},
+		Result: value.NewInteger(-1234567),
+	},
+	{
+		Name: "NumberParse Cannot Parse",
+		Function: parser.Function{
+			Name: "number_parse",
+		},
+		Args: []value.Primary{
+			value.NewString("1,234.56"),
+			value.NewString("de-DE"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "NumberParse Null",
+		Function: parser.Function{
+			Name: "number_parse",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("de-DE"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "NumberParse Arguments Error",
+		Function: parser.Function{
+			Name: "number_parse",
+		},
+		Args: []value.Primary{
+			value.NewString("1.234,56"),
+		},
+		Error: "function number_parse takes exactly 2 arguments",
+	},
+	{
+		Name: "NumberParse Invalid Locale Error",
+		Function: parser.Function{
+			Name: "number_parse",
+		},
+		Args: []value.Primary{
+			value.NewString("1.234,56"),
+			value.NewString("xx-XX"),
+		},
+		Error: "the second argument must be a locale for function number_parse",
+	},
+}
+
+func TestNumberParse(t *testing.T) {
+	testFunction(t, NumberParse, numberParseTests)
+}
+
 var randTests = []struct {
 	Name      string
 	Function  parser.Function
@@ -2106,6 +2178,453 @@ func TestJsonValue(t *testing.T) {
 	testFunction(t, JsonValue, jsonValueTests)
 }
 
+var jsonSetTests = []functionTest{
+	{
+		Name: "JsonSet",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":{\"c\":[1,2]}}"),
+			value.NewString("a"),
+			value.NewInteger(10),
+			value.NewString("b.c[2]"),
+			value.NewString("x"),
+			value.NewString("d"),
+			value.NewBoolean(true),
+		},
+		Result: value.NewString("{\"a\":10,\"b\":{\"c\":[1,2,\"x\"]},\"d\":true}"),
+	},
+	{
+		Name: "JsonSet Json-Text is Null",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewInteger(10),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonSet Path is Null",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewNull(),
+			value.NewInteger(10),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonSet Arguments Error",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewString("a"),
+		},
+		Error: "function json_set takes at least 3 arguments",
+	},
+	{
+		Name: "JsonSet Arguments Pair Error",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewString("a"),
+			value.NewInteger(10),
+			value.NewString("b"),
+		},
+		Error: "function json_set takes an odd number of arguments",
+	},
+	{
+		Name: "JsonSet Invalid Path Error",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewString("a[*]"),
+			value.NewInteger(10),
+		},
+		Error: "path must consist of object members and array indices for function json_set",
+	},
+	{
+		Name: "JsonSet Json Loading Error",
+		Function: parser.Function{
+			Name: "json_set",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1"),
+			value.NewString("a"),
+			value.NewInteger(10),
+		},
+		Error: "line 1, column 6: unexpected termination for function json_set",
+	},
+}
+
+func TestJsonSet(t *testing.T) {
+	testFunction(t, JsonSet, jsonSetTests)
+}
+
+var jsonInsertTests = []functionTest{
+	{
+		Name: "JsonInsert",
+		Function: parser.Function{
+			Name: "json_insert",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewString("a"),
+			value.NewInteger(10),
+			value.NewString("b"),
+			value.NewString("x"),
+		},
+		Result: value.NewString("{\"a\":1,\"b\":\"x\"}"),
+	},
+}
+
+func TestJsonInsert(t *testing.T) {
+	testFunction(t, JsonInsert, jsonInsertTests)
+}
+
+var jsonRemoveTests = []functionTest{
+	{
+		Name: "JsonRemove",
+		Function: parser.Function{
+			Name: "json_remove",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":[1,2,3],\"c\":3}"),
+			value.NewString("a"),
+			value.NewString("b[0]"),
+		},
+		Result: value.NewString("{\"b\":[2,3],\"c\":3}"),
+	},
+	{
+		Name: "JsonRemove Arguments Error",
+		Function: parser.Function{
+			Name: "json_remove",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+		},
+		Error: "function json_remove takes at least 2 arguments",
+	},
+	{
+		Name: "JsonRemove Root Error",
+		Function: parser.Function{
+			Name: "json_remove",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewString(""),
+		},
+		Error: "root value cannot be removed for function json_remove",
+	},
+}
+
+func TestJsonRemove(t *testing.T) {
+	testFunction(t, JsonRemove, jsonRemoveTests)
+}
+
+var jsonMergePatchTests = []functionTest{
+	{
+		Name: "JsonMergePatch",
+		Function: parser.Function{
+			Name: "json_merge_patch",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":{\"c\":2,\"d\":3}}"),
+			value.NewString("{\"b\":{\"c\":null}}"),
+			value.NewString("{\"e\":[1]}"),
+		},
+		Result: value.NewString("{\"a\":1,\"b\":{\"d\":3},\"e\":[1]}"),
+	},
+	{
+		Name: "JsonMergePatch Null",
+		Function: parser.Function{
+			Name: "json_merge_patch",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonMergePatch Arguments Error",
+		Function: parser.Function{
+			Name: "json_merge_patch",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1}"),
+		},
+		Error: "function json_merge_patch takes at least 2 arguments",
+	},
+}
+
+func TestJsonMergePatch(t *testing.T) {
+	testFunction(t, JsonMergePatch, jsonMergePatchTests)
+}
+
+var jsonKeysTests = []functionTest{
+	{
+		Name: "JsonKeys",
+		Function: parser.Function{
+			Name: "json_keys",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":{\"c\":2}}"),
+		},
+		Result: value.NewString("[\"a\",\"b\"]"),
+	},
+	{
+		Name: "JsonKeys with Path",
+		Function: parser.Function{
+			Name: "json_keys",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":{\"c\":2}}"),
+			value.NewString("b"),
+		},
+		Result: value.NewString("[\"c\"]"),
+	},
+	{
+		Name: "JsonKeys Not Object",
+		Function: parser.Function{
+			Name: "json_keys",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":{\"c\":2}}"),
+			value.NewString("a"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonKeys Arguments Error",
+		Function: parser.Function{
+			Name: "json_keys",
+		},
+		Args:  []value.Primary{},
+		Error: "function json_keys takes 1 or 2 arguments",
+	},
+}
+
+func TestJsonKeys(t *testing.T) {
+	testFunction(t, JsonKeys, jsonKeysTests)
+}
+
+var jsonLengthTests = []functionTest{
+	{
+		Name: "JsonLength Object",
+		Function: parser.Function{
+			Name: "json_length",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":[1,2,3]}"),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "JsonLength Array",
+		Function: parser.Function{
+			Name: "json_length",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":[1,2,3]}"),
+			value.NewString("b"),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "JsonLength Scalar",
+		Function: parser.Function{
+			Name: "json_length",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":[1,2,3]}"),
+			value.NewString("a"),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "JsonLength Not Exist",
+		Function: parser.Function{
+			Name: "json_length",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":1,\"b\":[1,2,3]}"),
+			value.NewString("c"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonLength Scalar Document",
+		Function: parser.Function{
+			Name: "json_length",
+		},
+		Args: []value.Primary{
+			value.NewString("1"),
+		},
+		Result: value.NewInteger(1),
+	},
+}
+
+func TestJsonLength(t *testing.T) {
+	testFunction(t, JsonLength, jsonLengthTests)
+}
+
+var jsonTypeTests = []functionTest{
+	{
+		Name: "JsonType",
+		Function: parser.Function{
+			Name: "json_type",
+		},
+		Args: []value.Primary{
+			value.NewString("[1,2]"),
+		},
+		Result: value.NewString("array"),
+	},
+	{
+		Name: "JsonType with Path",
+		Function: parser.Function{
+			Name: "json_type",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":null}"),
+			value.NewString("a"),
+		},
+		Result: value.NewString("null"),
+	},
+	{
+		Name: "JsonType Not Exist",
+		Function: parser.Function{
+			Name: "json_type",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":null}"),
+			value.NewString("b"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonType Number Document",
+		Function: parser.Function{
+			Name: "json_type",
+		},
+		Args: []value.Primary{
+			value.NewString("1"),
+		},
+		Result: value.NewString("number"),
+	},
+	{
+		Name: "JsonType String Document",
+		Function: parser.Function{
+			Name: "json_type",
+		},
+		Args: []value.Primary{
+			value.NewString("\"s\""),
+		},
+		Result: value.NewString("string"),
+	},
+	{
+		Name: "JsonType Null Document",
+		Function: parser.Function{
+			Name: "json_type",
+		},
+		Args: []value.Primary{
+			value.NewString("null"),
+		},
+		Result: value.NewString("null"),
+	},
+}
+
+func TestJsonType(t *testing.T) {
+	testFunction(t, JsonType, jsonTypeTests)
+}
+
+var jsonValidTests = []functionTest{
+	{
+		Name: "JsonValid",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":[1,2]}"),
+		},
+		Result: value.NewBoolean(true),
+	},
+	{
+		Name: "JsonValid Invalid",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args: []value.Primary{
+			value.NewString("{\"a\":[1,2}"),
+		},
+		Result: value.NewBoolean(false),
+	},
+	{
+		Name: "JsonValid Integer Document",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args: []value.Primary{
+			value.NewString("1"),
+		},
+		Result: value.NewBoolean(true),
+	},
+	{
+		Name: "JsonValid Float Document",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args: []value.Primary{
+			value.NewString("1.5"),
+		},
+		Result: value.NewBoolean(true),
+	},
+	{
+		Name: "JsonValid Multiple Values",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args: []value.Primary{
+			value.NewString("1, 2"),
+		},
+		Result: value.NewBoolean(false),
+	},
+	{
+		Name: "JsonValid Null",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonValid Arguments Error",
+		Function: parser.Function{
+			Name: "json_valid",
+		},
+		Args:  []value.Primary{},
+		Error: "function json_valid takes exactly 1 argument",
+	},
+}
+
+func TestJsonValid(t *testing.T) {
+	testFunction(t, JsonValid, jsonValidTests)
+}
+
 var md5Tests = []functionTest{
 	{
 		Name: "Md5",
@@ -2306,6 +2825,17 @@ var datetimeFormatTests = []functionTest{
 		},
 		Result: value.NewString("2012-02-03"),
 	},
+	{
+		Name: "DatetimeFormat Timezone",
+		Function: parser.Function{
+			Name: "datetime_format",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.FixedZone("+09:00", 9*3600))),
+			value.NewString("%T %O %L"),
+		},
+		Result: value.NewString("09:18:15 +09:00 +09:00"),
+	},
 	{
 		Name: "DatetimeFormat Datetime is Null",
 		Function: parser.Function{
@@ -3113,6 +3643,142 @@ func TestUTC(t *testing.T) {
 	testFunction(t, UTC, utcTests)
 }
 
+var convertTZTests = []functionTest{
+	{
+		Name: "ConvertTZ",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, time.UTC)),
+			value.NewString("+09:00"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 18, 18, 15, 123456789, time.FixedZone("+09:00", 9*3600))),
+	},
+	{
+		Name: "ConvertTZ From Specified Timezone",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03 09:18:15"),
+			value.NewString("-05:00"),
+			value.NewString("utc"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 14, 18, 15, 0, time.UTC)),
+	},
+	{
+		Name: "ConvertTZ Datetime Is Null",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("UTC"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ConvertTZ Timezone Is Null",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ConvertTZ Arguments Error",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+		},
+		Error: "function convert_tz takes 2 or 3 arguments",
+	},
+	{
+		Name: "ConvertTZ Invalid From Timezone Error",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			value.NewString("Mars/Base"),
+			value.NewString("UTC"),
+		},
+		Error: "the second argument must be a timezone for function convert_tz",
+	},
+	{
+		Name: "ConvertTZ Invalid To Timezone Error",
+		Function: parser.Function{
+			Name: "convert_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			value.NewString("UTC"),
+			value.NewString("Mars/Base"),
+		},
+		Error: "the third argument must be a timezone for function convert_tz",
+	},
+}
+
+func TestConvertTZ(t *testing.T) {
+	testFunction(t, ConvertTZ, convertTZTests)
+}
+
+var fromTZTests = []functionTest{
+	{
+		Name: "FromTZ",
+		Function: parser.Function{
+			Name: "from_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, time.UTC)),
+			value.NewString("+09:00"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, time.FixedZone("+09:00", 9*3600))),
+	},
+	{
+		Name: "FromTZ Datetime Is Null",
+		Function: parser.Function{
+			Name: "from_tz",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("+09:00"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "FromTZ Arguments Error",
+		Function: parser.Function{
+			Name: "from_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+		},
+		Error: "function from_tz takes exactly 2 arguments",
+	},
+	{
+		Name: "FromTZ Invalid Timezone Error",
+		Function: parser.Function{
+			Name: "from_tz",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			value.NewString("Mars/Base"),
+		},
+		Error: "the second argument must be a timezone for function from_tz",
+	},
+}
+
+func TestFromTZ(t *testing.T) {
+	testFunction(t, FromTZ, fromTZTests)
+}
+
 var nanoToDatetimeTests = []functionTest{
 	{
 		Name: "NanoToDatetime",
@@ -0,0 +1,251 @@
+package query
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// SeriesInterval represents a step of a datetime series such as '1 day' or '-2 hours'.
+type SeriesInterval struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// AddTo returns the time that the interval is added to n times.
+//
+// If years or months are added and the day does not exist in the resulting month,
+// then the day is set to the last day of the month instead of overflowing into the next month.
+func (i SeriesInterval) AddTo(t time.Time, n int) time.Time {
+	if i.Years != 0 || i.Months != 0 {
+		month := time.Date(t.Year()+i.Years*n, t.Month()+time.Month(i.Months*n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		day := t.Day()
+		if lastDay := month.AddDate(0, 1, -1).Day(); lastDay < day {
+			day = lastDay
+		}
+		t = month.AddDate(0, 0, day-1)
+	}
+	return t.AddDate(0, 0, i.Days*n).Add(i.Duration * time.Duration(n))
+}
+
+// ParseSeriesInterval parses a string that consists of pairs of a number and a unit
+// such as "1 day" or "1 hour 30 minutes".
+func ParseSeriesInterval(s string) (SeriesInterval, bool) {
+	var interval SeriesInterval
+
+	words := strings.Fields(s)
+	if len(words) < 1 || len(words)%2 != 0 {
+		return interval, false
+	}
+
+	for i := 0; i < len(words); i = i + 2 {
+		n, err := strconv.Atoi(words[i])
+		if err != nil {
+			return interval, false
+		}
+
+		switch strings.TrimSuffix(strings.ToLower(words[i+1]), "s") {
+		case "year":
+			interval.Years += n
+		case "month":
+			interval.Months += n
+		case "week":
+			interval.Days += n * 7
+		case "day":
+			interval.Days += n
+		case "hour":
+			interval.Duration += time.Duration(n) * time.Hour
+		case "minute":
+			interval.Duration += time.Duration(n) * time.Minute
+		case "second":
+			interval.Duration += time.Duration(n) * time.Second
+		case "millisecond":
+			interval.Duration += time.Duration(n) * time.Millisecond
+		case "microsecond":
+			interval.Duration += time.Duration(n) * time.Microsecond
+		case "nanosecond":
+			interval.Duration += time.Duration(n)
+		default:
+			return interval, false
+		}
+	}
+	return interval, true
+}
+
+// GenerateSeries returns a view that has a single column of the values from start to stop.
+//
+// Start and stop can be integers, floats or datetimes.
+// The step of numbers is 1 by default, and the step of datetimes must be specified as an interval.
+func GenerateSeries(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction) (*View, error) {
+	if len(expr.Args) < 2 || 3 < len(expr.Args) {
+		return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{2, 3})
+	}
+
+	args := make([]value.Primary, len(expr.Args))
+	for i, arg := range expr.Args {
+		p, err := Evaluate(ctx, scope, arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = p
+	}
+
+	var step value.Primary
+	if 2 < len(args) {
+		step = args[2]
+	}
+
+	var values []value.Primary
+	var err error
+	if isDatetimeSeries(args[0], args[1], step) {
+		values, err = generateDatetimeSeries(ctx, scope.Tx.Flags, expr, args[0], args[1], step)
+	} else {
+		values, err = generateNumberSeries(ctx, expr, args[0], args[1], step)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	view := &View{
+		Header:    NewHeader("", []string{ValueColumn}),
+		RecordSet: make(RecordSet, len(values)),
+	}
+	for i, v := range values {
+		view.RecordSet[i] = NewRecord([]value.Primary{v})
+	}
+	return view, nil
+}
+
+func isDatetimeSeries(start value.Primary, stop value.Primary, step value.Primary) bool {
+	if _, ok := start.(*value.Datetime); ok {
+		return true
+	}
+	if _, ok := stop.(*value.Datetime); ok {
+		return true
+	}
+	if s, ok := step.(*value.String); ok {
+		return value.IsNull(value.ToFloat(s))
+	}
+	return false
+}
+
+func generateNumberSeries(ctx context.Context, expr parser.TableFunction, start value.Primary, stop value.Primary, step value.Primary) ([]value.Primary, error) {
+	if step == nil {
+		step = value.NewInteger(1)
+	}
+
+	istart := value.ToInteger(start)
+	istop := value.ToInteger(stop)
+	istep := value.ToInteger(step)
+	if !value.IsNull(istart) && !value.IsNull(istop) && !value.IsNull(istep) {
+		return generateIntegerSeries(ctx, expr, istart.(*value.Integer).Raw(), istop.(*value.Integer).Raw(), istep.(*value.Integer).Raw())
+	}
+
+	fstart := value.ToFloat(start)
+	if value.IsNull(fstart) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the first argument must be a number or a datetime")
+	}
+	fstop := value.ToFloat(stop)
+	if value.IsNull(fstop) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the second argument must be a number or a datetime")
+	}
+	fstep := value.ToFloat(step)
+	if value.IsNull(fstep) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the third argument must be a number or an interval")
+	}
+	return generateFloatSeries(ctx, expr, fstart.(*value.Float).Raw(), fstop.(*value.Float).Raw(), fstep.(*value.Float).Raw())
+}
+
+func generateIntegerSeries(ctx context.Context, expr parser.TableFunction, start int64, stop int64, step int64) ([]value.Primary, error) {
+	if step == 0 {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "step must not be zero")
+	}
+
+	values := make([]value.Primary, 0, 16)
+	for i := start; (0 < step && i <= stop) || (step < 0 && stop <= i); i = i + step {
+		if len(values)&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		values = append(values, value.NewInteger(i))
+
+		if (0 < step && math.MaxInt64-step < i) || (step < 0 && i < math.MinInt64-step) {
+			break
+		}
+	}
+	return values, nil
+}
+
+func generateFloatSeries(ctx context.Context, expr parser.TableFunction, start float64, stop float64, step float64) ([]value.Primary, error) {
+	if step == 0 {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "step must not be zero")
+	}
+
+	values := make([]value.Primary, 0, 16)
+	for n := 0; ; n++ {
+		if n&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		f := start + float64(n)*step
+		if (0 < step && stop < f) || (step < 0 && f < stop) {
+			break
+		}
+		values = append(values, value.NewFloat(f))
+	}
+	return values, nil
+}
+
+func generateDatetimeSeries(ctx context.Context, flags *cmd.Flags, expr parser.TableFunction, start value.Primary, stop value.Primary, step value.Primary) ([]value.Primary, error) {
+	dstart := value.ToDatetime(start, flags.DatetimeFormat)
+	if value.IsNull(dstart) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the first argument must be a datetime")
+	}
+	dstop := value.ToDatetime(stop, flags.DatetimeFormat)
+	if value.IsNull(dstop) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the second argument must be a datetime")
+	}
+
+	if step == nil {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the step of datetimes must be specified")
+	}
+	s := value.ToString(step)
+	if value.IsNull(s) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the third argument must be an interval")
+	}
+	interval, ok := ParseSeriesInterval(s.(*value.String).Raw())
+	if !ok {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the third argument must be an interval")
+	}
+
+	t1 := dstart.(*value.Datetime).Raw()
+	t2 := dstop.(*value.Datetime).Raw()
+
+	next := interval.AddTo(t1, 1)
+	if next.Equal(t1) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "step must not be zero")
+	}
+	ascending := next.After(t1)
+
+	values := make([]value.Primary, 0, 16)
+	for n := 0; ; n++ {
+		if n&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		t := interval.AddTo(t1, n)
+		if (ascending && t.After(t2)) || (!ascending && t.Before(t2)) {
+			break
+		}
+		values = append(values, value.NewDatetime(t))
+	}
+	return values, nil
+}
@@ -0,0 +1,253 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var parseSeriesIntervalTests = []struct {
+	Input  string
+	Result SeriesInterval
+	Ok     bool
+}{
+	{
+		Input:  "1 day",
+		Result: SeriesInterval{Days: 1},
+		Ok:     true,
+	},
+	{
+		Input:  "-2 Months",
+		Result: SeriesInterval{Months: -2},
+		Ok:     true,
+	},
+	{
+		Input:  "1 year 2 weeks 1 hour 30 minutes",
+		Result: SeriesInterval{Years: 1, Days: 14, Duration: 90 * time.Minute},
+		Ok:     true,
+	},
+	{
+		Input: "day",
+		Ok:    false,
+	},
+	{
+		Input: "1 fortnight",
+		Ok:    false,
+	},
+	{
+		Input: "1.5 days",
+		Ok:    false,
+	},
+}
+
+func TestParseSeriesInterval(t *testing.T) {
+	for _, v := range parseSeriesIntervalTests {
+		result, ok := ParseSeriesInterval(v.Input)
+		if ok != v.Ok {
+			t.Errorf("ok = %t, want %t for %q", ok, v.Ok, v.Input)
+			continue
+		}
+		if ok && result != v.Result {
+			t.Errorf("result = %#v, want %#v for %q", result, v.Result, v.Input)
+		}
+	}
+}
+
+var seriesIntervalAddToTests = []struct {
+	Interval SeriesInterval
+	Time     time.Time
+	N        int
+	Result   time.Time
+}{
+	{
+		Interval: SeriesInterval{Months: 1},
+		Time:     time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC),
+		N:        3,
+		Result:   time.Date(2026, 4, 30, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		Interval: SeriesInterval{Years: 1},
+		Time:     time.Date(2012, 2, 29, 0, 0, 0, 0, time.UTC),
+		N:        1,
+		Result:   time.Date(2013, 2, 28, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Interval: SeriesInterval{Months: -1, Days: 1},
+		Time:     time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+		N:        1,
+		Result:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Interval: SeriesInterval{Days: 1, Duration: time.Hour},
+		Time:     time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		N:        2,
+		Result:   time.Date(2026, 2, 2, 2, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestSeriesInterval_AddTo(t *testing.T) {
+	for _, v := range seriesIntervalAddToTests {
+		result := v.Interval.AddTo(v.Time, v.N)
+		if !result.Equal(v.Result) {
+			t.Errorf("result = %s, want %s for %#v added %d times to %s", result, v.Result, v.Interval, v.N, v.Time)
+		}
+	}
+}
+
+func seriesView(values ...value.Primary) *View {
+	view := &View{
+		Header:    NewHeader("", []string{ValueColumn}),
+		RecordSet: make(RecordSet, len(values)),
+	}
+	for i, v := range values {
+		view.RecordSet[i] = NewRecord([]value.Primary{v})
+	}
+	return view
+}
+
+var generateSeriesTests = []struct {
+	Name   string
+	Args   []parser.QueryExpression
+	Result *View
+	Error  string
+}{
+	{
+		Name: "GenerateSeries Integers",
+		Args: []parser.QueryExpression{
+			parser.NewIntegerValue(1),
+			parser.NewIntegerValue(3),
+		},
+		Result: seriesView(value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)),
+	},
+	{
+		Name: "GenerateSeries Integers with Negative Step",
+		Args: []parser.QueryExpression{
+			parser.NewIntegerValue(10),
+			parser.NewIntegerValue(1),
+			parser.NewIntegerValue(-4),
+		},
+		Result: seriesView(value.NewInteger(10), value.NewInteger(6), value.NewInteger(2)),
+	},
+	{
+		Name: "GenerateSeries Empty",
+		Args: []parser.QueryExpression{
+			parser.NewIntegerValue(3),
+			parser.NewIntegerValue(1),
+		},
+		Result: seriesView(),
+	},
+	{
+		Name: "GenerateSeries Floats",
+		Args: []parser.QueryExpression{
+			parser.NewFloatValue(0),
+			parser.NewIntegerValue(1),
+			parser.NewFloatValue(0.5),
+		},
+		Result: seriesView(value.NewFloat(0), value.NewFloat(0.5), value.NewFloat(1)),
+	},
+	{
+		Name: "GenerateSeries Datetimes",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("2012-01-31"),
+			parser.NewStringValue("2012-04-30"),
+			parser.NewStringValue("1 month"),
+		},
+		Result: seriesView(
+			value.NewDatetime(time.Date(2012, 1, 31, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 29, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 3, 31, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 4, 30, 0, 0, 0, 0, GetTestLocation())),
+		),
+	},
+	{
+		Name: "GenerateSeries Datetimes with Negative Step",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("2012-01-02 00:00:00"),
+			parser.NewStringValue("2012-01-01 12:00:00"),
+			parser.NewStringValue("-6 hours"),
+		},
+		Result: seriesView(
+			value.NewDatetime(time.Date(2012, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 1, 1, 18, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 1, 1, 12, 0, 0, 0, GetTestLocation())),
+		),
+	},
+	{
+		Name: "GenerateSeries Arguments Length Error",
+		Args: []parser.QueryExpression{
+			parser.NewIntegerValue(1),
+		},
+		Error: "function generate_series takes 2 or 3 arguments",
+	},
+	{
+		Name: "GenerateSeries Zero Step Error",
+		Args: []parser.QueryExpression{
+			parser.NewIntegerValue(1),
+			parser.NewIntegerValue(3),
+			parser.NewIntegerValue(0),
+		},
+		Error: "step must not be zero for function generate_series",
+	},
+	{
+		Name: "GenerateSeries Invalid Number Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("a"),
+			parser.NewIntegerValue(3),
+		},
+		Error: "the first argument must be a number or a datetime for function generate_series",
+	},
+	{
+		Name: "GenerateSeries Datetime without Step Error",
+		Args: []parser.QueryExpression{
+			parser.NewDatetimeValue(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+			parser.NewStringValue("2012-01-03"),
+		},
+		Error: "the step of datetimes must be specified for function generate_series",
+	},
+	{
+		Name: "GenerateSeries Invalid Interval Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("2012-01-01"),
+			parser.NewStringValue("2012-01-03"),
+			parser.NewStringValue("1 fortnight"),
+		},
+		Error: "the third argument must be an interval for function generate_series",
+	},
+	{
+		Name: "GenerateSeries Invalid Datetime Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("2012-01-01"),
+			parser.NewStringValue("a"),
+			parser.NewStringValue("1 day"),
+		},
+		Error: "the second argument must be a datetime for function generate_series",
+	},
+}
+
+func TestGenerateSeries(t *testing.T) {
+	_ = TestTx.Flags.SetLocation(TestLocation)
+	ctx := context.Background()
+	for _, v := range generateSeriesTests {
+		expr := parser.TableFunction{Name: "generate_series", Args: v.Args}
+		result, err := GenerateSeries(ctx, NewReferenceScope(TestTx), expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package query
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/syntax"
+)
+
+type highlightSpan struct {
+	start  int
+	end    int
+	effect string
+}
+
+// Highlighter decorates statements being input in the interactive shell with the palette.
+type Highlighter struct {
+	tx      *Transaction
+	scanner *parser.Scanner
+
+	continued bool
+}
+
+func NewHighlighter(tx *Transaction) *Highlighter {
+	return &Highlighter{
+		tx:      tx,
+		scanner: new(parser.Scanner),
+	}
+}
+
+// Paint is called by the terminal every time the input line is refreshed.
+func (h *Highlighter) Paint(line []rune, _ int) []rune {
+	if useHighlight := h.tx.Environment.InteractiveShell.SyntaxHighlight; useHighlight == nil || !*useHighlight {
+		return line
+	}
+	return h.Highlight(line)
+}
+
+// SetContinued sets whether the line being input continues the lines buffered by the shell.
+// A closing parenthesis in a continued line may match an opening one in the buffered lines,
+// so it is not decorated as an error even if the line itself does not open it.
+func (h *Highlighter) SetContinued(continued bool) {
+	h.continued = continued
+}
+
+// Highlight returns the line in which tokens are decorated with the effects of the palette.
+// Unterminated quoted strings and unbalanced parentheses are decorated as errors.
+func (h *Highlighter) Highlight(line []rune) []rune {
+	if !h.tx.Flags.ExportOptions.Color || len(line) < 1 {
+		return line
+	}
+
+	spans := h.scan(line)
+	if len(spans) < 1 {
+		return line
+	}
+
+	var b strings.Builder
+	pos := 0
+	for _, span := range spans {
+		if len(span.effect) < 1 {
+			continue
+		}
+		b.WriteString(string(line[pos:span.start]))
+		b.WriteString(h.tx.Palette.Render(span.effect, string(line[span.start:span.end])))
+		pos = span.end
+	}
+	b.WriteString(string(line[pos:]))
+	return []rune(b.String())
+}
+
+func (h *Highlighter) scan(line []rune) []highlightSpan {
+	lineStarts := []int{0}
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\r':
+			if i+1 < len(line) && line[i+1] == '\n' {
+				i++
+			}
+			lineStarts = append(lineStarts, i+1)
+		case '\n':
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	s := h.scanner.Init(string(line), "", h.tx.Flags.DatetimeFormat, false, h.tx.Flags.AnsiQuotes)
+	spans := make([]highlightSpan, 0, 20)
+	parens := make([]int, 0, 4)
+
+	pos := 0
+	for {
+		token, err := s.Scan()
+		if token.Token == parser.EOF {
+			spans = appendCommentSpan(spans, line, pos, len(line))
+			break
+		}
+
+		start := lineStarts[token.Line-1] + token.Char - 1
+		end := s.Offset()
+		spans = appendCommentSpan(spans, line, pos, start)
+		pos = end
+
+		effect := cmd.NoEffect
+		if err != nil {
+			if isQuotationMark(line[start]) {
+				effect = cmd.ErrorEffect
+			}
+		} else {
+			switch token.Token {
+			case '(':
+				parens = append(parens, len(spans))
+			case ')':
+				if 0 < len(parens) {
+					parens = parens[:len(parens)-1]
+				} else if !h.continued {
+					effect = cmd.ErrorEffect
+				}
+			default:
+				effect = tokenEffect(token)
+			}
+		}
+		spans = append(spans, highlightSpan{start: start, end: end, effect: effect})
+	}
+
+	for _, idx := range parens {
+		spans[idx].effect = cmd.ErrorEffect
+	}
+	return spans
+}
+
+func appendCommentSpan(spans []highlightSpan, line []rune, start int, end int) []highlightSpan {
+	for start < end && unicode.IsSpace(line[start]) {
+		start++
+	}
+	for start < end && unicode.IsSpace(line[end-1]) {
+		end--
+	}
+	if start < end {
+		spans = append(spans, highlightSpan{start: start, end: end, effect: cmd.NullEffect})
+	}
+	return spans
+}
+
+func isQuotationMark(r rune) bool {
+	return r == '\'' || r == '"' || r == '`'
+}
+
+func tokenEffect(token parser.Token) string {
+	switch token.Token {
+	case parser.STRING:
+		return cmd.StringEffect
+	case parser.DATETIME:
+		return cmd.DatetimeEffect
+	case parser.INTEGER, parser.FLOAT:
+		return cmd.NumberEffect
+	case parser.TERNARY:
+		return cmd.TernaryEffect
+	case parser.NULL:
+		return cmd.NullEffect
+	case parser.IDENTIFIER:
+		return cmd.IdentifierEffect
+	case parser.VARIABLE, parser.ENVIRONMENT_VARIABLE, parser.RUNTIME_INFORMATION:
+		return syntax.VariableEffect
+	case parser.FLAG:
+		return syntax.FlagEffect
+	}
+
+	if parser.KeywordFrom <= token.Token && token.Token <= parser.KeywordTo {
+		return syntax.KeywordEffect
+	}
+	return cmd.NoEffect
+}
@@ -0,0 +1,104 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/syntax"
+
+	"github.com/mithrandie/go-text/color"
+)
+
+var highlighterHighlightTests = []struct {
+	Name      string
+	Continued bool
+	Input     string
+	Expect    func(p *color.Palette) string
+}{
+	{
+		Name:  "Tokens",
+		Input: "SELECT c1, 'str', 12, @var, @@FORMAT FROM `tbl` WHERE c2 IS NOT NULL",
+		Expect: func(p *color.Palette) string {
+			return p.Render(syntax.KeywordEffect, "SELECT") + " " +
+				p.Render(cmd.IdentifierEffect, "c1") + ", " +
+				p.Render(cmd.StringEffect, "'str'") + ", " +
+				p.Render(cmd.NumberEffect, "12") + ", " +
+				p.Render(syntax.VariableEffect, "@var") + ", " +
+				p.Render(syntax.FlagEffect, "@@FORMAT") + " " +
+				p.Render(syntax.KeywordEffect, "FROM") + " " +
+				p.Render(cmd.IdentifierEffect, "`tbl`") + " " +
+				p.Render(syntax.KeywordEffect, "WHERE") + " " +
+				p.Render(cmd.IdentifierEffect, "c2") + " " +
+				p.Render(syntax.KeywordEffect, "IS") + " " +
+				p.Render(syntax.KeywordEffect, "NOT") + " " +
+				p.Render(cmd.NullEffect, "NULL")
+		},
+	},
+	{
+		Name:  "Comments",
+		Input: "/* comment */ 1 -- line comment ",
+		Expect: func(p *color.Palette) string {
+			return p.Render(cmd.NullEffect, "/* comment */") + " " +
+				p.Render(cmd.NumberEffect, "1") + " " +
+				p.Render(cmd.NullEffect, "-- line comment") + " "
+		},
+	},
+	{
+		Name:  "Unterminated Quote",
+		Input: "SELECT 'abc",
+		Expect: func(p *color.Palette) string {
+			return p.Render(syntax.KeywordEffect, "SELECT") + " " +
+				p.Render(cmd.ErrorEffect, "'abc")
+		},
+	},
+	{
+		Name:  "Unbalanced Parentheses",
+		Input: "((1) (2))) (",
+		Expect: func(p *color.Palette) string {
+			return "((" + p.Render(cmd.NumberEffect, "1") + ") (" +
+				p.Render(cmd.NumberEffect, "2") + "))" +
+				p.Render(cmd.ErrorEffect, ")") + " " +
+				p.Render(cmd.ErrorEffect, "(")
+		},
+	},
+	{
+		Name:      "Unbalanced Parentheses in Continued Line",
+		Continued: true,
+		Input:     "1) (2)) (",
+		Expect: func(p *color.Palette) string {
+			return p.Render(cmd.NumberEffect, "1") + ") (" +
+				p.Render(cmd.NumberEffect, "2") + ")) " +
+				p.Render(cmd.ErrorEffect, "(")
+		},
+	},
+	{
+		Name:  "Multiple Lines",
+		Input: "SELECT\n  TRUE",
+		Expect: func(p *color.Palette) string {
+			return p.Render(syntax.KeywordEffect, "SELECT") + "\n  " +
+				p.Render(cmd.TernaryEffect, "TRUE")
+		},
+	},
+}
+
+func TestHighlighter_Highlight(t *testing.T) {
+	defer func() {
+		TestTx.UseColor(false)
+	}()
+	TestTx.UseColor(true)
+
+	h := NewHighlighter(TestTx)
+	for _, v := range highlighterHighlightTests {
+		h.SetContinued(v.Continued)
+		result := string(h.Highlight([]rune(v.Input)))
+		if expect := v.Expect(TestTx.Palette); result != expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, expect)
+		}
+	}
+
+	TestTx.UseColor(false)
+	input := "SELECT 1"
+	if result := string(h.Highlight([]rune(input))); result != input {
+		t.Errorf("without color: result = %q, want %q", result, input)
+	}
+}
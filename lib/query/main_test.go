@@ -128,15 +128,25 @@ func setup() {
 	}
 
 	_ = copyfile(filepath.Join(TestDir, "table_sjis.csv"), filepath.Join(TestDataDir, "table_sjis.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_windows1252.csv"), filepath.Join(TestDataDir, "table_windows1252.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table_noheader.csv"), filepath.Join(TestDataDir, "table_noheader.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table_broken.csv"), filepath.Join(TestDataDir, "table_broken.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table1_bom.csv"), filepath.Join(TestDataDir, "table1_bom.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table1b.csv"), filepath.Join(TestDataDir, "table1b.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_diff_a.csv"), filepath.Join(TestDataDir, "table_diff_a.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_diff_b.csv"), filepath.Join(TestDataDir, "table_diff_b.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_diff_key_a.csv"), filepath.Join(TestDataDir, "table_diff_key_a.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_diff_key_b.csv"), filepath.Join(TestDataDir, "table_diff_key_b.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_unnest.csv"), filepath.Join(TestDataDir, "table_unnest.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table4.csv"), filepath.Join(TestDataDir, "table4.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table5.csv"), filepath.Join(TestDataDir, "table5.csv"))
 	_ = copyfile(filepath.Join(TestDir, "group_table.csv"), filepath.Join(TestDataDir, "group_table.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_semicolon.csv"), filepath.Join(TestDataDir, "table_semicolon.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_single_quoted.csv"), filepath.Join(TestDataDir, "table_single_quoted.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_ragged.csv"), filepath.Join(TestDataDir, "table_ragged.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_preamble.csv"), filepath.Join(TestDataDir, "table_preamble.csv"))
 	_ = copyfile(filepath.Join(TestDir, "insert_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "update_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "delete_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
@@ -156,6 +166,11 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "table6.ltsv"), filepath.Join(TestDataDir, "table6.ltsv"))
 	_ = copyfile(filepath.Join(TestDir, "table6_bom.ltsv"), filepath.Join(TestDataDir, "table6_bom.ltsv"))
 
+	_ = copyfile(filepath.Join(TestDir, "table7.xml"), filepath.Join(TestDataDir, "table7.xml"))
+	_ = copyfile(filepath.Join(TestDir, "table7_latin1.xml"), filepath.Join(TestDataDir, "table7_latin1.xml"))
+
+	_ = copyfile(filepath.Join(TestDir, "table8.yaml"), filepath.Join(TestDataDir, "table8.yaml"))
+
 	_ = copyfile(filepath.Join(TestDir, "fixed_length.txt"), filepath.Join(TestDataDir, "fixed_length.txt"))
 	_ = copyfile(filepath.Join(TestDir, "fixed_length_bom.txt"), filepath.Join(TestDataDir, "fixed_length_bom.txt"))
 	_ = copyfile(filepath.Join(TestDir, "fixed_length_sl.txt"), filepath.Join(TestDataDir, "fixed_length_sl.txt"))
@@ -7,8 +7,6 @@ import (
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/go-text/color"
-
-	"golang.org/x/crypto/ssh/terminal"
 )
 
 const (
@@ -39,14 +37,8 @@ type ObjectWriter struct {
 
 func NewObjectWriter(tx *Transaction) *ObjectWriter {
 	maxWidth := DefaultLineWidth
-	if tx.Session.Terminal() != nil {
-		if termw, _, err := tx.Session.Terminal().GetSize(); err == nil {
-			maxWidth = termw
-		}
-	} else {
-		if w, _, err := terminal.GetSize(int(tx.Session.ScreenFd())); err == nil {
-			maxWidth = w
-		}
+	if w, err := tx.Session.ScreenWidth(); err == nil {
+		maxWidth = w
 	}
 
 	return &ObjectWriter{
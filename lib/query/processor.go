@@ -1,15 +1,19 @@
 package query
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/excmd"
+	"github.com/mithrandie/csvq/lib/pager"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -166,6 +170,8 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		err = proc.ReferenceScope.DisposeFunction(stmt.(parser.DisposeFunction).Name)
 	case parser.AggregateDeclaration:
 		err = proc.ReferenceScope.DeclareAggregateFunction(stmt.(parser.AggregateDeclaration))
+	case parser.ExternalFunctionDeclaration:
+		err = proc.DeclareExternalFunction(ctx, stmt.(parser.ExternalFunctionDeclaration))
 	case parser.StatementPreparation:
 		err = proc.Tx.PreparedStatements.Prepare(proc.ReferenceScope.Tx.Flags, stmt.(parser.StatementPreparation))
 	case parser.ExecuteStatement:
@@ -200,10 +206,20 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 					exportOptions := proc.Tx.Flags.ExportOptions.Copy()
 
 					var writer io.Writer
+					var pageBuf *bytes.Buffer
 					if proc.Tx.Session.OutFile() != nil {
 						writer = proc.Tx.Session.OutFile()
 					} else {
 						writer = proc.Tx.Session.Stdout()
+						if exportOptions.Expanded == cmd.ExpandedAuto {
+							if w, err := proc.Tx.Session.ScreenWidth(); err == nil {
+								exportOptions.ScreenWidth = w
+							}
+						}
+						if proc.usePager() {
+							pageBuf = new(bytes.Buffer)
+							writer = pageBuf
+						}
 					}
 					warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
 
@@ -219,6 +235,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 						!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
 						_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
 					}
+
+					if err == nil && pageBuf != nil {
+						err = proc.page(ctx, stmt.(parser.SelectQuery), pageBuf.Bytes(), exportOptions)
+					}
 				}
 
 				proc.Tx.Session.mtx.Unlock()
@@ -358,6 +378,23 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		} else {
 			err = e
 		}
+	case parser.AddConstraint:
+		info, name, e := AddConstraint(ctx, proc.ReferenceScope, stmt.(parser.AddConstraint))
+		if e == nil {
+			proc.Tx.uncommittedViews.SetForUpdatedView(info)
+			proc.Log(fmt.Sprintf("constraint %s added on %q.", name, info.Path), proc.Tx.Flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.DropConstraint:
+		expr := stmt.(parser.DropConstraint)
+		info, e := DropConstraint(ctx, proc.ReferenceScope, expr)
+		if e == nil {
+			proc.Tx.uncommittedViews.SetForUpdatedView(info)
+			proc.Log(fmt.Sprintf("constraint %s dropped on %q.", expr.Name.Literal, info.Path), proc.Tx.Flags.Quiet)
+		} else {
+			err = e
+		}
 	case parser.SetTableAttribute:
 		expr := stmt.(parser.SetTableAttribute)
 		info, log, e := SetTableAttribute(ctx, proc.ReferenceScope, expr)
@@ -634,30 +671,11 @@ func (proc *Processor) WhileInCursor(ctx context.Context, stmt parser.WhileInCur
 }
 
 func (proc *Processor) ExecExternalCommand(ctx context.Context, stmt parser.ExternalCommand) error {
-	splitter := new(excmd.ArgsSplitter).Init(stmt.Command)
-	var argStrs = make([]string, 0, 8)
-	for splitter.Scan() {
-		argStrs = append(argStrs, splitter.Text())
-	}
-	err := splitter.Err()
+	args, err := splitExternalCommand(ctx, proc.ReferenceScope, stmt.Command)
 	if err != nil {
 		return NewExternalCommandError(stmt, err.Error())
 	}
 
-	args := make([]string, 0, len(argStrs))
-	for _, argStr := range argStrs {
-		arg, err := EvaluateEmbeddedString(ctx, proc.ReferenceScope, argStr)
-		if err != nil {
-			if appErr, ok := err.(Error); ok {
-				err = NewExternalCommandError(stmt, appErr.Message())
-			} else {
-				err = NewExternalCommandError(stmt, err.Error())
-			}
-			return err
-		}
-		args = append(args, arg)
-	}
-
 	if len(args) < 1 {
 		return nil
 	}
@@ -674,6 +692,120 @@ func (proc *Processor) ExecExternalCommand(ctx context.Context, stmt parser.Exte
 	return err
 }
 
+func (proc *Processor) usePager() bool {
+	if proc.Tx.Session.Terminal() == nil {
+		return false
+	}
+	usePager := proc.Tx.Environment.InteractiveShell.UsePager
+	return usePager != nil && *usePager
+}
+
+// page writes the content to the standard output, or displays it with a pager if it does not fit in the terminal.
+func (proc *Processor) page(ctx context.Context, expr parser.SelectQuery, content []byte, options cmd.ExportOptions) error {
+	_, height, err := proc.Tx.Session.Terminal().GetSize()
+	if err != nil {
+		_, err = proc.Tx.Session.Stdout().Write(content)
+		return err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	if !pager.NeedsPaging(lines, height) {
+		_, err = proc.Tx.Session.Stdout().Write(content)
+		return err
+	}
+
+	if command := strings.TrimSpace(os.ExpandEnv(proc.Tx.Environment.InteractiveShell.Pager)); 0 < len(command) {
+		args, err := splitExternalCommand(ctx, proc.ReferenceScope, command)
+		if err != nil {
+			return NewExternalCommandError(expr, err.Error())
+		}
+		if 0 < len(args) {
+			c := exec.Command(args[0], args[1:]...)
+			c.Stdin = bytes.NewReader(content)
+			c.Stdout = proc.Tx.Session.Stdout()
+			c.Stderr = proc.Tx.Session.Stderr()
+			if err = c.Run(); err != nil {
+				return NewExternalCommandError(expr, err.Error())
+			}
+			return nil
+		}
+	}
+
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+
+	headerLines := 0
+	switch options.Format {
+	case cmd.TEXT, cmd.GFM, cmd.ORG:
+		headerLines = pager.DetectHeaderLines(lines)
+	case cmd.CSV, cmd.TSV, cmd.FIXED:
+		if !options.WithoutHeader && !options.SingleLine {
+			headerLines = 1
+		}
+	}
+
+	v := pager.NewViewer(lines, headerLines)
+	v.EastAsianEncoding = options.EastAsianEncoding
+	v.CountDiacriticalSign = options.CountDiacriticalSign
+	v.CountFormatCode = options.CountFormatCode
+
+	if err = pager.Run(v, proc.Tx.Session.Stdin(), proc.Tx.Session.Stdout(), int(proc.Tx.Session.ScreenFd())); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (proc *Processor) DeclareExternalFunction(ctx context.Context, stmt parser.ExternalFunctionDeclaration) error {
+	p, err := Evaluate(ctx, proc.ReferenceScope, stmt.Command)
+	if err != nil {
+		return err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return NewExternalFunctionError(stmt.Name, stmt.Name.Literal, "command must be a string")
+	}
+
+	command, err := splitExternalCommand(ctx, proc.ReferenceScope, s.(*value.String).Raw())
+	if err != nil {
+		return NewExternalFunctionError(stmt.Name, stmt.Name.Literal, err.Error())
+	}
+	if len(command) < 1 {
+		return NewExternalFunctionError(stmt.Name, stmt.Name.Literal, "command is empty")
+	}
+
+	fn := NewExternalFunction(stmt.Name.Literal, command, stmt.IsAggregate(), DefaultExternalFunctionTimeout, proc.Tx.Session.Stderr())
+	if err = proc.ReferenceScope.DeclareExternalFunction(stmt, fn); err != nil {
+		return err
+	}
+	proc.Tx.ExternalFunctions.Add(fn)
+	return nil
+}
+
+func splitExternalCommand(ctx context.Context, scope *ReferenceScope, command string) ([]string, error) {
+	splitter := new(excmd.ArgsSplitter).Init(command)
+	var argStrs = make([]string, 0, 8)
+	for splitter.Scan() {
+		argStrs = append(argStrs, splitter.Text())
+	}
+	if err := splitter.Err(); err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, len(argStrs))
+	for _, argStr := range argStrs {
+		arg, err := EvaluateEmbeddedString(ctx, scope, argStr)
+		if err != nil {
+			if appErr, ok := err.(Error); ok {
+				err = errors.New(appErr.Message())
+			}
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
 func (proc *Processor) showExecutionTime(ctx context.Context) {
 	if ctx.Err() != nil {
 		return
@@ -0,0 +1,443 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	ProfileTypeInteger  = "integer"
+	ProfileTypeFloat    = "float"
+	ProfileTypeBoolean  = "boolean"
+	ProfileTypeDatetime = "datetime"
+	ProfileTypeString   = "string"
+	ProfileTypeNull     = "null"
+)
+
+const (
+	DefaultProfileTopN      = 5
+	ProfileNonConformingMax = 5
+)
+
+type ValueFrequency struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type LengthDistribution struct {
+	Min     int     `json:"min"`
+	Max     int     `json:"max"`
+	Average float64 `json:"average"`
+}
+
+type ColumnProfile struct {
+	Name          string
+	Type          string
+	Count         int
+	Nulls         int
+	Empties       int
+	Distinct      int
+	Min           value.Primary
+	Max           value.Primary
+	Mean          value.Primary
+	Stddev        value.Primary
+	Top           []ValueFrequency
+	Length        LengthDistribution
+	NonConforming []string
+}
+
+type TableProfile struct {
+	Table   string
+	Records int
+	Columns []*ColumnProfile
+}
+
+// Profile reads a table and summarizes the values of each column.
+//
+// The type of a column is the type that most of the values in the column can be converted to,
+// and the values that cannot be converted are reported as non-conforming values.
+func Profile(ctx context.Context, scope *ReferenceScope, table parser.QueryExpression, topN int) (*TableProfile, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	view, err := LoadViewFromTableIdentifier(ctx, queryScope, table, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &TableProfile{
+		Table:   table.String(),
+		Records: view.RecordLen(),
+		Columns: make([]*ColumnProfile, 0, view.FieldLen()),
+	}
+	if view.FileInfo != nil && view.FileInfo.IsFile() {
+		profile.Table = view.FileInfo.Path
+	}
+
+	list := make([]value.Primary, view.RecordLen())
+	for i := range view.Header {
+		if !view.Header[i].IsFromTable {
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet {
+			list[j] = view.RecordSet[j][i][0]
+		}
+		profile.Columns = append(profile.Columns, profileColumn(view.Header[i].Column, list, topN, scope.Tx.Flags))
+	}
+
+	return profile, nil
+}
+
+func profileColumn(name string, list []value.Primary, topN int, flags *cmd.Flags) *ColumnProfile {
+	column := &ColumnProfile{
+		Name:          name,
+		Count:         len(list),
+		Min:           value.NewNull(),
+		Max:           value.NewNull(),
+		Mean:          value.NewNull(),
+		Stddev:        value.NewNull(),
+		Top:           make([]ValueFrequency, 0, topN),
+		NonConforming: make([]string, 0, ProfileNonConformingMax),
+	}
+
+	frequencies := make(map[string]int, len(list))
+	order := make([]string, 0, len(list))
+	values := make([]value.Primary, 0, len(list))
+	lengthSum := 0
+	measured := false
+
+	for _, p := range list {
+		if value.IsNull(p) {
+			column.Nulls++
+			continue
+		}
+
+		s := profileString(p)
+		if len(s) < 1 {
+			column.Empties++
+		}
+
+		if _, ok := frequencies[s]; !ok {
+			order = append(order, s)
+		}
+		frequencies[s]++
+
+		l := utf8.RuneCountInString(s)
+		if !measured || l < column.Length.Min {
+			column.Length.Min = l
+		}
+		measured = true
+		if column.Length.Max < l {
+			column.Length.Max = l
+		}
+		lengthSum += l
+
+		if 0 < len(s) {
+			values = append(values, p)
+		}
+	}
+
+	column.Distinct = len(order)
+	if nonNull := column.Count - column.Nulls; 0 < nonNull {
+		column.Length.Average = float64(lengthSum) / float64(nonNull)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return frequencies[order[i]] > frequencies[order[j]]
+	})
+	for i := 0; i < topN && i < len(order); i++ {
+		column.Top = append(column.Top, ValueFrequency{Value: order[i], Count: frequencies[order[i]]})
+	}
+
+	column.Type = inferProfileType(values, flags)
+
+	conformed := make([]value.Primary, 0, len(values))
+	for _, p := range values {
+		c := convertProfileValue(p, column.Type, flags)
+		if value.IsNull(c) {
+			if len(column.NonConforming) < ProfileNonConformingMax {
+				column.NonConforming = append(column.NonConforming, profileString(p))
+			}
+			continue
+		}
+		conformed = append(conformed, c)
+	}
+
+	switch column.Type {
+	case ProfileTypeInteger, ProfileTypeFloat:
+		column.Min = Min(conformed, flags)
+		column.Max = Max(conformed, flags)
+		column.Mean = Avg(conformed, flags)
+		column.Stddev = StdEV(conformed, flags)
+	case ProfileTypeDatetime:
+		column.Min = Min(conformed, flags)
+		column.Max = Max(conformed, flags)
+	case ProfileTypeString:
+		for _, p := range conformed {
+			s := p.(*value.String).Raw()
+			if value.IsNull(column.Min) || s < column.Min.(*value.String).Raw() {
+				column.Min = p
+			}
+			if value.IsNull(column.Max) || column.Max.(*value.String).Raw() < s {
+				column.Max = p
+			}
+		}
+	}
+
+	return column
+}
+
+func inferProfileType(values []value.Primary, flags *cmd.Flags) string {
+	if len(values) < 1 {
+		return ProfileTypeNull
+	}
+
+	candidates := []string{ProfileTypeInteger, ProfileTypeFloat, ProfileTypeBoolean, ProfileTypeDatetime}
+	counts := make([]int, len(candidates))
+	for _, p := range values {
+		for i, t := range candidates {
+			if !value.IsNull(convertProfileValue(p, t, flags)) {
+				counts[i]++
+			}
+		}
+	}
+
+	result := ProfileTypeString
+	max := 0
+	for i := range candidates {
+		if max < counts[i] {
+			result = candidates[i]
+			max = counts[i]
+		}
+	}
+
+	if max*2 <= len(values) {
+		return ProfileTypeString
+	}
+	return result
+}
+
+func convertProfileValue(p value.Primary, profileType string, flags *cmd.Flags) value.Primary {
+	switch profileType {
+	case ProfileTypeInteger:
+		if _, ok := p.(*value.Float); ok {
+			return value.NewNull()
+		}
+		return value.ToInteger(p)
+	case ProfileTypeFloat:
+		return value.ToFloat(p)
+	case ProfileTypeBoolean:
+		switch p.(type) {
+		case *value.Integer, *value.Float:
+			return value.NewNull()
+		}
+		return value.ToBoolean(p)
+	case ProfileTypeDatetime:
+		return value.ToDatetime(p, flags.DatetimeFormat)
+	case ProfileTypeString:
+		return value.ToString(p)
+	}
+	return value.NewNull()
+}
+
+func profileString(p value.Primary) string {
+	if s, ok := p.(*value.String); ok {
+		return s.Raw()
+	}
+	s, _, _ := ConvertFieldContents(p, false)
+	return s
+}
+
+func (p *TableProfile) WriteText(w *ObjectWriter) {
+	w.WriteColorWithoutLineBreak("Records: ", cmd.LableEffect)
+	w.WriteColorWithoutLineBreak(strconv.Itoa(p.Records), cmd.NumberEffect)
+	w.NewLine()
+
+	w.WriteColorWithoutLineBreak("Fields:", cmd.LableEffect)
+	w.NewLine()
+
+	digits := len(strconv.Itoa(len(p.Columns)))
+	for i, c := range p.Columns {
+		idxstr := strconv.Itoa(i + 1)
+
+		w.WriteSpaces(2 + digits - len(idxstr))
+		w.WriteColor(idxstr, cmd.NumberEffect)
+		w.Write(".")
+		w.WriteSpaces(1)
+		w.WriteColorWithoutLineBreak(c.Name, cmd.AttributeEffect)
+		w.NewLine()
+
+		indent := 4 + digits
+
+		w.WriteSpaces(indent)
+		w.WriteColorWithoutLineBreak("Type: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(c.Type)
+		w.NewLine()
+
+		w.WriteSpaces(indent)
+		writeProfileCount(w, "Nulls: ", c.Nulls)
+		w.WriteSpaces(2)
+		writeProfileCount(w, "Empties: ", c.Empties)
+		w.WriteSpaces(2)
+		writeProfileCount(w, "Distinct: ", c.Distinct)
+		w.NewLine()
+
+		if !value.IsNull(c.Min) {
+			w.WriteSpaces(indent)
+			writeProfileValue(w, "Min: ", c.Min)
+			w.WriteSpaces(2)
+			writeProfileValue(w, "Max: ", c.Max)
+			w.NewLine()
+		}
+
+		if !value.IsNull(c.Mean) {
+			w.WriteSpaces(indent)
+			writeProfileValue(w, "Mean: ", c.Mean)
+			w.WriteSpaces(2)
+			writeProfileValue(w, "Stddev: ", c.Stddev)
+			w.NewLine()
+		}
+
+		if c.Nulls < c.Count {
+			w.WriteSpaces(indent)
+			w.WriteColorWithoutLineBreak("Length: ", cmd.LableEffect)
+			w.WriteColorWithoutLineBreak(strconv.Itoa(c.Length.Min), cmd.NumberEffect)
+			w.WriteWithoutLineBreak(" - ")
+			w.WriteColorWithoutLineBreak(strconv.Itoa(c.Length.Max), cmd.NumberEffect)
+			w.WriteWithoutLineBreak(" (avg ")
+			w.WriteColorWithoutLineBreak(value.Float64ToStr(roundProfileFloat(c.Length.Average)), cmd.NumberEffect)
+			w.WriteWithoutLineBreak(")")
+			w.NewLine()
+		}
+
+		if 0 < len(c.Top) {
+			w.WriteSpaces(indent)
+			w.WriteColorWithoutLineBreak("Top Values:", cmd.LableEffect)
+			w.NewLine()
+			for _, f := range c.Top {
+				w.WriteSpaces(indent + 2)
+				w.WriteColorWithoutLineBreak(cmd.QuoteString(f.Value), cmd.StringEffect)
+				w.WriteWithoutLineBreak(" (")
+				w.WriteColorWithoutLineBreak(strconv.Itoa(f.Count), cmd.NumberEffect)
+				w.WriteWithoutLineBreak(")")
+				w.NewLine()
+			}
+		}
+
+		if 0 < len(c.NonConforming) {
+			w.WriteSpaces(indent)
+			w.WriteColorWithoutLineBreak("Non-conforming Values:", cmd.LableEffect)
+			w.NewLine()
+			for _, s := range c.NonConforming {
+				w.WriteSpaces(indent + 2)
+				w.WriteColorWithoutLineBreak(cmd.QuoteString(s), cmd.WarnEffect)
+				w.NewLine()
+			}
+		}
+	}
+}
+
+func writeProfileCount(w *ObjectWriter, label string, n int) {
+	w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
+	w.WriteColorWithoutLineBreak(strconv.Itoa(n), cmd.NumberEffect)
+}
+
+func writeProfileValue(w *ObjectWriter, label string, p value.Primary) {
+	w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
+	if f, ok := p.(*value.Float); ok {
+		p = value.NewFloat(roundProfileFloat(f.Raw()))
+	}
+	s, effect, _ := ConvertFieldContents(p, true)
+	w.WriteColorWithoutLineBreak(s, effect)
+}
+
+func roundProfileFloat(f float64) float64 {
+	f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'f', 6, 64), 64)
+	return f
+}
+
+// EncodeJson returns the profile as a JSON object for other tools.
+func (p *TableProfile) EncodeJson(prettyPrint bool) (string, error) {
+	type column struct {
+		Name          string             `json:"name"`
+		Type          string             `json:"type"`
+		Nulls         int                `json:"nulls"`
+		Empties       int                `json:"empties"`
+		Distinct      int                `json:"distinct"`
+		Min           interface{}        `json:"min"`
+		Max           interface{}        `json:"max"`
+		Mean          interface{}        `json:"mean"`
+		Stddev        interface{}        `json:"stddev"`
+		Top           []ValueFrequency   `json:"top"`
+		Length        LengthDistribution `json:"length"`
+		NonConforming []string           `json:"non_conforming"`
+	}
+
+	type table struct {
+		Table   string   `json:"table"`
+		Records int      `json:"records"`
+		Columns []column `json:"columns"`
+	}
+
+	obj := table{
+		Table:   p.Table,
+		Records: p.Records,
+		Columns: make([]column, 0, len(p.Columns)),
+	}
+	for _, c := range p.Columns {
+		obj.Columns = append(obj.Columns, column{
+			Name:          c.Name,
+			Type:          c.Type,
+			Nulls:         c.Nulls,
+			Empties:       c.Empties,
+			Distinct:      c.Distinct,
+			Min:           profileJsonValue(c.Min),
+			Max:           profileJsonValue(c.Max),
+			Mean:          profileJsonValue(c.Mean),
+			Stddev:        profileJsonValue(c.Stddev),
+			Top:           c.Top,
+			Length:        c.Length,
+			NonConforming: c.NonConforming,
+		})
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if prettyPrint {
+		enc.SetIndent("", "  ")
+	}
+	if err := enc.Encode(obj); err != nil {
+		return "", NewSystemError(err.Error())
+	}
+	return string(bytes.TrimRight(buf.Bytes(), "\n")), nil
+}
+
+func profileJsonValue(p value.Primary) interface{} {
+	switch p.(type) {
+	case *value.Integer:
+		return p.(*value.Integer).Raw()
+	case *value.Float:
+		return p.(*value.Float).Raw()
+	case *value.Boolean:
+		return p.(*value.Boolean).Raw()
+	case *value.String:
+		return p.(*value.String).Raw()
+	case *value.Datetime:
+		s, _, _ := ConvertFieldContents(p, false)
+		return s
+	}
+	return nil
+}
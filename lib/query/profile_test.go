@@ -0,0 +1,142 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var profileColumnTests = []struct {
+	Name   string
+	List   []value.Primary
+	TopN   int
+	Result *ColumnProfile
+}{
+	{
+		Name: "Profile Integer Column",
+		List: []value.Primary{
+			value.NewString("1"),
+			value.NewString("3"),
+			value.NewString("3"),
+			value.NewNull(),
+			value.NewString("abc"),
+		},
+		TopN: 1,
+		Result: &ColumnProfile{
+			Name:          "column1",
+			Type:          ProfileTypeInteger,
+			Count:         5,
+			Nulls:         1,
+			Empties:       0,
+			Distinct:      3,
+			Min:           value.NewInteger(1),
+			Max:           value.NewInteger(3),
+			Mean:          value.NewFloat(2.3333333333333335),
+			Stddev:        value.NewFloat(1.1547005383792515),
+			Top:           []ValueFrequency{{Value: "3", Count: 2}},
+			Length:        LengthDistribution{Min: 1, Max: 3, Average: 1.5},
+			NonConforming: []string{"abc"},
+		},
+	},
+	{
+		Name: "Profile String Column",
+		List: []value.Primary{
+			value.NewString("b"),
+			value.NewString(""),
+			value.NewString("abc"),
+			value.NewInteger(1),
+		},
+		TopN: 5,
+		Result: &ColumnProfile{
+			Name:          "column1",
+			Type:          ProfileTypeString,
+			Count:         4,
+			Empties:       1,
+			Distinct:      4,
+			Min:           value.NewString("1"),
+			Max:           value.NewString("b"),
+			Mean:          value.NewNull(),
+			Stddev:        value.NewNull(),
+			Top:           []ValueFrequency{{Value: "b", Count: 1}, {Value: "", Count: 1}, {Value: "abc", Count: 1}, {Value: "1", Count: 1}},
+			Length:        LengthDistribution{Min: 0, Max: 3, Average: 1.25},
+			NonConforming: []string{},
+		},
+	},
+	{
+		Name: "Profile Null Column",
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+		},
+		TopN: 5,
+		Result: &ColumnProfile{
+			Name:          "column1",
+			Type:          ProfileTypeNull,
+			Count:         2,
+			Nulls:         2,
+			Min:           value.NewNull(),
+			Max:           value.NewNull(),
+			Mean:          value.NewNull(),
+			Stddev:        value.NewNull(),
+			Top:           []ValueFrequency{},
+			NonConforming: []string{},
+		},
+	},
+}
+
+func TestProfileColumn(t *testing.T) {
+	for _, v := range profileColumnTests {
+		result := profileColumn("column1", v.List, v.TopN, TestTx.Flags)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	result, err := Profile(ctx, NewReferenceScope(TestTx), parser.Identifier{Literal: "table1"}, DefaultProfileTopN)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if result.Records != 3 {
+		t.Errorf("records = %d, want %d", result.Records, 3)
+	}
+	if len(result.Columns) != 2 {
+		t.Fatalf("column length = %d, want %d", len(result.Columns), 2)
+	}
+	if result.Columns[0].Name != "column1" || result.Columns[0].Type != ProfileTypeInteger {
+		t.Errorf("column = %s %s, want %s %s", result.Columns[0].Name, result.Columns[0].Type, "column1", ProfileTypeInteger)
+	}
+	if result.Columns[1].Name != "column2" || result.Columns[1].Type != ProfileTypeString {
+		t.Errorf("column = %s %s, want %s %s", result.Columns[1].Name, result.Columns[1].Type, "column2", ProfileTypeString)
+	}
+
+	expect := "{\"table\":\"table1\",\"records\":1,\"columns\":[{\"name\":\"c1\",\"type\":\"integer\",\"nulls\":0,\"empties\":0,\"distinct\":1,\"min\":1,\"max\":1,\"mean\":1,\"stddev\":null,\"top\":[{\"value\":\"1\",\"count\":1}],\"length\":{\"min\":1,\"max\":1,\"average\":1},\"non_conforming\":[]}]}"
+	profile := &TableProfile{
+		Table:   "table1",
+		Records: 1,
+		Columns: []*ColumnProfile{profileColumn("c1", []value.Primary{value.NewString("1")}, DefaultProfileTopN, TestTx.Flags)},
+	}
+	s, err := profile.EncodeJson(false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if s != expect {
+		t.Errorf("json = %s, want %s", s, expect)
+	}
+
+	if _, err := Profile(ctx, NewReferenceScope(TestTx), parser.Identifier{Literal: "notexist"}, DefaultProfileTopN); err == nil || err.Error() != "file notexist does not exist" {
+		t.Errorf("error = %v, want %q", err, "file notexist does not exist")
+	}
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync/atomic"
 
@@ -372,6 +373,8 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		fields = view.Header.TableColumns()
 	}
 
+	recordLen := view.RecordLen()
+
 	if query.ValuesList != nil {
 		if insertRecords, err = view.InsertValues(ctx, queryScope, fields, query.ValuesList); err != nil {
 			return nil, insertRecords, err
@@ -386,6 +389,14 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		return nil, insertRecords, err
 	}
 
+	targets := make([]int, 0, view.RecordLen()-recordLen)
+	for i := recordLen; i < view.RecordLen(); i++ {
+		targets = append(targets, i)
+	}
+	if err = CheckConstraints(ctx, queryScope, query, view, targets); err != nil {
+		return nil, insertRecords, err
+	}
+
 	if !view.FileInfo.IsFile() {
 		scope.ReplaceTemporaryTable(view)
 	} else {
@@ -503,6 +514,17 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			return nil, nil, err
 		}
 
+		if v.FileInfo != nil && 0 < len(v.FileInfo.Constraints) {
+			targets := make([]int, 0, len(updatesList[k]))
+			for internalId := range updatesList[k] {
+				targets = append(targets, internalId)
+			}
+			sort.Ints(targets)
+			if err = CheckConstraints(ctx, queryScope, query, v, targets); err != nil {
+				return nil, nil, err
+			}
+		}
+
 		if !v.FileInfo.IsFile() {
 			scope.ReplaceTemporaryTable(v)
 		} else {
@@ -559,6 +581,10 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 		return nil, replaceRecords, err
 	}
 
+	if err = CheckConstraints(ctx, queryScope, query, view, nil); err != nil {
+		return nil, replaceRecords, err
+	}
+
 	if !view.FileInfo.IsFile() {
 		scope.ReplaceTemporaryTable(view)
 	} else {
@@ -696,6 +722,8 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 
 	fileInfo.LineBreak = flags.ExportOptions.LineBreak
 	fileInfo.EncloseAll = flags.ExportOptions.EncloseAll
+	fileInfo.setQuoteMark(flags.ExportOptions.Quote)
+	fileInfo.QuoteEscape = flags.ExportOptions.QuoteEscape
 	fileInfo.NoHeader = flags.ExportOptions.WithoutHeader
 	fileInfo.PrettyPrint = flags.ExportOptions.PrettyPrint
 	fileInfo.ForUpdate = true
@@ -734,6 +762,26 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 
 	view.FileInfo = fileInfo
 
+	if 0 < len(query.Constraints) {
+		table := parser.FormatTableName(fileInfo.Path)
+		constraints := make([]*Constraint, 0, len(query.Constraints))
+		for _, expr := range query.Constraints {
+			c, err := NewConstraint(expr, table, view.Header, constraints)
+			if err == nil {
+				err = ValidateConstraintCondition(ctx, queryScope, view.Header, c)
+			}
+			if err != nil {
+				return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+			}
+			constraints = append(constraints, c)
+		}
+		fileInfo.Constraints = constraints
+
+		if err = CheckConstraints(ctx, queryScope, query, view, nil); err != nil {
+			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+		}
+	}
+
 	scope.Tx.cachedViews.Set(view)
 
 	return view.FileInfo, nil
@@ -888,10 +936,38 @@ func DropColumns(ctx context.Context, scope *ReferenceScope, query parser.DropCo
 		}
 	}
 
+	droppedColumns := make([]string, 0, dropIndices.Len())
+	for i := 0; i < view.FieldLen(); i++ {
+		if dropIndices.Exists(uint(i)) {
+			droppedColumns = append(droppedColumns, view.Header[i].Column)
+		}
+	}
+
 	if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
 		return nil, 0, err
 	}
 
+	if 0 < len(view.FileInfo.Constraints) {
+		constraints := make([]*Constraint, 0, len(view.FileInfo.Constraints))
+		for _, c := range view.FileInfo.Constraints {
+			dropped := false
+			for _, col := range droppedColumns {
+				if c.HasColumn(col) {
+					dropped = true
+					break
+				}
+			}
+			if dropped {
+				continue
+			}
+			if err = ValidateConstraintCondition(ctx, queryScope, view.Header, c); err != nil {
+				return nil, 0, err
+			}
+			constraints = append(constraints, c)
+		}
+		view.FileInfo.Constraints = constraints
+	}
+
 	if !view.FileInfo.IsFile() {
 		scope.ReplaceTemporaryTable(view)
 	} else {
@@ -928,8 +1004,32 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 		return nil, err
 	}
 
+	oldName := view.Header[idx].Column
 	view.Header[idx].Column = query.New.Literal
 
+	if 0 < len(view.FileInfo.Constraints) {
+		constraints := make([]*Constraint, len(view.FileInfo.Constraints))
+		for i, c := range view.FileInfo.Constraints {
+			if c.HasColumn(oldName) {
+				columns := make([]string, len(c.Columns))
+				for j := range c.Columns {
+					if strings.EqualFold(c.Columns[j], oldName) {
+						columns[j] = query.New.Literal
+					} else {
+						columns[j] = c.Columns[j]
+					}
+				}
+				c = &Constraint{Name: c.Name, Type: c.Type, Columns: columns, Condition: c.Condition}
+			}
+			if err = ValidateConstraintCondition(ctx, queryScope, view.Header, c); err != nil {
+				view.Header[idx].Column = oldName
+				return nil, err
+			}
+			constraints[i] = c
+		}
+		view.FileInfo.Constraints = constraints
+	}
+
 	if !view.FileInfo.IsFile() {
 		scope.ReplaceTemporaryTable(view)
 	} else {
@@ -939,6 +1039,70 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 	return view.FileInfo, err
 }
 
+func AddConstraint(ctx context.Context, scope *ReferenceScope, query parser.AddConstraint) (*FileInfo, string, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	queryScope.Tx.operationMutex.Lock()
+	defer queryScope.Tx.operationMutex.Unlock()
+
+	view, err := LoadViewFromTableIdentifier(ctx, queryScope, query.Table, true, false)
+	if err != nil {
+		return nil, "", err
+	}
+	if !view.FileInfo.IsFile() {
+		return nil, "", NewNotTableError(query.Table)
+	}
+
+	constraint, err := NewConstraint(query.Constraint, parser.FormatTableName(view.FileInfo.Path), view.Header, view.FileInfo.Constraints)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = ValidateConstraintCondition(ctx, queryScope, view.Header, constraint); err != nil {
+		return nil, "", err
+	}
+	if err = checkConstraints(ctx, queryScope, query, view, []*Constraint{constraint}, nil); err != nil {
+		return nil, "", err
+	}
+
+	constraints := make([]*Constraint, 0, len(view.FileInfo.Constraints)+1)
+	constraints = append(constraints, view.FileInfo.Constraints...)
+	view.FileInfo.Constraints = append(constraints, constraint)
+
+	scope.Tx.cachedViews.Set(view)
+	return view.FileInfo, constraint.Name, nil
+}
+
+func DropConstraint(ctx context.Context, scope *ReferenceScope, query parser.DropConstraint) (*FileInfo, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	queryScope.Tx.operationMutex.Lock()
+	defer queryScope.Tx.operationMutex.Unlock()
+
+	view, err := LoadViewFromTableIdentifier(ctx, queryScope, query.Table, true, false)
+	if err != nil {
+		return nil, err
+	}
+	if !view.FileInfo.IsFile() {
+		return nil, NewNotTableError(query.Table)
+	}
+
+	constraints := make([]*Constraint, 0, len(view.FileInfo.Constraints))
+	for _, c := range view.FileInfo.Constraints {
+		if !strings.EqualFold(c.Name, query.Name.Literal) {
+			constraints = append(constraints, c)
+		}
+	}
+	if len(constraints) == len(view.FileInfo.Constraints) {
+		return nil, NewConstraintNotExistError(query.Name, parser.FormatTableName(view.FileInfo.Path))
+	}
+	view.FileInfo.Constraints = constraints
+
+	scope.Tx.cachedViews.Set(view)
+	return view.FileInfo, nil
+}
+
 func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.SetTableAttribute) (*FileInfo, string, error) {
 	var log string
 
@@ -969,7 +1133,8 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	fileInfo := view.FileInfo
 	attr := strings.ToUpper(query.Attribute.Literal)
 	switch attr {
-	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape:
+	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape,
+		TableQuote, TableQuoteEscape, TableLocale:
 		s := value.ToString(p)
 		if value.IsNull(s) {
 			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
@@ -987,6 +1152,12 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 			err = fileInfo.SetLineBreak(s.(*value.String).Raw())
 		case TableJsonEscape:
 			err = fileInfo.SetJsonEscape(s.(*value.String).Raw())
+		case TableQuote:
+			err = fileInfo.SetQuote(s.(*value.String).Raw())
+		case TableQuoteEscape:
+			err = fileInfo.SetQuoteEscape(s.(*value.String).Raw())
+		case TableLocale:
+			err = fileInfo.SetLocale(s.(*value.String).Raw())
 		}
 		value.Discard(s)
 	case TableHeader, TableEncloseAll, TablePrettyPrint:
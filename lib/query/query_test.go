@@ -4184,6 +4184,84 @@ var setTableAttributeTests = []struct {
 		},
 		Error: "NULL for delimiter is not allowed",
 	},
+	{
+		Name: "Set Quote",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "quote"},
+			Value:     parser.NewStringValue("'"),
+		},
+		Expect: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			Quote:     '\'',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+	},
+	{
+		Name: "Set Quote Error",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "quote"},
+			Value:     parser.NewStringValue("aa"),
+		},
+		Error: "quote must be one character",
+	},
+	{
+		Name: "Set QuoteEscape",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "quote_escape"},
+			Value:     parser.NewStringValue("backslash"),
+		},
+		Expect: &FileInfo{
+			Path:        GetTestFilePath("table1.csv"),
+			Delimiter:   ',',
+			QuoteEscape: cmd.QuoteEscapeBackslash,
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			LineBreak:   text.LF,
+			ForUpdate:   true,
+		},
+	},
+	{
+		Name: "Set QuoteEscape Unchanged",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "quote_escape"},
+			Value:     parser.NewStringValue("double"),
+		},
+		Error: "table attributes of " + GetTestFilePath("table1.csv") + " remain unchanged",
+	},
+	{
+		Name: "Set Locale",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "locale"},
+			Value:     parser.NewStringValue("de_DE"),
+		},
+		Expect: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			Locale:    "de-DE",
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+	},
+	{
+		Name: "Set Locale Error",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "locale"},
+			Value:     parser.NewStringValue("xx"),
+		},
+		Error: "locale \"xx\" is not supported",
+	},
 	{
 		Name: "Set DelimiterPositions",
 		Query: parser.SetTableAttribute{
@@ -4266,7 +4344,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XML|YAML|HTML|SQL",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -4307,7 +4385,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "encoding"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS",
+		Error: "encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258",
 	},
 	{
 		Name: "Set Encoding Error in JSON Format",
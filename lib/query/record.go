@@ -7,6 +7,7 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/ternary"
 )
 
 type Cell []value.Primary
@@ -19,6 +20,41 @@ func NewGroupCell(values []value.Primary) Cell {
 	return values
 }
 
+// localeText is the text of a field written in a locale from which the value of a cell is converted in loading.
+type localeText struct {
+	text   string
+	locale string
+}
+
+func (t localeText) String() string {
+	return t.text
+}
+
+func (t localeText) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
+// newLocaleCell returns a cell of the value converted from the text of a field written in the locale.
+//
+// The text is kept next to the value beyond the length of the cell, so it is carried along with the cell
+// when records are copied or moved, and is discarded when the cell is replaced.
+func newLocaleCell(val value.Primary, s string, locale string) Cell {
+	cell := Cell{val, localeText{text: s, locale: locale}}
+	return cell[:1]
+}
+
+// localeText returns the text of the field from which the value of the cell was converted in loading
+// if the text is written in the locale.
+func (c Cell) localeText(locale string) (string, bool) {
+	if len(c) != 1 || cap(c) < 2 {
+		return "", false
+	}
+	if t, ok := c[:2][1].(localeText); ok && t.locale == locale {
+		return t.text, true
+	}
+	return "", false
+}
+
 type RecordSet []Record
 
 func (r RecordSet) Copy() RecordSet {
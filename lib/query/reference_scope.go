@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -617,15 +618,54 @@ func (rs *ReferenceScope) DeclareAggregateFunction(expr parser.AggregateDeclarat
 	return rs.blocks[0].functions.DeclareAggregate(expr)
 }
 
+func (rs *ReferenceScope) DeclareExternalFunction(expr parser.ExternalFunctionDeclaration, fn *ExternalFunction) error {
+	return rs.blocks[0].functions.DeclareExternal(expr, fn)
+}
+
 func (rs *ReferenceScope) GetFunction(expr parser.QueryExpression, name string) (*UserDefinedFunction, error) {
 	for i := range rs.blocks {
 		if fn, ok := rs.blocks[i].functions.Get(expr, name); ok {
 			return fn, nil
 		}
 	}
+	if fn, ok := rs.getPlugin(name); ok {
+		return fn, nil
+	}
 	return nil, NewFunctionNotExistError(expr, name)
 }
 
+func (rs *ReferenceScope) getPlugin(name string) (*UserDefinedFunction, bool) {
+	if rs.Tx == nil || rs.Tx.Environment == nil {
+		return nil, false
+	}
+
+	uname := strings.ToUpper(name)
+	plugin, ok := rs.Tx.Environment.Plugins[uname]
+	if !ok {
+		return nil, false
+	}
+
+	fn := rs.Tx.ExternalFunctions.LoadPlugin(uname, func() *UserDefinedFunction {
+		timeout := DefaultExternalFunctionTimeout
+		if plugin.Timeout != nil {
+			timeout = time.Duration(*plugin.Timeout * float64(time.Second))
+		}
+
+		command, err := splitExternalCommand(context.Background(), rs, plugin.Command)
+		external := NewExternalFunction(uname, command, plugin.Aggregate, timeout, rs.Tx.Session.Stderr())
+		if err != nil {
+			external.startErr = err
+		}
+
+		return &UserDefinedFunction{
+			Name:        parser.Identifier{Literal: uname},
+			IsAggregate: plugin.Aggregate,
+			External:    external,
+		}
+	})
+	return fn, true
+}
+
 func (rs *ReferenceScope) DisposeFunction(name parser.Identifier) error {
 	for i := range rs.blocks {
 		if rs.blocks[i].functions.Dispose(name) {
@@ -13,6 +13,8 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
+
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 var (
@@ -181,7 +183,8 @@ type Session struct {
 	stdinViewMap ViewMap
 	stdinLocker  *StdinLocker
 
-	mtx *sync.Mutex
+	mtx         *sync.Mutex
+	terminalMtx *sync.RWMutex
 }
 
 func NewSession() *Session {
@@ -199,7 +202,8 @@ func NewSession() *Session {
 		stdinViewMap: NewViewMap(),
 		stdinLocker:  NewStdinLocker(),
 
-		mtx: &sync.Mutex{},
+		mtx:         &sync.Mutex{},
+		terminalMtx: &sync.RWMutex{},
 	}
 }
 
@@ -207,6 +211,16 @@ func (sess *Session) ScreenFd() uintptr {
 	return sess.screenFd
 }
 
+// ScreenWidth returns the width of the terminal on which the session is running.
+func (sess *Session) ScreenWidth() (int, error) {
+	if t := sess.Terminal(); t != nil {
+		w, _, err := t.GetSize()
+		return w, err
+	}
+	w, _, err := terminal.GetSize(int(sess.screenFd))
+	return w, err
+}
+
 func (sess *Session) Stdin() io.ReadCloser {
 	return sess.stdin
 }
@@ -223,8 +237,13 @@ func (sess *Session) OutFile() io.Writer {
 	return sess.outFile
 }
 
+// Terminal returns the virtual terminal of the interactive shell, or nil if the shell is not running.
+// It is safe to call from other goroutines such as signal handlers.
 func (sess *Session) Terminal() VirtualTerminal {
-	return sess.terminal
+	sess.terminalMtx.RLock()
+	t := sess.terminal
+	sess.terminalMtx.RUnlock()
+	return t
 }
 
 func (sess *Session) SetStdin(r io.ReadCloser) error {
@@ -268,7 +287,9 @@ func (sess *Session) SetOutFile(w io.Writer) {
 
 func (sess *Session) SetTerminal(t VirtualTerminal) {
 	sess.mtx.Lock()
+	sess.terminalMtx.Lock()
 	sess.terminal = t
+	sess.terminalMtx.Unlock()
 	sess.mtx.Unlock()
 }
 
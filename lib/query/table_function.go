@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	ValueColumn      = "value"
+	OrdinalityColumn = "ordinality"
+)
+
+func loadTableFunction(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction) (*View, error) {
+	switch strings.ToUpper(expr.Name) {
+	case "DIFF":
+		return DiffTable(ctx, scope, expr)
+	case "GENERATE_SERIES":
+		return GenerateSeries(ctx, scope, expr)
+	case "UNNEST":
+		return Unnest(ctx, scope, expr)
+	case "SPLIT_TO_TABLE":
+		return SplitToTable(ctx, scope, expr)
+	}
+	return nil, NewFunctionNotExistError(expr, expr.Name)
+}
+
+// evalTableFunctionTableArgument converts an argument of a table function to a table identifier.
+// Field references are treated as table names, and other values are evaluated as file paths.
+func evalTableFunctionTableArgument(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction, arg parser.QueryExpression) (parser.QueryExpression, error) {
+	if fr, ok := arg.(parser.FieldReference); ok {
+		lit := fr.Column.Literal
+		if 0 < len(fr.View.Literal) {
+			lit = fr.View.Literal + "." + lit
+		}
+		return parser.Identifier{BaseExpr: fr.BaseExpr, Literal: lit, Quoted: fr.Column.Quoted}, nil
+	}
+
+	p, err := Evaluate(ctx, scope, arg)
+	if err != nil {
+		return nil, err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "table must be specified")
+	}
+	path := s.(*value.String).Raw()
+	value.Discard(s)
+
+	if strings.EqualFold(path, "STDIN") {
+		return parser.Stdin{BaseExpr: expr.BaseExpr}, nil
+	}
+	return parser.Identifier{BaseExpr: expr.BaseExpr, Literal: path}, nil
+}
+
+// evalTableFunctionColumnArgument converts an argument of a table function to a column name.
+func evalTableFunctionColumnArgument(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction, arg parser.QueryExpression) (string, error) {
+	if fr, ok := arg.(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+		return fr.Column.Literal, nil
+	}
+
+	p, err := Evaluate(ctx, scope, arg)
+	if err != nil {
+		return "", err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return "", NewFunctionInvalidArgumentError(expr, expr.Name, "column name must be a string")
+	}
+	name := s.(*value.String).Raw()
+	value.Discard(s)
+	return name, nil
+}
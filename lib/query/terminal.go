@@ -3,6 +3,7 @@ package query
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -18,6 +19,9 @@ const (
 	TerminalContinuousPrompt string = "     > "
 )
 
+// ErrInterrupt is returned by ReadLine of VirtualTerminal when the input is interrupted with Ctrl+C.
+var ErrInterrupt = errors.New("interrupt")
+
 type VirtualTerminal interface {
 	ReadLine() (string, error)
 	Write(string) error
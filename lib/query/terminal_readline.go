@@ -15,12 +15,13 @@ import (
 )
 
 type ReadLineTerminal struct {
-	terminal  *readline.Instance
-	fd        int
-	prompt    *Prompt
-	env       *cmd.Environment
-	completer *Completer
-	tx        *Transaction
+	terminal    *readline.Instance
+	fd          int
+	prompt      *Prompt
+	env         *cmd.Environment
+	completer   *Completer
+	highlighter *Highlighter
+	tx          *Transaction
 }
 
 func NewTerminal(ctx context.Context, scope *ReferenceScope) (VirtualTerminal, error) {
@@ -35,6 +36,7 @@ func NewTerminal(ctx context.Context, scope *ReferenceScope) (VirtualTerminal, e
 
 	prompt := NewPrompt(scope)
 	completer := NewCompleter(scope)
+	highlighter := NewHighlighter(scope.Tx)
 
 	t, err := readline.NewEx(&readline.Config{
 		HistoryFile:            historyFile,
@@ -42,6 +44,7 @@ func NewTerminal(ctx context.Context, scope *ReferenceScope) (VirtualTerminal, e
 		HistoryLimit:           limit,
 		HistorySearchFold:      true,
 		Listener:               new(ReadlineListener),
+		Painter:                highlighter,
 		Stdin:                  readline.NewCancelableStdin(scope.Tx.Session.Stdin()),
 		Stdout:                 scope.Tx.Session.Stdout(),
 		Stderr:                 scope.Tx.Session.Stderr(),
@@ -51,12 +54,13 @@ func NewTerminal(ctx context.Context, scope *ReferenceScope) (VirtualTerminal, e
 	}
 
 	terminal := ReadLineTerminal{
-		terminal:  t,
-		fd:        fd,
-		prompt:    prompt,
-		env:       scope.Tx.Environment,
-		completer: completer,
-		tx:        scope.Tx,
+		terminal:    t,
+		fd:          fd,
+		prompt:      prompt,
+		env:         scope.Tx.Environment,
+		completer:   completer,
+		highlighter: highlighter,
+		tx:          scope.Tx,
 	}
 
 	terminal.setCompleter()
@@ -75,7 +79,11 @@ func (t ReadLineTerminal) Teardown() error {
 }
 
 func (t ReadLineTerminal) ReadLine() (string, error) {
-	return t.terminal.Readline()
+	line, err := t.terminal.Readline()
+	if err == readline.ErrInterrupt {
+		err = ErrInterrupt
+	}
+	return line, err
 }
 
 func (t ReadLineTerminal) Write(s string) error {
@@ -93,6 +101,7 @@ func (t ReadLineTerminal) SetPrompt(ctx context.Context) {
 	if err != nil {
 		t.tx.LogError(err.Error())
 	}
+	t.highlighter.SetContinued(false)
 	t.terminal.SetPrompt(str)
 }
 
@@ -101,6 +110,7 @@ func (t ReadLineTerminal) SetContinuousPrompt(ctx context.Context) {
 	if err != nil {
 		t.tx.LogError(err.Error())
 	}
+	t.highlighter.SetContinued(true)
 	t.terminal.SetPrompt(str)
 }
 
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -32,6 +33,7 @@ type Transaction struct {
 
 	cachedViews      ViewMap
 	uncommittedViews UncommittedViews
+	loadedFiles      *SyncMap
 
 	operationMutex   *sync.Mutex
 	viewLoadingMutex *sync.Mutex
@@ -40,6 +42,7 @@ type Transaction struct {
 	flagMutex *sync.RWMutex
 
 	PreparedStatements PreparedStatementMap
+	ExternalFunctions  *ExternalFunctionContainer
 
 	SelectedViews []*View
 	AffectedRows  int
@@ -70,11 +73,13 @@ func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retry
 		FileContainer:      file.NewContainer(),
 		cachedViews:        NewViewMap(),
 		uncommittedViews:   NewUncommittedViews(),
+		loadedFiles:        NewSyncMap(),
 		operationMutex:     &sync.Mutex{},
 		viewLoadingMutex:   &sync.Mutex{},
 		stdinIsLocked:      false,
 		flagMutex:          &sync.RWMutex{},
 		PreparedStatements: NewPreparedStatementMap(),
+		ExternalFunctions:  NewExternalFunctionContainer(),
 		SelectedViews:      nil,
 		AffectedRows:       0,
 		AutoCommit:         false,
@@ -166,6 +171,9 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if err := SaveConstraints(f.Path, f.Constraints); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), tx.Flags.Quiet)
 	}
@@ -173,6 +181,9 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if err := SaveConstraints(f.Path, f.Constraints); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), tx.Flags.Quiet)
 	}
@@ -221,6 +232,16 @@ func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) e
 	return nil
 }
 
+// LoadedFilePaths returns the paths of the files that have been loaded since the last call of ClearLoadedFilePaths.
+// The paths remain after the loaded views are released by commit or rollback.
+func (tx *Transaction) LoadedFilePaths() []string {
+	return tx.loadedFiles.SortedKeys()
+}
+
+func (tx *Transaction) ClearLoadedFilePaths() {
+	tx.loadedFiles.Clear()
+}
+
 func (tx *Transaction) quietForTemporaryViews(expr parser.Expression) bool {
 	return tx.Flags.Quiet || expr == nil
 }
@@ -244,6 +265,7 @@ func (tx *Transaction) ReleaseResourcesWithErrors() error {
 	if err := tx.FileContainer.CloseAllWithErrors(); err != nil {
 		errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
 	}
+	_ = tx.ExternalFunctions.CloseAll()
 	tx.UnlockStdin()
 	return file.NewForcedUnlockError(errs)
 }
@@ -322,6 +344,26 @@ func (tx *Transaction) LogWarn(log string, quiet bool) {
 	}
 }
 
+// reportLenientLoad warns of the rows adjusted or skipped while loading the file in lenient mode,
+// and appends the skipped rows to the reject file if it is specified.
+func (tx *Transaction) reportLenientLoad(expr parser.QueryExpression, fileInfo *FileInfo) error {
+	summary := fileInfo.lenientLoadSummary
+	if summary == nil {
+		return nil
+	}
+	fileInfo.lenientLoadSummary = nil
+
+	tx.LogWarn(fmt.Sprintf("%s: %s.", fileInfo.Path, summary.String()), tx.Flags.Quiet)
+
+	if len(tx.Flags.ImportOptions.RejectFile) < 1 || len(summary.Rejected) < 1 {
+		return nil
+	}
+	if err := appendRejectedLines(tx.Flags.ImportOptions.RejectFile, fileInfo.Path, summary.Rejected); err != nil {
+		return NewIOError(expr, err.Error())
+	}
+	return nil
+}
+
 func (tx *Transaction) LogError(log string) {
 	if err := tx.Session.WriteToStderrWithLineBreak(tx.Error(log)); err != nil {
 		println(err.Error())
@@ -418,6 +460,48 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.LenientFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetLenient(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.RejectFileFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetRejectFile(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SkipLinesFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetSkipLines(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.CommentFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetComment(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.QuoteFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetQuote(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.QuoteEscapeFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetQuoteEscape(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.LocaleFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetLocale(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.FormatFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetFormat(s, outFile)
@@ -442,6 +526,18 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.ExportQuoteFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetWriteQuote(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ExportQuoteEscapeFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetWriteQuoteEscape(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.WithoutHeaderFlag:
 		if b, ok := value.(bool); ok {
 			tx.Flags.SetWithoutHeader(b)
@@ -472,6 +568,42 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.XmlRootElementFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetXmlRootElement(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.XmlRowElementFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetXmlRowElement(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SqlTableFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetSqlTable(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SqlDialectFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetSqlDialect(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.BorderStyleFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetBorderStyle(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ExpandedFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetExpanded(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.StripEndingLineBreakFlag:
 		if b, ok := value.(bool); ok {
 			tx.Flags.SetStripEndingLineBreak(b)
@@ -562,7 +694,7 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 	case cmd.ImportFormatFlag:
 		val = value.NewString(tx.Flags.ImportOptions.Format.String())
 	case cmd.DelimiterFlag:
-		val = value.NewString(string(tx.Flags.ImportOptions.Delimiter))
+		val = value.NewString(cmd.ImportDelimiterToString(tx.Flags.ImportOptions.Delimiter))
 	case cmd.DelimiterPositionsFlag:
 		s := fixedlen.DelimiterPositions(tx.Flags.ImportOptions.DelimiterPositions).String()
 		if tx.Flags.ImportOptions.SingleLine {
@@ -572,15 +704,29 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 	case cmd.JsonQueryFlag:
 		val = value.NewString(tx.Flags.ImportOptions.JsonQuery)
 	case cmd.EncodingFlag:
-		val = value.NewString(tx.Flags.ImportOptions.Encoding.String())
+		val = value.NewString(charset.String(tx.Flags.ImportOptions.Encoding))
 	case cmd.NoHeaderFlag:
 		val = value.NewBoolean(tx.Flags.ImportOptions.NoHeader)
 	case cmd.WithoutNullFlag:
 		val = value.NewBoolean(tx.Flags.ImportOptions.WithoutNull)
+	case cmd.LenientFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.Lenient)
+	case cmd.RejectFileFlag:
+		val = value.NewString(tx.Flags.ImportOptions.RejectFile)
+	case cmd.SkipLinesFlag:
+		val = value.NewInteger(int64(tx.Flags.ImportOptions.SkipLines))
+	case cmd.CommentFlag:
+		val = value.NewString(tx.Flags.ImportOptions.Comment)
+	case cmd.QuoteFlag:
+		val = value.NewString(string(tx.Flags.ImportOptions.Quote))
+	case cmd.QuoteEscapeFlag:
+		val = value.NewString(tx.Flags.ImportOptions.QuoteEscape.String())
+	case cmd.LocaleFlag:
+		val = value.NewString(tx.Flags.ImportOptions.Locale)
 	case cmd.FormatFlag:
 		val = value.NewString(tx.Flags.ExportOptions.Format.String())
 	case cmd.ExportEncodingFlag:
-		val = value.NewString(tx.Flags.ExportOptions.Encoding.String())
+		val = value.NewString(charset.String(tx.Flags.ExportOptions.Encoding))
 	case cmd.ExportDelimiterFlag:
 		val = value.NewString(string(tx.Flags.ExportOptions.Delimiter))
 	case cmd.ExportDelimiterPositionsFlag:
@@ -589,6 +735,10 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 			s = "S" + s
 		}
 		val = value.NewString(s)
+	case cmd.ExportQuoteFlag:
+		val = value.NewString(string(tx.Flags.ExportOptions.Quote))
+	case cmd.ExportQuoteEscapeFlag:
+		val = value.NewString(tx.Flags.ExportOptions.QuoteEscape.String())
 	case cmd.WithoutHeaderFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.WithoutHeader)
 	case cmd.LineBreakFlag:
@@ -599,6 +749,18 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(cmd.JsonEscapeTypeToString(tx.Flags.ExportOptions.JsonEscape))
 	case cmd.PrettyPrintFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.PrettyPrint)
+	case cmd.XmlRootElementFlag:
+		val = value.NewString(tx.Flags.ExportOptions.XmlRootElement)
+	case cmd.XmlRowElementFlag:
+		val = value.NewString(tx.Flags.ExportOptions.XmlRowElement)
+	case cmd.SqlTableFlag:
+		val = value.NewString(tx.Flags.ExportOptions.SqlTable)
+	case cmd.SqlDialectFlag:
+		val = value.NewString(cmd.SqlDialectToString(tx.Flags.ExportOptions.SqlDialect))
+	case cmd.BorderStyleFlag:
+		val = value.NewString(cmd.BorderStyleToString(tx.Flags.ExportOptions.BorderStyle))
+	case cmd.ExpandedFlag:
+		val = value.NewString(tx.Flags.ExportOptions.Expanded.String())
 	case cmd.StripEndingLineBreakFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.StripEndingLineBreak)
 	case cmd.EastAsianEncodingFlag:
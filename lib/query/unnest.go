@@ -0,0 +1,102 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+// Unnest returns a view that has a row for each element of a JSON array.
+//
+// Objects and arrays in the elements are returned as JSON strings.
+// A JSON scalar value is regarded as an array that has only the value,
+// and a null, an empty string or a JSON null returns a view that has no records.
+func Unnest(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction) (*View, error) {
+	if len(expr.Args) != 1 {
+		return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+	}
+
+	p, err := Evaluate(ctx, scope, expr.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var values []value.Primary
+	s := value.ToString(p)
+	if !value.IsNull(s) {
+		text := s.(*value.String).Raw()
+		value.Discard(s)
+
+		if 0 < len(strings.TrimSpace(text)) {
+			structure, _, err := json.Decode(text)
+			if err != nil {
+				return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the argument must be a JSON array")
+			}
+
+			switch structure.(type) {
+			case txjson.Array:
+				values = json.ConvertToArray(structure.(txjson.Array))
+			case txjson.Object:
+				return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the argument must be a JSON array")
+			case txjson.Null:
+			default:
+				values = []value.Primary{json.ConvertToValue(structure)}
+			}
+		}
+	}
+
+	return newElementsView(values), nil
+}
+
+// SplitToTable returns a view that has a row for each substring of a string separated by a separator.
+func SplitToTable(ctx context.Context, scope *ReferenceScope, expr parser.TableFunction) (*View, error) {
+	if len(expr.Args) != 2 {
+		return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{2})
+	}
+
+	args := make([]value.Primary, len(expr.Args))
+	for i, arg := range expr.Args {
+		p, err := Evaluate(ctx, scope, arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = p
+	}
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "the second argument must be a string")
+	}
+	sepStr := sep.(*value.String).Raw()
+	value.Discard(sep)
+
+	var values []value.Primary
+	s := value.ToString(args[0])
+	if !value.IsNull(s) {
+		list := strings.Split(s.(*value.String).Raw(), sepStr)
+		value.Discard(s)
+
+		values = make([]value.Primary, len(list))
+		for i := range list {
+			values[i] = value.NewString(list[i])
+		}
+	}
+
+	return newElementsView(values), nil
+}
+
+func newElementsView(values []value.Primary) *View {
+	view := &View{
+		Header:    NewHeader("", []string{ValueColumn, OrdinalityColumn}),
+		RecordSet: make(RecordSet, len(values)),
+	}
+	for i, v := range values {
+		view.RecordSet[i] = NewRecord([]value.Primary{v, value.NewInteger(int64(i + 1))})
+	}
+	return view
+}
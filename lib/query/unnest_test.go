@@ -0,0 +1,205 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var unnestTests = []struct {
+	Name   string
+	Args   []parser.QueryExpression
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Unnest",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("[1, \"a\", null, {\"b\": true}]"),
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewNull(), value.NewInteger(3)}),
+				NewRecord([]value.Primary{value.NewString("{\"b\":true}"), value.NewInteger(4)}),
+			},
+		},
+	},
+	{
+		Name: "Unnest Null",
+		Args: []parser.QueryExpression{
+			parser.NewNullValue(),
+		},
+		Result: &View{
+			Header:    NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{},
+		},
+	},
+	{
+		Name: "Unnest Empty String",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue(" "),
+		},
+		Result: &View{
+			Header:    NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{},
+		},
+	},
+	{
+		Name: "Unnest JSON Null",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("null"),
+		},
+		Result: &View{
+			Header:    NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{},
+		},
+	},
+	{
+		Name: "Unnest Scalar",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("\"a\""),
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+			},
+		},
+	},
+	{
+		Name: "Unnest Integer",
+		Args: []parser.QueryExpression{
+			parser.NewIntegerValue(5),
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewInteger(5), value.NewInteger(1)}),
+			},
+		},
+	},
+	{
+		Name: "Unnest Arguments Length Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("[1]"),
+			parser.NewStringValue("[2]"),
+		},
+		Error: "function unnest takes exactly 1 argument",
+	},
+	{
+		Name: "Unnest Not Array Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("{\"a\": 1}"),
+		},
+		Error: "the argument must be a JSON array for function unnest",
+	},
+	{
+		Name: "Unnest Invalid JSON Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("a, b"),
+		},
+		Error: "the argument must be a JSON array for function unnest",
+	},
+}
+
+func TestUnnest(t *testing.T) {
+	ctx := context.Background()
+	for _, v := range unnestTests {
+		expr := parser.TableFunction{Name: "unnest", Args: v.Args}
+		result, err := Unnest(ctx, NewReferenceScope(TestTx), expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var splitToTableTests = []struct {
+	Name   string
+	Args   []parser.QueryExpression
+	Result *View
+	Error  string
+}{
+	{
+		Name: "SplitToTable",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("a;b;;c"),
+			parser.NewStringValue(";"),
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("b"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewString(""), value.NewInteger(3)}),
+				NewRecord([]value.Primary{value.NewString("c"), value.NewInteger(4)}),
+			},
+		},
+	},
+	{
+		Name: "SplitToTable Null",
+		Args: []parser.QueryExpression{
+			parser.NewNullValue(),
+			parser.NewStringValue(";"),
+		},
+		Result: &View{
+			Header:    NewHeader("", []string{"value", "ordinality"}),
+			RecordSet: RecordSet{},
+		},
+	},
+	{
+		Name: "SplitToTable Arguments Length Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("a;b"),
+		},
+		Error: "function split_to_table takes exactly 2 arguments",
+	},
+	{
+		Name: "SplitToTable Separator Error",
+		Args: []parser.QueryExpression{
+			parser.NewStringValue("a;b"),
+			parser.NewNullValue(),
+		},
+		Error: "the second argument must be a string for function split_to_table",
+	},
+}
+
+func TestSplitToTable(t *testing.T) {
+	ctx := context.Background()
+	for _, v := range splitToTableTests {
+		expr := parser.TableFunction{Name: "split_to_table", Args: v.Args}
+		result, err := SplitToTable(ctx, NewReferenceScope(TestTx), expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
@@ -88,6 +88,19 @@ func (m UserDefinedFunctionMap) DeclareAggregate(expr parser.AggregateDeclaratio
 	return nil
 }
 
+func (m UserDefinedFunctionMap) DeclareExternal(expr parser.ExternalFunctionDeclaration, external *ExternalFunction) error {
+	if err := m.CheckDuplicate(expr.Name); err != nil {
+		return err
+	}
+
+	m.Store(expr.Name.Literal, &UserDefinedFunction{
+		Name:        expr.Name,
+		IsAggregate: expr.IsAggregate(),
+		External:    external,
+	})
+	return nil
+}
+
 func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssignment) ([]parser.Variable, map[string]parser.QueryExpression, int, error) {
 	var isDuplicate = func(variable parser.Variable, variables []parser.Variable) bool {
 		for _, v := range variables {
@@ -143,7 +156,10 @@ func (m UserDefinedFunctionMap) Get(fn parser.QueryExpression, name string) (*Us
 }
 
 func (m UserDefinedFunctionMap) Dispose(name parser.Identifier) bool {
-	if m.Exists(name.Literal) {
+	if fn, ok := m.Load(name.Literal); ok {
+		if fn.External != nil {
+			_ = fn.External.Close()
+		}
 		m.Delete(name.Literal)
 		return true
 	}
@@ -159,9 +175,19 @@ type UserDefinedFunction struct {
 
 	IsAggregate bool
 	Cursor      parser.Identifier // For Aggregate Functions
+
+	External *ExternalFunction // For External Functions
+}
+
+func (fn *UserDefinedFunction) IsExternal() bool {
+	return fn.External != nil
 }
 
 func (fn *UserDefinedFunction) Execute(ctx context.Context, scope *ReferenceScope, args []value.Primary) (value.Primary, error) {
+	if fn.IsExternal() {
+		return fn.executeExternal(ctx, nil, args)
+	}
+
 	childScope := scope.CreateChild()
 	defer childScope.CloseCurrentBlock()
 
@@ -169,6 +195,10 @@ func (fn *UserDefinedFunction) Execute(ctx context.Context, scope *ReferenceScop
 }
 
 func (fn *UserDefinedFunction) ExecuteAggregate(ctx context.Context, scope *ReferenceScope, values []value.Primary, args []value.Primary) (value.Primary, error) {
+	if fn.IsExternal() {
+		return fn.executeExternal(ctx, values, args)
+	}
+
 	childScope := scope.CreateChild()
 	defer childScope.CloseCurrentBlock()
 
@@ -179,6 +209,13 @@ func (fn *UserDefinedFunction) ExecuteAggregate(ctx context.Context, scope *Refe
 }
 
 func (fn *UserDefinedFunction) CheckArgsLen(expr parser.QueryExpression, name string, argsLen int) error {
+	if fn.IsExternal() {
+		if fn.IsAggregate && argsLen < 0 {
+			return NewFunctionArgumentLengthErrorWithCustomArgs(expr, name, fmt.Sprintf("at least %s", FormatCount(1, "argument")))
+		}
+		return nil
+	}
+
 	parametersLen := len(fn.Parameters)
 	requiredLen := fn.RequiredArgs
 	if fn.IsAggregate {
@@ -233,3 +270,14 @@ func (fn *UserDefinedFunction) execute(ctx context.Context, scope *ReferenceScop
 
 	return ret, nil
 }
+
+func (fn *UserDefinedFunction) executeExternal(ctx context.Context, values []value.Primary, args []value.Primary) (value.Primary, error) {
+	ret, err := fn.External.Call(ctx, values, args)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewExternalFunctionError(fn.Name, fn.Name.Literal, err.Error())
+		}
+		return nil, err
+	}
+	return ret, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	gojson "encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,15 +15,20 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/sniffer"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xml"
+	"github.com/mithrandie/csvq/lib/yaml"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/csv"
 	"github.com/mithrandie/go-text/fixedlen"
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/go-text/ltsv"
 	"github.com/mithrandie/ternary"
 )
@@ -126,6 +132,10 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		skipLinesIdx := 3
+		commentIdx := 4
+		quoteIdx := 5
+		quoteEscapeIdx := 6
 
 		switch tableObject.Type.Token {
 		case parser.CSV:
@@ -137,11 +147,14 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			s := felem.(*value.String).Raw()
 			d := []rune(s)
+			if strings.EqualFold(s, cmd.DetectDelimiterAutomatically) {
+				d = []rune{cmd.AutoDelimiter}
+			}
 			if 1 != len(d) {
 				return nil, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
 			}
-			if 3 < len(tableObject.Args) {
-				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
+			if 7 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 9)
 			}
 			options.Delimiter = d[0]
 			if options.Delimiter == '\t' {
@@ -193,11 +206,35 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			options.Format = cmd.LTSV
 			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
+		case parser.XML:
+			if felem == nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, "row path is not specified")
+			}
+			if value.IsNull(felem) {
+				return nil, NewTableObjectInvalidXmlRowPathError(tableObject, tableObject.FormatElement.String())
+			}
+			if 1 < len(tableObject.Args) {
+				return nil, NewTableObjectXmlArgumentsLengthError(tableObject)
+			}
+			options.JsonQuery = felem.(*value.String).Raw()
+			options.Format = cmd.XML
+		case parser.YAML:
+			if felem == nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, "json query is not specified")
+			}
+			if value.IsNull(felem) {
+				return nil, NewTableObjectInvalidJsonQueryError(tableObject, tableObject.FormatElement.String())
+			}
+			if 1 < len(tableObject.Args) {
+				return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 3)
+			}
+			options.JsonQuery = felem.(*value.String).Raw()
+			options.Format = cmd.YAML
 		default:
 			return nil, NewInvalidTableObjectError(tableObject, tableObject.Type.Literal)
 		}
 
-		args := make([]value.Primary, 3)
+		args := make([]value.Primary, 7)
 		defer func() {
 			for i := range args {
 				if args[i] != nil {
@@ -241,6 +278,34 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				} else {
 					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[withoutNullIdx].String()))
 				}
+			case skipLinesIdx:
+				v := value.ToInteger(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a skip-lines value: %s", tableObject.Args[skipLinesIdx].String()))
+				}
+			case commentIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a comment value: %s", tableObject.Args[commentIdx].String()))
+				}
+			case quoteIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a quote value: %s", tableObject.Args[quoteIdx].String()))
+				}
+			case quoteEscapeIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a quote-escape value: %s", tableObject.Args[quoteEscapeIdx].String()))
+				}
 			}
 		}
 
@@ -255,6 +320,24 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		if args[withoutNullIdx] != nil {
 			options.WithoutNull = args[withoutNullIdx].(*value.Boolean).Raw()
 		}
+		if args[skipLinesIdx] != nil {
+			if options.SkipLines = int(args[skipLinesIdx].(*value.Integer).Raw()); options.SkipLines < 0 {
+				options.SkipLines = 0
+			}
+		}
+		if args[commentIdx] != nil {
+			options.Comment = args[commentIdx].(*value.String).Raw()
+		}
+		if args[quoteIdx] != nil {
+			if options.Quote, err = cmd.ParseQuote(args[quoteIdx].(*value.String).Raw()); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+		}
+		if args[quoteEscapeIdx] != nil {
+			if options.QuoteEscape, err = cmd.ParseQuoteEscape(args[quoteEscapeIdx].(*value.String).Raw()); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+		}
 
 		view, err = loadObject(
 			ctx,
@@ -300,12 +383,11 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 
 			joinTableName := t.Name()
 
-			subquery := t.Object.(parser.Subquery)
 			var hfields Header
 			resultSetList := make([]RecordSet, view.RecordLen())
 
 			if err := EvaluateSequentially(ctx, scope, view, func(seqScope *ReferenceScope, rIdx int) error {
-				appliedView, err := Select(ctx, seqScope, subquery.Query)
+				appliedView, err := loadLaterableTable(ctx, seqScope, t.Object)
 				if err != nil {
 					return err
 				}
@@ -420,6 +502,22 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			return nil, err
 		}
 
+	case parser.TableFunction:
+		view, err = loadTableFunction(ctx, scope, table.Object.(parser.TableFunction))
+		if err != nil {
+			return nil, err
+		}
+
+		if 0 < len(tableName.Literal) {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+
+			if err = view.Header.Update(tableName.Literal, nil); err != nil {
+				return nil, err
+			}
+		}
+
 	case parser.Subquery:
 		subquery := table.Object.(parser.Subquery)
 		view, err = Select(ctx, scope, subquery.Query)
@@ -441,6 +539,13 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 	return view, err
 }
 
+func loadLaterableTable(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) (*View, error) {
+	if fn, ok := expr.(parser.TableFunction); ok {
+		return loadTableFunction(ctx, scope, fn)
+	}
+	return Select(ctx, scope, expr.(parser.Subquery).Query)
+}
+
 func joinViews(ctx context.Context, scope *ReferenceScope, view *View, joinView *View, join parser.Join) error {
 	condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, joinView)
 	if err != nil {
@@ -546,6 +651,9 @@ func loadStdin(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, s
 		if err != nil {
 			return nil, err
 		}
+		if err = scope.Tx.reportLenientLoad(stdin, view.FileInfo); err != nil {
+			return nil, err
+		}
 		scope.Global().temporaryTables.Set(view)
 	}
 
@@ -603,8 +711,13 @@ func loadObject(
 			Encoding:           options.Encoding,
 			LineBreak:          scope.Tx.Flags.ExportOptions.LineBreak,
 			NoHeader:           options.NoHeader,
+			QuoteEscape:        options.QuoteEscape,
+			SkipLines:          options.SkipLines,
+			Comment:            options.Comment,
+			Locale:             options.Locale,
 			ViewType:           ViewTypeStdin,
 		}
+		fileInfo.setQuoteMark(options.Quote)
 		return loadStdin(ctx, scope, fileInfo, stdin, tableName, forUpdate, useInternalId)
 	}
 
@@ -734,6 +847,11 @@ func cacheViewFromFile(
 			fileInfo.JsonQuery = cmd.TrimSpace(options.JsonQuery)
 			fileInfo.LineBreak = scope.Tx.Flags.ExportOptions.LineBreak
 			fileInfo.NoHeader = options.NoHeader
+			fileInfo.setQuoteMark(options.Quote)
+			fileInfo.QuoteEscape = options.QuoteEscape
+			fileInfo.SkipLines = options.SkipLines
+			fileInfo.Comment = options.Comment
+			fileInfo.Locale = options.Locale
 			fileInfo.EncloseAll = scope.Tx.Flags.ExportOptions.EncloseAll
 			fileInfo.JsonEscape = scope.Tx.Flags.ExportOptions.JsonEscape
 
@@ -741,6 +859,11 @@ func cacheViewFromFile(
 				fileInfo = view.FileInfo
 			}
 
+			if forUpdate && scope.Tx.Flags.ImportOptions.Lenient && (fileInfo.Format == cmd.CSV || fileInfo.Format == cmd.TSV) {
+				tableIdentifier.Literal = fileInfo.Path
+				return filePath, NewLenientLoadForUpdateError(tableIdentifier)
+			}
+
 			if err = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, fileInfo.Path); err != nil {
 				return filePath, err
 			}
@@ -773,8 +896,18 @@ func cacheViewFromFile(
 				}
 				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
 			}
+			if forUpdate {
+				if loadView.FileInfo.Constraints, err = LoadConstraints(fileInfo.Path, scope.Tx.Flags.DatetimeFormat); err != nil {
+					err = NewDataParsingError(tableIdentifier, ConstraintsFilePath(fileInfo.Path), err.Error())
+					return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+				}
+			}
+			if err = scope.Tx.reportLenientLoad(tableIdentifier, loadView.FileInfo); err != nil {
+				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+			}
 			loadView.FileInfo.ForUpdate = forUpdate
 			scope.Tx.cachedViews.Set(loadView)
+			scope.Tx.loadedFiles.store(loadView.FileInfo.Path, true)
 		}
 	}
 	if !cacheExists {
@@ -791,16 +924,23 @@ func loadViewFromFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, f
 		return loadViewFromLTSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
 	case cmd.JSON:
 		return loadViewFromJsonFile(fp, fileInfo, expr)
+	case cmd.XML:
+		return loadViewFromXmlFile(fp, fileInfo, expr)
+	case cmd.YAML:
+		return loadViewFromYamlFile(fp, fileInfo, expr)
 	}
-	return loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, expr)
+	return loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, flags.ImportOptions.Lenient, expr)
 }
 
 func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	enc, err := charset.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
+	if charset.IsExtended(enc) {
+		return nil, NewIOError(expr, fmt.Sprintf("fixed-length format does not support %s", charset.String(enc)))
+	}
 
 	var r io.Reader
 
@@ -846,7 +986,7 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 		}
 	}
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -869,14 +1009,25 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 	return view, nil
 }
 
-func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, lenient bool, expr parser.QueryExpression) (*View, error) {
+	enc, err := charset.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
 
-	reader, err := csv.NewReader(fp, fileInfo.Encoding)
+	if fileInfo.Delimiter == cmd.AutoDelimiter {
+		if err = detectCSVDialect(fp, fileInfo); err != nil {
+			return nil, NewIOError(expr, err.Error())
+		}
+	}
+
+	if lenient || fileInfo.UsesCSVDialectOptions() {
+		return loadViewFromCSVFileWithDialectOptions(ctx, fp, fileInfo, withoutNull, lenient)
+	}
+
+	r, enc := charset.ConvertReader(fp, fileInfo.Encoding)
+	reader, err := csv.NewReader(r, enc)
 	if err != nil {
 		return nil, err
 	}
@@ -891,7 +1042,7 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 		}
 	}
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -915,20 +1066,130 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 	return view, nil
 }
 
+func loadViewFromCSVFileWithDialectOptions(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, lenient bool) (*View, error) {
+	reader, err := newCSVReader(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	reader.Delimiter = fileInfo.Delimiter
+	reader.Quote = fileInfo.QuoteMark()
+	reader.QuoteEscape = fileInfo.QuoteEscape
+	reader.SkipLines = fileInfo.SkipLines
+	reader.Comment = fileInfo.Comment
+	reader.WithoutNull = withoutNull
+	reader.Lenient = lenient
+
+	var header []string
+	if !fileInfo.NoHeader {
+		header, err = reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if header == nil {
+		header = make([]string, reader.FieldsPerRecord)
+		for i := 0; i < reader.FieldsPerRecord; i++ {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	if reader.DetectedLineBreak != "" {
+		fileInfo.LineBreak = reader.DetectedLineBreak
+	}
+	fileInfo.EncloseAll = reader.EnclosedAll
+	if !reader.Summary.IsEmpty() {
+		fileInfo.lenientLoadSummary = &reader.Summary
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+// detectCSVDialect guesses the delimiter, the quotation mark and the existence of the header from the beginning of the file,
+// and leaves the file offset unchanged. The quotation mark is not changed if it is specified.
+func detectCSVDialect(fp io.ReadSeeker, fileInfo *FileInfo) error {
+	pos, err := fp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, sniffer.DefaultSampleSize)
+	n, err := io.ReadFull(fp, buf)
+	eof := err == io.EOF || err == io.ErrUnexpectedEOF
+	if err != nil && !eof {
+		return err
+	}
+	if _, err = fp.Seek(pos, io.SeekStart); err != nil {
+		return err
+	}
+
+	sample, err := charset.Decode(buf[:n], fileInfo.Encoding)
+	if err != nil {
+		return err
+	}
+
+	dialect := sniffer.Sniff(trimCSVPreamble(string(sample), fileInfo.SkipLines, fileInfo.Comment), eof)
+	fileInfo.Delimiter = dialect.Delimiter
+	if dialect.Delimiter == '\t' {
+		fileInfo.Format = cmd.TSV
+	}
+	if fileInfo.Quote == 0 {
+		fileInfo.setQuoteMark(dialect.Quote)
+	}
+	if !dialect.HasHeader {
+		fileInfo.NoHeader = true
+	}
+	fileInfo.DetectedDialect = &dialect
+	return nil
+}
+
+// trimCSVPreamble removes the skipped leading lines and the comment lines from a sample.
+func trimCSVPreamble(sample string, skipLines int, comment string) string {
+	if skipLines < 1 && len(comment) < 1 {
+		return sample
+	}
+
+	lines := strings.SplitAfter(sample, "\n")
+	if skipLines < len(lines) {
+		lines = lines[skipLines:]
+	} else {
+		lines = nil
+	}
+
+	var buf strings.Builder
+	for _, line := range lines {
+		if 0 < len(comment) && strings.HasPrefix(line, comment) {
+			continue
+		}
+		buf.WriteString(line)
+	}
+	return buf.String()
+}
+
 func loadViewFromLTSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	enc, err := charset.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
 
-	reader, err := ltsv.NewReader(fp, fileInfo.Encoding)
+	r, enc := charset.ConvertReader(fp, fileInfo.Encoding)
+	reader, err := ltsv.NewReader(r, enc)
 	if err != nil {
 		return nil, NewIOError(expr, err.Error())
 	}
 	reader.WithoutNull = withoutNull
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -967,9 +1228,11 @@ func fileSize(fp io.ReadSeeker) int64 {
 	return 0
 }
 
-func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (RecordSet, error) {
+func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64, fileInfo *FileInfo) (RecordSet, error) {
 	var err error
 	recordSet := make(RecordSet, 0, fileLoadingPreparedRecordSetCap)
+
+	locale := fileInfo.importLocale()
 	rowch := make(chan []text.RawText, fileLoadingBuffer)
 	pos := 0
 
@@ -986,6 +1249,13 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 			for i, v := range row {
 				if v == nil {
 					record[i] = NewCell(value.NewNull())
+				} else if locale != nil {
+					p := convertInLocale(string(v), locale)
+					if _, ok := p.(*value.String); ok {
+						record[i] = NewCell(p)
+					} else {
+						record[i] = newLocaleCell(p, string(v), locale.Name)
+					}
 				} else {
 					record[i] = NewCell(value.NewString(string(v)))
 				}
@@ -1039,6 +1309,25 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 	return recordSet, err
 }
 
+// convertInLocale converts a field written in the locale to a number or a datetime.
+// Fields that can be interpreted without the locale remain strings.
+func convertInLocale(s string, locale *cmd.Locale) value.Primary {
+	trimmed := cmd.TrimSpace(s)
+	if len(trimmed) < 1 || value.MaybeInteger(trimmed) || (locale.DecimalPoint == '.' && value.MaybeNumber(trimmed)) {
+		return value.NewString(s)
+	}
+
+	if p, ok := value.StrToNumberInLocale(trimmed, locale); ok {
+		return p
+	}
+	if _, ok := value.StrToTime(trimmed, nil); !ok {
+		if t, ok := value.StrToTimeInLocale(trimmed, locale); ok {
+			return value.NewDatetime(t)
+		}
+	}
+	return value.NewString(s)
+}
+
 func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
 	jsonText, err := ioutil.ReadAll(fp)
 	if err != nil {
@@ -1065,6 +1354,162 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExp
 	return view, nil
 }
 
+func loadViewFromXmlFile(fp io.ReadSeeker, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	declared, err := xml.DeclaredEncoding(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+	if 0 < len(declared) {
+		declaredEnc, ok := xml.ParseEncodingName(declared)
+		if !ok {
+			return nil, NewLoadXmlError(expr, fmt.Sprintf("encoding %s declared in the document is not supported", declared))
+		}
+		if fileInfo.Encoding == text.AUTO {
+			fileInfo.Encoding = declaredEnc
+		} else if !strings.EqualFold(xml.EncodingName(fileInfo.Encoding), xml.EncodingName(declaredEnc)) {
+			return nil, NewXmlEncodingMismatchError(expr, charset.String(fileInfo.Encoding), declared)
+		}
+	}
+
+	enc, err := charset.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, NewCannotDetectFileEncodingError(expr)
+	}
+	fileInfo.Encoding = enc
+
+	r, err := charset.GetTransformDecoder(fp, enc)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+
+	doc, err := xml.Decode(r)
+	if err != nil {
+		return nil, NewLoadXmlError(expr, err.Error())
+	}
+
+	rows, rowElement, err := extractXmlRows(fileInfo.JsonQuery, doc)
+	if err != nil {
+		return nil, NewLoadXmlError(expr, err.Error())
+	}
+
+	headerLabels, values, err := json.ConvertToTableValue(rows)
+	if err != nil {
+		return nil, NewLoadXmlError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(values))
+	for i := range values {
+		records[i] = NewRecord(values[i])
+	}
+
+	fileInfo.XmlRootElement = doc.Members[0].Key
+	if xml.IsName(rowElement) {
+		fileInfo.XmlRowElement = rowElement
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+// extractXmlRows returns the elements specified by the row path, and the name of the elements.
+// If the row path is empty, then the child elements of the root element are returned.
+func extractXmlRows(rowPath string, doc txjson.Object) (txjson.Array, string, error) {
+	if len(rowPath) < 1 {
+		rows, rowElement := xml.Rows(doc)
+		for _, row := range rows {
+			if _, ok := row.(txjson.Object); !ok {
+				return nil, "", errors.New("rows loaded from xml must be elements that have attributes or child elements")
+			}
+		}
+		return rows, rowElement, nil
+	}
+
+	queryString := rowPath
+	if !strings.HasSuffix(queryString, "}") {
+		queryString = queryString + "{}"
+	}
+	query, err := json.Query.Parse(queryString)
+	if err != nil {
+		return nil, "", err
+	}
+
+	extracted, err := json.Extract(query, doc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rowElement := ""
+	for query != nil {
+		switch q := query.(type) {
+		case json.Element:
+			rowElement = q.Label
+			query = q.Child
+		case json.ArrayItem:
+			query = q.Child
+		default:
+			query = nil
+		}
+	}
+
+	rows, ok := extracted.(txjson.Array)
+	if !ok {
+		return nil, "", errors.New(fmt.Sprintf("xml elements do not exist for %q", rowPath))
+	}
+	return rows, rowElement, nil
+}
+
+func loadViewFromYamlFile(fp io.ReadSeeker, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	enc, err := charset.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, NewCannotDetectFileEncodingError(expr)
+	}
+	fileInfo.Encoding = enc
+
+	r, err := charset.GetTransformDecoder(fp, enc)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+
+	data, err := yaml.Decode(r)
+	if err != nil {
+		return nil, NewLoadYamlError(expr, err.Error())
+	}
+
+	query, err := json.Query.Parse(fileInfo.JsonQuery)
+	if err != nil {
+		return nil, NewLoadYamlError(expr, err.Error())
+	}
+
+	extracted, err := json.Extract(query, data)
+	if err != nil {
+		return nil, NewLoadYamlError(expr, err.Error())
+	}
+
+	rows, ok := extracted.(txjson.Array)
+	if !ok {
+		return nil, NewLoadYamlError(expr, fmt.Sprintf("yaml sequence does not exist for %q", fileInfo.JsonQuery))
+	}
+
+	headerLabels, values, err := json.ConvertToTableValue(rows)
+	if err != nil {
+		return nil, NewLoadYamlError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(values))
+	for i := range values {
+		records[i] = NewRecord(values[i])
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadDualView() *View {
 	return &View{
 		Header:    NewEmptyHeader(1),
@@ -1562,6 +2007,8 @@ func (view *View) additionalColumns(ctx context.Context, scope *ReferenceScope,
 		ovalues := []parser.QueryExpression(nil)
 		if fn.AnalyticClause.OrderByClause != nil {
 			ovalues = fn.AnalyticClause.OrderByClause.(parser.OrderByClause).Items
+		} else if fn.OrderBy != nil {
+			ovalues = fn.OrderBy.(parser.OrderByClause).Items
 		}
 
 		if pvalues != nil {
@@ -1715,6 +2162,11 @@ func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScop
 		if err != nil {
 			return err
 		}
+	} else if expr.OrderBy != nil {
+		err := view.OrderBy(ctx, scope, expr.OrderBy.(parser.OrderByClause))
+		if err != nil {
+			return err
+		}
 	}
 
 	err := Analyze(ctx, scope, view, expr, partitionIndices)
@@ -10,8 +10,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/charset"
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/sniffer"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
@@ -31,6 +33,8 @@ var viewLoadTests = []struct {
 	DelimiterPositions []int
 	SingleLine         bool
 	JsonQuery          string
+	Lenient            bool
+	Locale             string
 	Scope              *ReferenceScope
 	Result             *View
 	ResultScope        *ReferenceScope
@@ -258,6 +262,57 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView From Stdin with Locale",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:  "id;amount;rate;date;code\n1;1.234,56;0,5;31.12.2026;007\n2;-12;1.5;2026-12-31;abc",
+		Locale: "de-DE",
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "amount", "rate", "date", "code"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewFloat(1234.56),
+					value.NewFloat(0.5),
+					value.NewDatetime(time.Date(2026, 12, 31, 0, 0, 0, 0, GetTestLocation())),
+					value.NewString("007"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("-12"),
+					value.NewString("1.5"),
+					value.NewString("2026-12-31"),
+					value.NewString("abc"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ';',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				Locale:    "de-DE",
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+		Delimiter: ';',
+	},
 	{
 		Name: "LoadView From Stdin ForUpdate",
 		From: parser.FromClause{
@@ -724,6 +779,68 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView TableObject From CSV File Detecting Windows-1252",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table_windows1252"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("AUTO"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("Café"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("Zürich"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_windows1252.csv",
+				Delimiter: ',',
+				Format:    cmd.CSV,
+				Encoding:  charset.WINDOWS1252,
+				LineBreak: text.CRLF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table_windows1252.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From Fixed-Length Text File with Unsupported Encoding",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("spaces"),
+						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("EUCJP"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "fixed-length format does not support EUCJP",
+	},
 	{
 		Name: "LoadView TableObject From TSV File",
 		From: parser.FromClause{
@@ -843,6 +960,10 @@ var viewLoadTests = []struct {
 							parser.NewStringValue("SJIS"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewTernaryValueFromString("true"),
+							parser.NewIntegerValue(1),
+							parser.NewStringValue("#"),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("DOUBLE"),
 							parser.NewStringValue("extra"),
 						},
 					},
@@ -850,7 +971,80 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "table object csv takes at most 5 arguments",
+		Error: "table object csv takes at most 9 arguments",
+	},
+	{
+		Name: "LoadView TableObject From CSV File with Dialect Options",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table_preamble"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewIntegerValue(1),
+							parser.NewStringValue("#"),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("DOUBLE"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str'1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str,2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_preamble.csv",
+				Delimiter: ',',
+				Quote:     '\'',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table_preamble.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From CSV File Quote Argument Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table_preamble"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("''"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: quote must be one character",
 	},
 	{
 		Name: "LoadView TableObject From CSV File 3rd Argument Error",
@@ -929,7 +1123,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS",
+		Error: "invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|ISO2022JP|GB18030|BIG5|EUCKR|LATIN1|WINDOWS1250|WINDOWS1251|WINDOWS1252|WINDOWS1253|WINDOWS1254|WINDOWS1255|WINDOWS1256|WINDOWS1257|WINDOWS1258",
 	},
 	{
 		Name: "LoadView TableObject From Fixed-Length File",
@@ -1302,11 +1496,306 @@ var viewLoadTests = []struct {
 						FormatElement: parser.NewStringValue("{}"),
 						Path:          parser.Identifier{Literal: "notexist"},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+	{
+		Name: "LoadView TableObject From XML File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue("catalog.book"),
+						Path:          parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"@id", "title", "price"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("title1"),
+					value.NewString("100"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("title2"),
+					value.NewNull(),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.xml",
+				Delimiter: ',',
+				JsonQuery: "catalog.book",
+				Format:    cmd.XML,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table7.xml")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From XML File with Field Selection",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue("catalog.book{`@id` as id, title}"),
+						Path:          parser.Identifier{Literal: "table7"},
+						Args:          []parser.QueryExpression{parser.NewStringValue("utf8")},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "title"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("title1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("title2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.xml",
+				Delimiter: ',',
+				JsonQuery: "catalog.book{`@id` as id, title}",
+				Format:    cmd.XML,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table7.xml")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From XML File FormatElement Is Not Specified",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.XML, Literal: "xml"},
+						Path: parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for xml: row path is not specified",
+	},
+	{
+		Name: "LoadView TableObject From XML File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue("catalog.book"),
+						Path:          parser.Identifier{Literal: "table7"},
+						Args:          []parser.QueryExpression{parser.NewStringValue("utf8"), parser.NewTernaryValueFromString("true")},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object xml takes 2 or 3 arguments",
+	},
+	{
+		Name: "LoadView TableObject From XML File Invalid Row Path",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewNullValue(),
+						Path:          parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid xml row path: NULL",
+	},
+	{
+		Name: "LoadView TableObject From XML File in Declared Encoding",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue(""),
+						Path:          parser.Identifier{Literal: "table7_latin1"},
+						Args:          []parser.QueryExpression{parser.NewStringValue("auto")},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("Café"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7_latin1.xml",
+				Delimiter: ',',
+				Format:    cmd.XML,
+				Encoding:  charset.LATIN1,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table7_latin1.xml")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From XML File Encoding Mismatch Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue(""),
+						Path:          parser.Identifier{Literal: "table7_latin1"},
+						Args:          []parser.QueryExpression{parser.NewStringValue("utf8")},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "xml loading error: encoding UTF8 is specified, but ISO-8859-1 is declared in the document",
+	},
+	{
+		Name: "LoadView TableObject From XML File Row Path Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue("catalog.notexist"),
+						Path:          parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "xml loading error: xml elements do not exist for \"catalog.notexist\"",
+	},
+	{
+		Name: "LoadView TableObject From YAML File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("servers"),
+						Path:          parser.Identifier{Literal: "table8"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"name", "port", "tags", "enabled"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("web"),
+					value.NewInteger(80),
+					value.NewString("[\"http\",\"public\"]"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("db"),
+					value.NewInteger(5432),
+					value.NewNull(),
+					value.NewBoolean(false),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table8.yaml",
+				Delimiter: ',',
+				JsonQuery: "servers",
+				Format:    cmd.YAML,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table8.yaml")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From YAML File FormatElement Is Not Specified",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.YAML, Literal: "yaml"},
+						Path: parser.Identifier{Literal: "table8"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for yaml: json query is not specified",
+	},
+	{
+		Name: "LoadView TableObject From YAML File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("servers"),
+						Path:          parser.Identifier{Literal: "table8"},
+						Args:          []parser.QueryExpression{parser.NewStringValue("utf8"), parser.NewTernaryValueFromString("true")},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object yaml takes exactly 3 arguments",
+	},
+	{
+		Name: "LoadView TableObject From YAML File Not Sequence Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue(""),
+						Path:          parser.Identifier{Literal: "table8"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "file notexist does not exist",
+		Error: "yaml loading error: yaml sequence does not exist for \"\"",
 	},
 	{
 		Name: "LoadView TableObject From LTSV File",
@@ -1707,6 +2196,194 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name:      "LoadView File with Automatically Detected Delimiter",
+		Delimiter: cmd.AutoDelimiter,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_semicolon"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_semicolon", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str;1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_semicolon.csv",
+				Delimiter: ';',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				DetectedDialect: &sniffer.Dialect{
+					Delimiter: ';',
+					Quote:     '"',
+					Quoted:    true,
+					HasHeader: true,
+				},
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_SEMICOLON": strings.ToUpper(GetTestFilePath("table_semicolon.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name:      "LoadView Single-Quoted File with Automatically Detected Delimiter",
+		Delimiter: cmd.AutoDelimiter,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_single_quoted"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_single_quoted", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str,1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("it's"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_single_quoted.csv",
+				Delimiter: ',',
+				Quote:     '\'',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				DetectedDialect: &sniffer.Dialect{
+					Delimiter: ',',
+					Quote:     '\'',
+					Quoted:    true,
+					HasHeader: true,
+				},
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_SINGLE_QUOTED": strings.ToUpper(GetTestFilePath("table_single_quoted.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name:    "LoadView File Leniently",
+		Lenient: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_ragged"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_ragged", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewNull(),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_ragged.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_RAGGED": strings.ToUpper(GetTestFilePath("table_ragged.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name:      "LoadView File Leniently ForUpdate Error",
+		Lenient:   true,
+		ForUpdate: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_ragged"},
+				},
+			},
+		},
+		Error: "file " + GetTestFilePath("table_ragged.csv") + " cannot be updated because it is loaded in lenient mode",
+	},
+	{
+		Name: "LoadView Malformed File Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_ragged"},
+				},
+			},
+		},
+		Error: "data parse error in file " + GetTestFilePath("table_ragged.csv") + ": line 2, column 7: wrong number of fields in line",
+	},
+	{
+		Name:      "LoadView No Header File with Automatically Detected Delimiter",
+		Delimiter: cmd.AutoDelimiter,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_noheader"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_noheader", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_noheader.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				NoHeader:  true,
+				DetectedDialect: &sniffer.Dialect{
+					Delimiter: ',',
+					Quote:     '"',
+					Quoted:    false,
+					HasHeader: false,
+				},
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_NOHEADER": strings.ToUpper(GetTestFilePath("table_noheader.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
 	{
 		Name: "LoadView Multiple File",
 		From: parser.FromClause{
@@ -1950,6 +2627,138 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "Lateral Cross Join with Table Function",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+						},
+						JoinTable: parser.Table{
+							Lateral: parser.Token{Token: parser.LATERAL},
+							Object: parser.TableFunction{
+								Name: "generate_series",
+								Args: []parser.QueryExpression{
+									parser.NewIntegerValue(2),
+									parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								},
+							},
+							Alias: parser.Identifier{
+								Literal: "s",
+							},
+						},
+						JoinType: parser.Token{Token: parser.CROSS, Literal: "cross"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "s", Column: "value", Number: 1, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "Lateral Left Join with Unnest",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table_unnest"},
+						},
+						JoinTable: parser.Table{
+							Lateral: parser.Token{Token: parser.LATERAL},
+							Object: parser.TableFunction{
+								Name: "unnest",
+								Args: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "tags"}},
+								},
+							},
+							Alias: parser.Identifier{
+								Literal: "t",
+							},
+						},
+						Direction: parser.Token{Token: parser.LEFT, Literal: "left"},
+						Condition: parser.JoinCondition{
+							On: parser.NewTernaryValueFromString("true"),
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table_unnest", Column: "id", Number: 1, IsFromTable: true},
+				{View: "table_unnest", Column: "tags", Number: 2, IsFromTable: true},
+				{View: "t", Column: "value", Number: 1, IsFromTable: true},
+				{View: "t", Column: "ordinality", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("[\"a\",\"b\"]"),
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("[\"a\",\"b\"]"),
+					value.NewString("b"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("\"c\""),
+					value.NewString("c"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewString(""),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_UNNEST": strings.ToUpper(GetTestFilePath("table_unnest.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
 	{
 		Name: "Inner Join",
 		From: parser.FromClause{
@@ -2722,6 +3531,7 @@ func TestView_Load(t *testing.T) {
 	}()
 
 	TestTx.Flags.Repository = TestDir
+	_ = TestTx.Flags.SetLocation(TestLocation)
 	ctx := context.Background()
 
 	for _, v := range viewLoadTests {
@@ -2738,6 +3548,8 @@ func TestView_Load(t *testing.T) {
 		TestTx.Flags.ImportOptions.SingleLine = v.SingleLine
 		TestTx.Flags.ImportOptions.JsonQuery = v.JsonQuery
 		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+		TestTx.Flags.ImportOptions.Lenient = v.Lenient
+		TestTx.Flags.ImportOptions.Locale = v.Locale
 		if v.Encoding != text.AUTO {
 			TestTx.Flags.ImportOptions.Encoding = v.Encoding
 		} else {
@@ -2783,6 +3595,9 @@ func TestView_Load(t *testing.T) {
 			if !reflect.DeepEqual(view.FileInfo.DelimiterPositions, v.Result.FileInfo.DelimiterPositions) {
 				t.Errorf("%s: FileInfo.DelimiterPositions = %v, want %v", v.Name, view.FileInfo.DelimiterPositions, v.Result.FileInfo.DelimiterPositions)
 			}
+			if view.FileInfo.Quote != v.Result.FileInfo.Quote {
+				t.Errorf("%s: FileInfo.Quote = %q, want %q", v.Name, view.FileInfo.Quote, v.Result.FileInfo.Quote)
+			}
 			if view.FileInfo.JsonQuery != v.Result.FileInfo.JsonQuery {
 				t.Errorf("%s: FileInfo.JsonQuery = %q, want %q", v.Name, view.FileInfo.JsonQuery, v.Result.FileInfo.JsonQuery)
 			}
@@ -2804,6 +3619,12 @@ func TestView_Load(t *testing.T) {
 			if view.FileInfo.ViewType != v.Result.FileInfo.ViewType {
 				t.Errorf("%s: FileInfo.ViewType = %d, want %d", v.Name, view.FileInfo.ViewType, v.Result.FileInfo.ViewType)
 			}
+			if view.FileInfo.Locale != v.Result.FileInfo.Locale {
+				t.Errorf("%s: FileInfo.Locale = %q, want %q", v.Name, view.FileInfo.Locale, v.Result.FileInfo.Locale)
+			}
+			if !reflect.DeepEqual(view.FileInfo.DetectedDialect, v.Result.FileInfo.DetectedDialect) {
+				t.Errorf("%s: FileInfo.DetectedDialect = %v, want %v", v.Name, view.FileInfo.DetectedDialect, v.Result.FileInfo.DetectedDialect)
+			}
 		}
 		if view.FileInfo != nil {
 			_ = TestTx.FileContainer.Close(view.FileInfo.Handler)
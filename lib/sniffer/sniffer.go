@@ -0,0 +1,250 @@
+// Package sniffer guesses the dialect of CSV data from its beginning.
+package sniffer
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	DefaultSampleSize    = 64 * 1024
+	DefaultMaxRecords    = 100
+	headerSampleRecords  = 20
+	defaultDelimiterRune = ','
+	defaultQuoteRune     = '"'
+)
+
+// Candidates are the delimiters that are tried in the order of priority.
+var Candidates = []rune{',', '\t', ';', '|', ':'}
+
+// QuoteCandidates are the quotation marks that are tried in the order of priority.
+var QuoteCandidates = []rune{'"', '\''}
+
+type Dialect struct {
+	Delimiter rune
+	Quote     rune
+	Quoted    bool
+	HasHeader bool
+}
+
+// Sniff guesses the dialect of CSV data from the sample.
+//
+// The delimiter is the candidate with which the largest number of records have the same number of fields.
+// For each delimiter, the quotation mark that encloses fields is chosen from the quote candidates,
+// and a quotation mark that is not used in the sample is chosen only if no other candidate is used.
+// If the sample does not end at the end of the data, then eof should be false so that the last incomplete line is ignored.
+func Sniff(sample string, eof bool) Dialect {
+	if !eof {
+		if idx := strings.LastIndexAny(sample, "\r\n"); 0 <= idx {
+			sample = sample[:idx]
+		}
+	}
+
+	dialect := Dialect{
+		Delimiter: defaultDelimiterRune,
+		Quote:     defaultQuoteRune,
+		HasHeader: true,
+	}
+
+	var records [][]string
+	bestScore := 0.0
+	bestFields := 1
+	for _, c := range Candidates {
+		recs, q, quoted, fields, score := splitWithQuoteCandidates(sample, c)
+		if fields < 2 {
+			continue
+		}
+		if bestScore < score || (bestScore == score && bestFields < fields) {
+			bestScore = score
+			bestFields = fields
+			dialect.Delimiter = c
+			dialect.Quote = q
+			dialect.Quoted = quoted
+			records = recs
+		}
+	}
+
+	if records == nil {
+		records, dialect.Quote, dialect.Quoted, _, _ = splitWithQuoteCandidates(sample, dialect.Delimiter)
+	}
+	dialect.HasHeader = hasHeader(records)
+	return dialect
+}
+
+// splitWithQuoteCandidates splits the sample with each quote candidate, and returns the result of the quote
+// with which the records are the most consistent. A quote that is used in the sample takes precedence
+// over an unused one of the same consistency.
+func splitWithQuoteCandidates(sample string, delimiter rune) ([][]string, rune, bool, int, float64) {
+	var records [][]string
+	quote := defaultQuoteRune
+	quoted := false
+	fields := 0
+	score := 0.0
+
+	for i, q := range QuoteCandidates {
+		recs, used := split(sample, delimiter, q, DefaultMaxRecords)
+		f, sc := consistency(recs)
+		if i == 0 || score < sc || (score == sc && used && !quoted) {
+			records, quote, quoted, fields, score = recs, q, used, f, sc
+		}
+	}
+	return records, quote, quoted, fields, score
+}
+
+func split(sample string, delimiter rune, quote rune, maxRecords int) ([][]string, bool) {
+	records := make([][]string, 0, maxRecords)
+	quoted := false
+
+	record := make([]string, 0, 10)
+	var field strings.Builder
+	inQuotes := false
+	fieldStart := true
+
+	endRecord := func() {
+		record = append(record, field.String())
+		field.Reset()
+		if !(len(record) == 1 && len(record[0]) < 1) {
+			records = append(records, record)
+		}
+		record = make([]string, 0, len(record))
+		fieldStart = true
+	}
+
+	for i := 0; i < len(sample) && len(records) < maxRecords; {
+		r, size := utf8.DecodeRuneInString(sample[i:])
+		i = i + size
+
+		if inQuotes {
+			if r == quote {
+				if next, nsize := utf8.DecodeRuneInString(sample[i:]); i < len(sample) && next == quote {
+					field.WriteRune(quote)
+					i = i + nsize
+				} else {
+					inQuotes = false
+				}
+			} else {
+				field.WriteRune(r)
+			}
+			continue
+		}
+
+		switch {
+		case r == quote && fieldStart:
+			inQuotes = true
+			quoted = true
+			fieldStart = false
+		case r == delimiter:
+			record = append(record, field.String())
+			field.Reset()
+			fieldStart = true
+		case r == '\r':
+			if i < len(sample) && sample[i] == '\n' {
+				i++
+			}
+			endRecord()
+		case r == '\n':
+			endRecord()
+		default:
+			field.WriteRune(r)
+			fieldStart = false
+		}
+	}
+	if !inQuotes && (0 < len(record) || 0 < field.Len()) && len(records) < maxRecords {
+		endRecord()
+	}
+	return records, quoted
+}
+
+// consistency returns the most frequent number of fields and the ratio of the records that have that number of fields.
+func consistency(records [][]string) (int, float64) {
+	if len(records) < 1 {
+		return 0, 0
+	}
+
+	counts := make(map[int]int)
+	for _, r := range records {
+		counts[len(r)]++
+	}
+
+	mode := 0
+	for n, cnt := range counts {
+		if counts[mode] < cnt || (counts[mode] == cnt && mode < n) {
+			mode = n
+		}
+	}
+	return mode, float64(counts[mode]) / float64(len(records))
+}
+
+// hasHeader guesses whether the first record is a header by comparing it with the following records.
+//
+// A column in which all the following values are numbers, or have the same length, votes for the header
+// if the first value differs from them. An empty value in the first record votes against the header.
+// The first record is regarded as a header unless the votes are against it.
+func hasHeader(records [][]string) bool {
+	if len(records) < 2 {
+		return true
+	}
+
+	first := records[0]
+	data := records[1:]
+	if headerSampleRecords < len(data) {
+		data = data[:headerSampleRecords]
+	}
+
+	votes := 0
+	for i, h := range first {
+		if len(strings.TrimSpace(h)) < 1 {
+			votes--
+			continue
+		}
+
+		numeric := true
+		length := -1
+		sameLength := true
+		exists := false
+		for _, r := range data {
+			if len(r) <= i {
+				continue
+			}
+			exists = true
+			v := r[i]
+			if numeric && !isNumber(v) {
+				numeric = false
+			}
+			if l := utf8.RuneCountInString(v); length < 0 {
+				length = l
+			} else if length != l {
+				sameLength = false
+			}
+		}
+		if !exists {
+			continue
+		}
+
+		switch {
+		case numeric:
+			if isNumber(h) {
+				votes--
+			} else {
+				votes++
+			}
+		case sameLength:
+			if utf8.RuneCountInString(h) == length {
+				votes--
+			} else {
+				votes++
+			}
+		}
+	}
+	return 0 <= votes
+}
+
+func isNumber(s string) bool {
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return false
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
@@ -0,0 +1,82 @@
+package sniffer
+
+import (
+	"testing"
+)
+
+var sniffTests = []struct {
+	Name   string
+	Sample string
+	EOF    bool
+	Expect Dialect
+}{
+	{
+		Name:   "Comma",
+		Sample: "id,name\n1,abc\n2,def\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ',', Quote: '"', HasHeader: true},
+	},
+	{
+		Name:   "Semicolon with Decimal Commas",
+		Sample: "id;price\n1;1,5\n2;20,25\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ';', Quote: '"', HasHeader: true},
+	},
+	{
+		Name:   "Tab",
+		Sample: "id\tname\r\n1\tabc\r\n2\tdef",
+		EOF:    true,
+		Expect: Dialect{Delimiter: '\t', Quote: '"', HasHeader: true},
+	},
+	{
+		Name:   "Pipe with Quoted Fields",
+		Sample: "\"id\"|\"name\"\n\"1\"|\"a|b\"\n\"2\"|\"c\nd\"\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: '|', Quote: '"', Quoted: true, HasHeader: true},
+	},
+	{
+		Name:   "Single-Quoted Fields",
+		Sample: "'id','name'\n'1','a,b'\n'2','it''s'\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ',', Quote: '\'', Quoted: true, HasHeader: true},
+	},
+	{
+		Name:   "Apostrophes in Fields",
+		Sample: "id,name\n1,\"a,b\"\n2,it's\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ',', Quote: '"', Quoted: true, HasHeader: true},
+	},
+	{
+		Name:   "Without Header",
+		Sample: "1,abc,2020-01-01\n2,def,2020-01-02\n3,ghi,2020-01-03\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ',', Quote: '"', HasHeader: false},
+	},
+	{
+		Name:   "Incomplete Last Line",
+		Sample: "id;name\n1;abc\n2;def\n3,",
+		EOF:    false,
+		Expect: Dialect{Delimiter: ';', Quote: '"', HasHeader: true},
+	},
+	{
+		Name:   "Single Column",
+		Sample: "name\nabc\ndef\n",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ',', Quote: '"', HasHeader: true},
+	},
+	{
+		Name:   "Empty",
+		Sample: "",
+		EOF:    true,
+		Expect: Dialect{Delimiter: ',', Quote: '"', HasHeader: true},
+	},
+}
+
+func TestSniff(t *testing.T) {
+	for _, v := range sniffTests {
+		result := Sniff(v.Sample, v.EOF)
+		if result != v.Expect {
+			t.Errorf("%s: result = %+v, want %+v", v.Name, result, v.Expect)
+		}
+	}
+}
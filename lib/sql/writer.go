@@ -0,0 +1,305 @@
+package sql
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+)
+
+const DefaultBatchSize = 100
+
+type Dialect int
+
+const (
+	PostgreSQL Dialect = iota
+	MySQL
+	SQLite
+)
+
+type FieldType int
+
+const (
+	NullType FieldType = iota
+	IntegerType
+	FloatType
+	BooleanType
+	DatetimeType
+	StringType
+)
+
+type Field struct {
+	Type     FieldType
+	Integer  int64
+	Float    float64
+	Boolean  bool
+	Datetime time.Time
+	String   string
+}
+
+func NewNullField() Field {
+	return Field{Type: NullType}
+}
+
+func NewIntegerField(i int64) Field {
+	return Field{Type: IntegerType, Integer: i}
+}
+
+func NewFloatField(f float64) Field {
+	return Field{Type: FloatType, Float: f}
+}
+
+func NewBooleanField(b bool) Field {
+	return Field{Type: BooleanType, Boolean: b}
+}
+
+func NewDatetimeField(t time.Time) Field {
+	return Field{Type: DatetimeType, Datetime: t}
+}
+
+func NewStringField(s string) Field {
+	return Field{Type: StringType, String: s}
+}
+
+// MergeType returns the column type that can store values of both types.
+func MergeType(t1 FieldType, t2 FieldType) FieldType {
+	switch {
+	case t1 == t2 || t2 == NullType:
+		return t1
+	case t1 == NullType:
+		return t2
+	case (t1 == IntegerType && t2 == FloatType) || (t1 == FloatType && t2 == IntegerType):
+		return FloatType
+	default:
+		return StringType
+	}
+}
+
+// TypeName returns the name of the column type in the dialect.
+func TypeName(dialect Dialect, t FieldType) string {
+	switch t {
+	case IntegerType:
+		if dialect == SQLite {
+			return "INTEGER"
+		}
+		return "BIGINT"
+	case FloatType:
+		switch dialect {
+		case MySQL:
+			return "DOUBLE"
+		case SQLite:
+			return "REAL"
+		default:
+			return "DOUBLE PRECISION"
+		}
+	case BooleanType:
+		if dialect == SQLite {
+			return "INTEGER"
+		}
+		return "BOOLEAN"
+	case DatetimeType:
+		switch dialect {
+		case MySQL:
+			return "DATETIME(6)"
+		case SQLite:
+			return "TEXT"
+		default:
+			return "TIMESTAMP WITH TIME ZONE"
+		}
+	default:
+		return "TEXT"
+	}
+}
+
+// QuoteIdentifier encloses the identifier in the quotation marks of the dialect.
+func QuoteIdentifier(dialect Dialect, s string) string {
+	if dialect == MySQL {
+		return "`" + strings.Replace(s, "`", "``", -1) + "`"
+	}
+	return "\"" + strings.Replace(s, "\"", "\"\"", -1) + "\""
+}
+
+// QuoteString returns the string literal of the dialect.
+func QuoteString(dialect Dialect, s string) string {
+	s = strings.Replace(s, "'", "''", -1)
+	if dialect == MySQL {
+		s = strings.Replace(s, "\\", "\\\\", -1)
+	}
+	return "'" + s + "'"
+}
+
+// Literal returns the literal that represents the field value in the dialect.
+func Literal(dialect Dialect, f Field) string {
+	switch f.Type {
+	case IntegerType:
+		return strconv.FormatInt(f.Integer, 10)
+	case FloatType:
+		switch {
+		case math.IsNaN(f.Float):
+			if dialect == PostgreSQL {
+				return "'NaN'"
+			}
+			return "NULL"
+		case math.IsInf(f.Float, 1):
+			if dialect == PostgreSQL {
+				return "'Infinity'"
+			}
+			return "NULL"
+		case math.IsInf(f.Float, -1):
+			if dialect == PostgreSQL {
+				return "'-Infinity'"
+			}
+			return "NULL"
+		}
+		return strconv.FormatFloat(f.Float, 'g', -1, 64)
+	case BooleanType:
+		if dialect == SQLite {
+			if f.Boolean {
+				return "1"
+			}
+			return "0"
+		}
+		if f.Boolean {
+			return "TRUE"
+		}
+		return "FALSE"
+	case DatetimeType:
+		if dialect == MySQL {
+			return QuoteString(dialect, f.Datetime.Format("2006-01-02 15:04:05.999999"))
+		}
+		return QuoteString(dialect, f.Datetime.Format("2006-01-02 15:04:05.999999-07:00"))
+	case StringType:
+		return QuoteString(dialect, f.String)
+	default:
+		return "NULL"
+	}
+}
+
+// Writer writes records as INSERT statements.
+// Records are gathered into one statement up to BatchSize.
+type Writer struct {
+	BatchSize int
+
+	dialect   Dialect
+	table     string
+	columns   []string
+	lineBreak string
+
+	writer   *bufio.Writer
+	buffered int
+	started  bool
+}
+
+func NewWriter(w io.Writer, dialect Dialect, table string, columns []string, lineBreak text.LineBreak, enc text.Encoding) (*Writer, error) {
+	if len(table) < 1 {
+		return nil, errors.New("table name is empty")
+	}
+
+	writer, err := charset.GetTransformWriter(w, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		BatchSize: DefaultBatchSize,
+		dialect:   dialect,
+		table:     table,
+		columns:   columns,
+		lineBreak: lineBreak.Value(),
+		writer:    bufio.NewWriter(writer),
+	}, nil
+}
+
+// WriteCreateTable writes a CREATE TABLE statement that has columns of the specified types.
+func (e *Writer) WriteCreateTable(types []FieldType) error {
+	if len(types) != len(e.columns) {
+		return errors.New("field length does not match")
+	}
+
+	if err := e.startStatement(); err != nil {
+		return err
+	}
+	if _, err := e.writer.WriteString("CREATE TABLE " + QuoteIdentifier(e.dialect, e.table) + " (" + e.lineBreak); err != nil {
+		return err
+	}
+	for i := range e.columns {
+		s := "  " + QuoteIdentifier(e.dialect, e.columns[i]) + " " + TypeName(e.dialect, types[i])
+		if i < len(e.columns)-1 {
+			s = s + ","
+		}
+		if _, err := e.writer.WriteString(s + e.lineBreak); err != nil {
+			return err
+		}
+	}
+	_, err := e.writer.WriteString(");")
+	return err
+}
+
+func (e *Writer) Write(record []Field) error {
+	if len(record) != len(e.columns) {
+		return errors.New("field length does not match")
+	}
+
+	if 0 < e.buffered {
+		if _, err := e.writer.WriteString("," + e.lineBreak); err != nil {
+			return err
+		}
+	} else {
+		if err := e.startStatement(); err != nil {
+			return err
+		}
+		columns := make([]string, len(e.columns))
+		for i := range e.columns {
+			columns[i] = QuoteIdentifier(e.dialect, e.columns[i])
+		}
+		if _, err := e.writer.WriteString("INSERT INTO " + QuoteIdentifier(e.dialect, e.table) + " (" + strings.Join(columns, ", ") + ") VALUES" + e.lineBreak); err != nil {
+			return err
+		}
+	}
+
+	values := make([]string, len(record))
+	for i := range record {
+		values[i] = Literal(e.dialect, record[i])
+	}
+	if _, err := e.writer.WriteString("  (" + strings.Join(values, ", ") + ")"); err != nil {
+		return err
+	}
+
+	e.buffered++
+	if e.BatchSize <= e.buffered {
+		return e.terminate()
+	}
+	return nil
+}
+
+func (e *Writer) startStatement() error {
+	if !e.started {
+		e.started = true
+		return nil
+	}
+	_, err := e.writer.WriteString(e.lineBreak)
+	return err
+}
+
+func (e *Writer) terminate() error {
+	if e.buffered < 1 {
+		return nil
+	}
+	e.buffered = 0
+	return e.writer.WriteByte(';')
+}
+
+// Flush terminates the pending INSERT statement and flushes the buffered data.
+func (e *Writer) Flush() error {
+	if err := e.terminate(); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
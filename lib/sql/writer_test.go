@@ -0,0 +1,256 @@
+package sql
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/go-text"
+)
+
+var mergeTypeTests = []struct {
+	Type1  FieldType
+	Type2  FieldType
+	Expect FieldType
+}{
+	{
+		Type1:  IntegerType,
+		Type2:  IntegerType,
+		Expect: IntegerType,
+	},
+	{
+		Type1:  NullType,
+		Type2:  BooleanType,
+		Expect: BooleanType,
+	},
+	{
+		Type1:  DatetimeType,
+		Type2:  NullType,
+		Expect: DatetimeType,
+	},
+	{
+		Type1:  IntegerType,
+		Type2:  FloatType,
+		Expect: FloatType,
+	},
+	{
+		Type1:  BooleanType,
+		Type2:  IntegerType,
+		Expect: StringType,
+	},
+}
+
+func TestMergeType(t *testing.T) {
+	for _, v := range mergeTypeTests {
+		result := MergeType(v.Type1, v.Type2)
+		if result != v.Expect {
+			t.Errorf("result = %d, want %d for %d, %d", result, v.Expect, v.Type1, v.Type2)
+		}
+	}
+}
+
+var literalTests = []struct {
+	Dialect Dialect
+	Field   Field
+	Expect  string
+}{
+	{
+		Dialect: PostgreSQL,
+		Field:   NewNullField(),
+		Expect:  "NULL",
+	},
+	{
+		Dialect: PostgreSQL,
+		Field:   NewIntegerField(-12),
+		Expect:  "-12",
+	},
+	{
+		Dialect: PostgreSQL,
+		Field:   NewFloatField(1.5e-7),
+		Expect:  "1.5e-07",
+	},
+	{
+		Dialect: PostgreSQL,
+		Field:   NewFloatField(math.Inf(-1)),
+		Expect:  "'-Infinity'",
+	},
+	{
+		Dialect: MySQL,
+		Field:   NewFloatField(math.NaN()),
+		Expect:  "NULL",
+	},
+	{
+		Dialect: PostgreSQL,
+		Field:   NewBooleanField(true),
+		Expect:  "TRUE",
+	},
+	{
+		Dialect: SQLite,
+		Field:   NewBooleanField(false),
+		Expect:  "0",
+	},
+	{
+		Dialect: PostgreSQL,
+		Field:   NewDatetimeField(time.Date(2012, 2, 3, 9, 18, 15, 123000000, time.FixedZone("", 9*3600))),
+		Expect:  "'2012-02-03 09:18:15.123+09:00'",
+	},
+	{
+		Dialect: MySQL,
+		Field:   NewDatetimeField(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+		Expect:  "'2012-02-03 09:18:15'",
+	},
+	{
+		Dialect: PostgreSQL,
+		Field:   NewStringField("it's \\n"),
+		Expect:  "'it''s \\n'",
+	},
+	{
+		Dialect: MySQL,
+		Field:   NewStringField("it's \\n"),
+		Expect:  "'it''s \\\\n'",
+	},
+}
+
+func TestLiteral(t *testing.T) {
+	for _, v := range literalTests {
+		result := Literal(v.Dialect, v.Field)
+		if result != v.Expect {
+			t.Errorf("result = %s, want %s for %#v", result, v.Expect, v.Field)
+		}
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	s := "a\"b`c"
+
+	if result := QuoteIdentifier(PostgreSQL, s); result != "\"a\"\"b`c\"" {
+		t.Errorf("result = %s, want %s for %q", result, "\"a\"\"b`c\"", s)
+	}
+	if result := QuoteIdentifier(MySQL, s); result != "`a\"b``c`" {
+		t.Errorf("result = %s, want %s for %q", result, "`a\"b``c`", s)
+	}
+}
+
+var writerTests = []struct {
+	Name      string
+	Dialect   Dialect
+	Table     string
+	Columns   []string
+	Types     []FieldType
+	Records   [][]Field
+	BatchSize int
+	LineBreak text.LineBreak
+	Expect    string
+	Error     string
+}{
+	{
+		Name:    "Write",
+		Dialect: PostgreSQL,
+		Table:   "users",
+		Columns: []string{"id", "name"},
+		Types:   []FieldType{IntegerType, StringType},
+		Records: [][]Field{
+			{NewIntegerField(1), NewStringField("a")},
+			{NewIntegerField(2), NewNullField()},
+		},
+		LineBreak: text.LF,
+		Expect: "CREATE TABLE \"users\" (\n" +
+			"  \"id\" BIGINT,\n" +
+			"  \"name\" TEXT\n" +
+			");\n" +
+			"INSERT INTO \"users\" (\"id\", \"name\") VALUES\n" +
+			"  (1, 'a'),\n" +
+			"  (2, NULL);",
+	},
+	{
+		Name:    "Write Batches",
+		Dialect: SQLite,
+		Table:   "t",
+		Columns: []string{"c1"},
+		Records: [][]Field{
+			{NewBooleanField(true)},
+			{NewBooleanField(false)},
+			{NewNullField()},
+		},
+		BatchSize: 2,
+		LineBreak: text.CRLF,
+		Expect: "INSERT INTO \"t\" (\"c1\") VALUES\r\n" +
+			"  (1),\r\n" +
+			"  (0);\r\n" +
+			"INSERT INTO \"t\" (\"c1\") VALUES\r\n" +
+			"  (NULL);",
+	},
+	{
+		Name:      "Write Empty Records",
+		Dialect:   MySQL,
+		Table:     "t",
+		Columns:   []string{"c1", "c2"},
+		Types:     []FieldType{FloatType, DatetimeType},
+		LineBreak: text.LF,
+		Expect: "CREATE TABLE `t` (\n" +
+			"  `c1` DOUBLE,\n" +
+			"  `c2` DATETIME(6)\n" +
+			");",
+	},
+	{
+		Name:    "Write Field Length Error",
+		Dialect: PostgreSQL,
+		Table:   "t",
+		Columns: []string{"c1", "c2"},
+		Records: [][]Field{
+			{NewIntegerField(1)},
+		},
+		LineBreak: text.LF,
+		Error:     "field length does not match",
+	},
+	{
+		Name:      "Empty Table Name Error",
+		Dialect:   PostgreSQL,
+		Columns:   []string{"c1"},
+		LineBreak: text.LF,
+		Error:     "table name is empty",
+	},
+}
+
+func TestWriter_Write(t *testing.T) {
+	for _, v := range writerTests {
+		buf := &bytes.Buffer{}
+		w, err := NewWriter(buf, v.Dialect, v.Table, v.Columns, v.LineBreak, text.UTF8)
+
+		if err == nil {
+			if 0 < v.BatchSize {
+				w.BatchSize = v.BatchSize
+			}
+			if v.Types != nil {
+				err = w.WriteCreateTable(v.Types)
+			}
+		}
+		if err == nil {
+			for _, r := range v.Records {
+				if err = w.Write(r); err != nil {
+					break
+				}
+			}
+		}
+		if err == nil {
+			err = w.Flush()
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Expect)
+		}
+	}
+}
@@ -112,6 +112,9 @@ var CsvqSyntax = []Expression{
 							{Link("subquery")},
 							{Link("subquery"), Identifier("alias")},
 							{Link("subquery"), Keyword("AS"), Identifier("alias")},
+							{Link("table_function")},
+							{Link("table_function"), Identifier("alias")},
+							{Link("table_function"), Keyword("AS"), Identifier("alias")},
 						},
 					},
 					{
@@ -145,10 +148,12 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "table_object",
 						Group: []Grammar{
-							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null"), Integer("skip_lines"), String("comment"), String("quote"), String("quote_escape")}}}},
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Link("table_identifier")}}},
 							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "XML", Args: []Element{String("row_path"), Link("table_identifier"), Option{String("encoding")}}}},
+							{Function{Name: "YAML", Args: []Element{String("json_query"), Link("table_identifier"), Option{String("encoding")}}}},
 						},
 					},
 					{
@@ -158,6 +163,24 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "JSON_TABLE", Args: []Element{String("json_query"), String("json_data")}}},
 						},
 					},
+					{
+						Name: "table_function",
+						Group: []Grammar{
+							{Function{Name: "DIFF", Args: []Element{Link("table_identifier"), Link("table_identifier"), ContinuousOption{String("key_column")}}}},
+							{Function{Name: "GENERATE_SERIES", Args: []Element{Float("start"), Float("stop"), Option{Float("step")}}}},
+							{Function{Name: "GENERATE_SERIES", Args: []Element{Datetime("start"), Datetime("stop"), String("interval")}}},
+							{Function{Name: "UNNEST", Args: []Element{String("json_array")}}},
+							{Function{Name: "SPLIT_TO_TABLE", Args: []Element{String("str"), String("separator")}}},
+						},
+						Description: Description{
+							Template: "DIFF returns the records that are added, removed or changed between two tables compared by %s. " +
+								"GENERATE_SERIES returns a table that has a single column named \"value\" with the values from %s to %s. " +
+								"The default %s is 1, and %s is a string such as '1 day' or '-2 hours'. " +
+								"UNNEST and SPLIT_TO_TABLE return a table that has a row for each element of %s or each substring of %s separated by %s, " +
+								"with the columns named \"value\" and \"ordinality\".",
+							Values: []Element{String("key_column"), Float("start"), Float("stop"), Float("step"), String("interval"), String("json_array"), String("str"), String("separator")},
+						},
+					},
 				},
 			},
 			{
@@ -347,8 +370,32 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Link("table_element")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("table_element")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "table_element",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_constraint"), Token("...")}},
+					{Link("table_constraint")},
+				},
+			},
+			{
+				Name: "column_constraint",
+				Group: []Grammar{
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, AnyOne{PlainGroup{Keyword("NOT"), Keyword("NULL")}, PlainGroup{Keyword("PRIMARY"), Keyword("KEY")}, Keyword("UNIQUE"), PlainGroup{Keyword("CHECK"), Parentheses{Link("condition")}}}},
+				},
+			},
+			{
+				Name: "table_constraint",
+				Group: []Grammar{
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, AnyOne{PlainGroup{Keyword("NOT"), Keyword("NULL")}, PlainGroup{Keyword("PRIMARY"), Keyword("KEY")}, Keyword("UNIQUE")}, Parentheses{ContinuousOption{Identifier("column_name")}}},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("CHECK"), Parentheses{Link("condition")}},
+				},
+				Description: Description{
+					Template: "Constraints are stored in the file named the table file path followed by %s, and are checked when records are inserted or updated.",
+					Values:   []Element{String(".constraints.json")},
 				},
 			},
 		},
@@ -396,6 +443,18 @@ var CsvqSyntax = []Expression{
 					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("RENAME"), Identifier("old_column_name"), Keyword("TO"), Identifier("new_column_name")},
 				},
 			},
+			{
+				Name: "alter_table_add_constraint_statement",
+				Group: []Grammar{
+					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("ADD"), Link("table_constraint")},
+				},
+			},
+			{
+				Name: "alter_table_drop_constraint_statement",
+				Group: []Grammar{
+					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("DROP"), Keyword("CONSTRAINT"), Identifier("constraint_name")},
+				},
+			},
 			{
 				Name: "alter_table_set_attribute_statement",
 				Group: []Grammar{
@@ -405,7 +464,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "table_attribute",
 				Group: []Grammar{
-					{AnyOne{Keyword("FORMAT"), Keyword("DELIMITER"), Keyword("DELIMITER_POSITIONS"), Keyword("JSON_ESCAPE"), Keyword("ENCODING"), Keyword("LINE_BREAK"), Keyword("HEADER"), Keyword("ENCLOSE_ALL"), Keyword("PRETTY_PRINT")}},
+					{AnyOne{Keyword("FORMAT"), Keyword("DELIMITER"), Keyword("DELIMITER_POSITIONS"), Keyword("JSON_ESCAPE"), Keyword("ENCODING"), Keyword("LINE_BREAK"), Keyword("HEADER"), Keyword("ENCLOSE_ALL"), Keyword("PRETTY_PRINT"), Keyword("LOCALE")}},
 				},
 			},
 		},
@@ -576,6 +635,20 @@ var CsvqSyntax = []Expression{
 					Template: "An aggregate function can also be called as an analytic function.",
 				},
 			},
+			{
+				Name: "declare_external_function_statement",
+				Group: []Grammar{
+					{Keyword("DECLARE"), Identifier("function_name"), Keyword("FUNCTION"), Keyword("EXTERNAL"), String("command")},
+					{Keyword("DECLARE"), Identifier("function_name"), Keyword("AGGREGATE"), Keyword("EXTERNAL"), String("command")},
+				},
+				Description: Description{
+					Template: "" +
+						"Declare a function evaluated by a long-lived child process. " +
+						"The process receives requests as JSON lines on the standard input, and must write one JSON line per request to the standard output. " +
+						"Functions can also be defined in the %s section of the configuration file.",
+					Values: []Element{String("plugins")},
+				},
+			},
 			{
 				Name: "function_parameters",
 				Group: []Grammar{
@@ -1027,6 +1100,20 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Parse empty fields as empty strings.\n" +
 				"%s  <type::%s>\n" +
+				"  > Load malformed CSV rows leniently.\n" +
+				"%s  <type::%s>\n" +
+				"  > File path to write rejected CSV rows.\n" +
+				"%s  <type::%s>\n" +
+				"  > Number of leading lines to skip in CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Prefix of lines to be ignored in CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Quotation mark for CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Escaping of quotation marks in CSV. One of DOUBLE|BACKSLASH.\n" +
+				"%s  <type::%s>\n" +
+				"  > Locale in which numbers and dates are written in files. e.g. de-DE\n" +
+				"%s  <type::%s>\n" +
 				"  > Strip line break from the end of files and query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
@@ -1037,6 +1124,10 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Delimiter positions for query results in Fixed-Length Format.\n" +
 				"%s  <type::%s>\n" +
+				"  > Quotation mark for query results in CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Escaping of quotation marks for query results in CSV. One of DOUBLE|BACKSLASH.\n" +
+				"%s  <type::%s>\n" +
 				"  > Write without the header line in query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > %s in query results.\n" +
@@ -1074,16 +1165,31 @@ var CsvqSyntax = []Expression{
 				Flag("@@ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@NO_HEADER"), Boolean("boolean"),
 				Flag("@@WITHOUT_NULL"), Boolean("boolean"),
+				Flag("@@LENIENT"), Boolean("boolean"),
+				Flag("@@REJECT_FILE"), String("string"),
+				Flag("@@SKIP_LINES"), Integer("integer"),
+				Flag("@@COMMENT"), String("string"),
+				Flag("@@QUOTE"), String("string"),
+				Flag("@@QUOTE_ESCAPE"), String("string"),
+				Flag("@@LOCALE"), String("string"),
 				Flag("@@STRIP_ENDING_LINE_BREAK"), Boolean("boolean"),
 				Flag("@@FORMAT"), String("string"), Link("Format"),
 				Flag("@@WRITE_ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@WRITE_DELIMITER"), String("string"),
 				Flag("@@WRITE_DELIMITER_POSITIONS"), String("string"),
+				Flag("@@WRITE_QUOTE"), String("string"),
+				Flag("@@WRITE_QUOTE_ESCAPE"), String("string"),
 				Flag("@@WITHOUT_HEADER"), Boolean("boolean"),
 				Flag("@@LINE_BREAK"), String("string"), Link("Line Break"),
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
+				Flag("@@XML_ROOT_ELEMENT"), String("string"),
+				Flag("@@XML_ROW_ELEMENT"), String("string"),
+				Flag("@@SQL_TABLE"), String("string"),
+				Flag("@@SQL_DIALECT"), String("string"),
+				Flag("@@BORDER_STYLE"), String("string"),
+				Flag("@@EXPANDED"), String("string"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
 				Flag("@@COUNT_DIACRITICAL_SIGN"), Boolean("boolean"),
 				Flag("@@COUNT_FORMAT_CODE"), Boolean("boolean"),
@@ -1188,6 +1294,15 @@ var CsvqSyntax = []Expression{
 				"  > Square Brackets(U+005B [, U+005D ]) are used to represent json array.\n" +
 				"%s\n" +
 				"  > Curly Brackets(U+007B {, U+007D }) are used to repsesent json array of objects.\n" +
+				"%s\n" +
+				"  > An Asterisk(U+002A *) is used to represent all the elements of an array or all the member values of an object.\n" +
+				"%s\n" +
+				"  > Two periods(U+002E .) are used to represent a value and all of its descendants.\n" +
+				"%s\n" +
+				"  > A question mark(U+003F ?) followed by a condition enclosed in parentheses is used to represent the elements that satisfy the condition." +
+				" In the condition, a Commercial At(U+0040 @) represents the current element.\n" +
+				"\n" +
+				"If a value contains a wildcard, a recursive descent, a slice or a filter, then the value returns an array of all the values that exist.\n" +
 				"",
 			Values: []Element{
 				Name("Value Identifier"),
@@ -1195,13 +1310,16 @@ var CsvqSyntax = []Expression{
 				Name("Value Separator"),
 				Name("Array"),
 				Name("Object Array"),
+				Name("Wildcard"),
+				Name("Recursive Descent"),
+				Name("Filter"),
 			},
 		},
 		Grammar: []Definition{
 			{
 				Name: "json_value",
 				Group: []Grammar{
-					{AnyOne{Link("json_object_member"), Link("json_array_element")}},
+					{AnyOne{Link("json_object_member"), Link("json_array_element"), Link("json_wildcard"), Link("json_recursive_descent")}},
 					{ContinuousOption{Link("json_value")}},
 				},
 			},
@@ -1215,6 +1333,43 @@ var CsvqSyntax = []Expression{
 				Name: "json_array_element",
 				Group: []Grammar{
 					{Option{Integer("index")}},
+					{Token("[*]")},
+					{Token("["), Option{Integer("start")}, Token(":"), Option{Integer("end")}, Option{Token(":"), Integer("step")}, Token("]")},
+					{Token("[?("), Link("json_filter_condition"), Token(")]")},
+				},
+			},
+			{
+				Name: "json_wildcard",
+				Group: []Grammar{
+					{Token("*")},
+				},
+			},
+			{
+				Name: "json_recursive_descent",
+				Group: []Grammar{
+					{Token(".."), AnyOne{Link("json_object_member"), Link("json_wildcard")}},
+				},
+			},
+			{
+				Name: "json_filter_condition",
+				Group: []Grammar{
+					{Link("json_filter_operand")},
+					{Link("json_filter_operand"), AnyOne{Token("=="), Token("!="), Token("<"), Token("<="), Token(">"), Token(">=")}, Link("json_filter_operand")},
+					{Link("json_filter_condition"), Token("&&"), Link("json_filter_condition")},
+					{Link("json_filter_condition"), Token("||"), Link("json_filter_condition")},
+					{Token("!"), Link("json_filter_condition")},
+					{Parentheses{Link("json_filter_condition")}},
+				},
+			},
+			{
+				Name: "json_filter_operand",
+				Group: []Grammar{
+					{Token("@"), Option{Link("json_value")}},
+					{String("string")},
+					{Float("number")},
+					{Keyword("true")},
+					{Keyword("false")},
+					{Keyword("null")},
 				},
 			},
 			{
@@ -1253,13 +1408,14 @@ var CsvqSyntax = []Expression{
 						"  |          1 | +  (Unary Plus)     | Right-to-Left |\n" +
 						"  |            | -  (Unary Minus)    | Right-to-Left |\n" +
 						"  |            | !  (Logical Not)    | Right-to-Left |\n" +
-						"  |          2 | *  (Multiplication) | Left-to-Right |\n" +
+						"  |          2 | AT TIME ZONE        | Left-to-Right |\n" +
+						"  |          3 | *  (Multiplication) | Left-to-Right |\n" +
 						"  |            | /  (Division)       | Left-to-Right |\n" +
 						"  |            | %s  (Modulo)         | Left-to-Right |\n" +
-						"  |          3 | +  (Addition)       | Left-to-Right |\n" +
+						"  |          4 | +  (Addition)       | Left-to-Right |\n" +
 						"  |            | -  (Subtraction)    | Left-to-Right |\n" +
-						"  |          4 | || (Concatenation)  | Left-to-Right |\n" +
-						"  |          5 | =                   | n/a           |\n" +
+						"  |          5 | || (Concatenation)  | Left-to-Right |\n" +
+						"  |          6 | =                   | n/a           |\n" +
 						"  |            | ==                  | n/a           |\n" +
 						"  |            | <                   | n/a           |\n" +
 						"  |            | <=                  | n/a           |\n" +
@@ -1271,13 +1427,13 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
-						"  |          6 | NOT                 | Right-to-Left |\n" +
-						"  |          7 | AND                 | Left-to-Right |\n" +
-						"  |          8 | OR                  | Left-to-Right |\n" +
-						"  |          9 | INTERSECT           | Left-to-Right |\n" +
-						"  |         10 | UNION               | Left-to-Right |\n" +
+						"  |          7 | NOT                 | Right-to-Left |\n" +
+						"  |          8 | AND                 | Left-to-Right |\n" +
+						"  |          9 | OR                  | Left-to-Right |\n" +
+						"  |         10 | INTERSECT           | Left-to-Right |\n" +
+						"  |         11 | UNION               | Left-to-Right |\n" +
 						"  |            | EXCEPT              | Left-to-Right |\n" +
-						"  |         11 | :=                  | Right-to-Left |\n" +
+						"  |         12 | :=                  | Right-to-Left |\n" +
 						"  +------------+---------------------+---------------+\n" +
 						"```",
 					Values: []Element{Token("%")},
@@ -1728,6 +1884,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Formats %s to a string with separators.", Values: []Element{Integer("number")}},
 					},
+					{
+						Name: "number_parse",
+						Group: []Grammar{
+							{Function{Name: "NUMBER_PARSE", Args: []Element{String("str"), String("locale")}, Return: Return("float or integer")}},
+						},
+						Description: Description{Template: "Converts %s written with the decimal point and the thousands separators of %s to a number. If %s cannot be converted, then returns null.", Values: []Element{String("str"), String("locale"), String("str")}},
+					},
 					{
 						Name: "rand",
 						Group: []Grammar{
@@ -2000,6 +2163,28 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the datetime value of %s in UTC.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "convert_tz",
+						Group: []Grammar{
+							{Function{Name: "CONVERT_TZ", Args: []Element{Datetime("datetime"), String("timezone")}, Return: Return("datetime")}},
+							{Function{Name: "CONVERT_TZ", Args: []Element{Datetime("datetime"), String("from_timezone"), String("to_timezone")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns the datetime value of %s in %s. If %s is specified, then the clock of %s is interpreted as the time in %s.", Values: []Element{Datetime("datetime"), String("timezone"), String("from_timezone"), Datetime("datetime"), String("from_timezone")}},
+					},
+					{
+						Name: "from_tz",
+						Group: []Grammar{
+							{Function{Name: "FROM_TZ", Args: []Element{Datetime("datetime"), String("timezone")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns the datetime value that has the same clock as %s in %s.", Values: []Element{Datetime("datetime"), String("timezone")}},
+					},
+					{
+						Name: "at_time_zone",
+						Group: []Grammar{
+							{Datetime("datetime"), Keyword("AT"), Keyword("TIME"), Keyword("ZONE"), String("timezone")},
+						},
+						Description: Description{Template: "Returns the datetime value of %s in %s. It is the same as CONVERT_TZ(%s, %s).", Values: []Element{Datetime("datetime"), String("timezone"), Datetime("datetime"), String("timezone")}},
+					},
 				},
 			},
 			{
@@ -2202,6 +2387,62 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns a string formatted in JSON."},
 					},
+					{
+						Name: "json_set",
+						Group: []Grammar{
+							{Function{Name: "JSON_SET", Args: []Element{String("json_data"), String("path"), Link("value")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns %s in which %s is set to the position specified by %s. Pairs of %s and %s can be repeated.", Values: []Element{String("json_data"), Link("value"), String("path"), String("path"), Link("value")}},
+					},
+					{
+						Name: "json_insert",
+						Group: []Grammar{
+							{Function{Name: "JSON_INSERT", Args: []Element{String("json_data"), String("path"), Link("value")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns %s in which %s is added to the position specified by %s if the position does not exist. Pairs of %s and %s can be repeated.", Values: []Element{String("json_data"), Link("value"), String("path"), String("path"), Link("value")}},
+					},
+					{
+						Name: "json_remove",
+						Group: []Grammar{
+							{Function{Name: "JSON_REMOVE", Args: []Element{String("json_data"), ContinuousOption{String("path")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns %s from which the values specified by %s are removed.", Values: []Element{String("json_data"), String("path")}},
+					},
+					{
+						Name: "json_merge_patch",
+						Group: []Grammar{
+							{Function{Name: "JSON_MERGE_PATCH", Args: []Element{String("json_data"), ContinuousOption{String("patch")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns %s to which %s is applied as described in RFC 7396.", Values: []Element{String("json_data"), String("patch")}},
+					},
+					{
+						Name: "json_keys",
+						Group: []Grammar{
+							{Function{Name: "JSON_KEYS", Args: []Element{String("json_data"), Option{String("path")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the keys of an object in %s as a JSON array.", Values: []Element{String("json_data")}},
+					},
+					{
+						Name: "json_length",
+						Group: []Grammar{
+							{Function{Name: "JSON_LENGTH", Args: []Element{String("json_data"), Option{String("path")}}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of elements of a value in %s.", Values: []Element{String("json_data")}},
+					},
+					{
+						Name: "json_type",
+						Group: []Grammar{
+							{Function{Name: "JSON_TYPE", Args: []Element{String("json_data"), Option{String("path")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the type name of a value in %s.", Values: []Element{String("json_data")}},
+					},
+					{
+						Name: "json_valid",
+						Group: []Grammar{
+							{Function{Name: "JSON_VALID", Args: []Element{String("str")}, Return: Return("boolean")}},
+						},
+						Description: Description{Template: "Returns whether %s is a valid JSON data.", Values: []Element{String("str")}},
+					},
 				},
 			},
 			{
@@ -2454,6 +2695,128 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Link("value")}, Return: Return("primitive type")}},
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of %s. " +
+								"If several values are the most frequent, then returns the one that appears first. " +
+								"With the WITHIN GROUP clause, the sort key of %s is used as the value, and the first one in the sort order is returned. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Link("order_by_clause"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs to calculate, then returns %s.",
+							Values: []Element{Float("y"), Float("x"), Null("NULL")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs to calculate, then returns %s.",
+							Values: []Element{Float("y"), Float("x"), Null("NULL")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs to calculate, then returns %s.",
+							Values: []Element{Float("y"), Float("x"), Null("NULL")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the least-squares regression line fitted to the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs to calculate, then returns %s.",
+							Values: []Element{Float("y"), Float("x"), Null("NULL")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the least-squares regression line fitted to the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs to calculate, then returns %s.",
+							Values: []Element{Float("y"), Float("x"), Null("NULL")},
+						},
+					},
+					{
+						Name: "regr_r2",
+						Group: []Grammar{
+							{Function{Name: "REGR_R2", Args: []Element{Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the coefficient of determination of the least-squares regression line fitted to the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs to calculate, then returns %s.",
+							Values: []Element{Float("y"), Float("x"), Null("NULL")},
+						},
+					},
+					{
+						Name: "regr_count",
+						Group: []Grammar{
+							{Function{Name: "REGR_COUNT", Args: []Element{Float("y"), Float("x")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the number of the pairs of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Option{Keyword("DISTINCT")}, Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Return: Return("float or integer or datetime")}},
+						},
+						Description: Description{
+							Template: "Returns the value at %s of the float or datetime values sorted by %s, interpolating between adjacent values. " +
+								"%s must be between 0 and 1, and %s must have exactly one sort key. " +
+								"If all values are datetimes, then returns a datetime. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("order_by_clause"), Float("fraction"), Link("order_by_clause"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Option{Keyword("DISTINCT")}, Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value whose cumulative distribution is greater than or equal to %s in the values sorted by %s. " +
+								"%s must be between 0 and 1, and %s must have exactly one sort key. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("order_by_clause"), Float("fraction"), Link("order_by_clause"), Null("NULL")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2702,6 +3065,109 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("primitive type")}},
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of %s. " +
+								"With the WITHIN GROUP clause, the sort key of %s is used as the value, and the first one in the sort order is returned. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Link("order_by_clause"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the least-squares regression line fitted to the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the least-squares regression line fitted to the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_r2",
+						Group: []Grammar{
+							{Function{Name: "REGR_R2", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the coefficient of determination of the least-squares regression line fitted to the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_count",
+						Group: []Grammar{
+							{Function{Name: "REGR_COUNT", Args: []Element{Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the number of the pairs of %s and %s.",
+							Values:   []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Option{Keyword("DISTINCT")}, Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("float or integer or datetime")}},
+						},
+						Description: Description{
+							Template: "Returns the value at %s of the float or datetime values in the partition sorted by %s, interpolating between adjacent values.",
+							Values:   []Element{Float("fraction"), Link("order_by_clause")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Option{Keyword("DISTINCT")}, Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value whose cumulative distribution is greater than or equal to %s in the partition sorted by %s.",
+							Values:   []Element{Float("fraction"), Link("order_by_clause")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2902,19 +3368,19 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
-						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
+						"BETWEEN BREAK BY CASE CHDIR CHECK CLOSE COMMIT CONSTRAINT CONTINUE CORR COUNT COVAR_POP COVAR_SAMP CREATE CROSS " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
-						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
+						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS EXTERNAL " +
 						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
 						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LATERAL LEAD " +
-						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
+						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN MODE NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON ONLY OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
-						"PERCENT_RANK PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
+						"PERCENT_RANK PERCENTILE_CONT PERCENTILE_DISC PRECEDING PREPARE PRIMARY PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
+						"REGR_COUNT REGR_INTERCEPT REGR_R2 REGR_SLOPE RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
 						"SELECT SEPARATOR SET SHOW SOURCE STDEV STDEVP STDIN SUBSTRING SUM SYNTAX TABLE " +
 						"THEN TO TRIGGER TRUE " +
-						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
+						"UNBOUNDED UNION UNIQUE UNKNOWN UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
 						"WHILE WITH WITHIN",
 				},
 			},
@@ -2985,11 +3451,14 @@ var CsvqSyntax = []Expression{
 						"| %%H | Hour in 24-hour (00 - 23)                                   |\n" +
 						"| %%h | Hour in two digits 12-hour (01 - 12)                        |\n" +
 						"| %%i | Minute in two digits (00 - 59)                              |\n" +
+						"| %%L | Time zone name (Europe/Berlin, ...)                         |\n" +
 						"| %%l | Hour in 12-hour (1 - 12)                                    |\n" +
 						"| %%M | Month name (January, February, ...)                         |\n" +
 						"| %%m | Month number with two digits (01 - 12)                      |\n" +
 						"| %%N | Nanoseconds that drops trailing zeros (empty - .999999999)  |\n" +
 						"| %%n | Nanoseconds (.000000000 - .999999999)                       |\n" +
+						"| %%O | Time difference with colon (+01:00, ...)                    |\n" +
+						"| %%o | Time difference without colon (+0100, ...)                  |\n" +
 						"| %%p | Period in a day (AM or PM)                                  |\n" +
 						"| %%r | Time with a period (%%H:%%i:%%s %%p)                            |\n" +
 						"| %%s | Second in two digits (00 - 59)                              |\n" +
@@ -2997,7 +3466,7 @@ var CsvqSyntax = []Expression{
 						"| %%W | Week name (Sunday, Monday, ...)                             |\n" +
 						"| %%Y | Year in four digits                                         |\n" +
 						"| %%y | Year in two digits                                          |\n" +
-						"| %%Z | Time zone in time difference                                |\n" +
+						"| %%Z | Time zone in time difference, or Z for UTC                  |\n" +
 						"| %%z | Abbreviation of Time zone name                              |\n" +
 						"| %%%% | '%%'                                                         |\n" +
 						"+----+-------------------------------------------------------------+\n" +
@@ -3059,19 +3528,26 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+----------+---------------------------------------------+\n" +
-						"| Value    |     Character Encoding                      |\n" +
-						"+----------+---------------------------------------------+\n" +
-						"| AUTO     | Detect encoding automatically               |\n" +
-						"| UTF8     | UTF-8. Detect BOM automatically             |\n" +
-						"| UTF8M    | UTF-8 with BOM                              |\n" +
-						"| UTF16    | UTF-16. Detect BOM and Endian automatically |\n" +
-						"| UTF16BE  | UTF-16 Big-Endian                           |\n" +
-						"| UTF16LE  | UTF-16 Little-Endian                        |\n" +
-						"| UTF16BEM | UTF-16 Big-Endian with BOM                  |\n" +
-						"| UTF16LEM | UTF-16 Little-Endian with BOM               |\n" +
-						"| SJIS     | Shift_JIS                                   |\n" +
-						"+----------+---------------------------------------------+\n" +
+						"+-------------+---------------------------------------------+\n" +
+						"| Value       |     Character Encoding                      |\n" +
+						"+-------------+---------------------------------------------+\n" +
+						"| AUTO        | Detect encoding automatically               |\n" +
+						"| UTF8        | UTF-8. Detect BOM automatically             |\n" +
+						"| UTF8M       | UTF-8 with BOM                              |\n" +
+						"| UTF16       | UTF-16. Detect BOM and Endian automatically |\n" +
+						"| UTF16BE     | UTF-16 Big-Endian                           |\n" +
+						"| UTF16LE     | UTF-16 Little-Endian                        |\n" +
+						"| UTF16BEM    | UTF-16 Big-Endian with BOM                  |\n" +
+						"| UTF16LEM    | UTF-16 Little-Endian with BOM               |\n" +
+						"| SJIS        | Shift_JIS                                   |\n" +
+						"| EUCJP       | EUC-JP                                      |\n" +
+						"| ISO2022JP   | ISO-2022-JP                                 |\n" +
+						"| GB18030     | GB18030                                     |\n" +
+						"| BIG5        | Big5                                        |\n" +
+						"| EUCKR       | EUC-KR                                      |\n" +
+						"| LATIN1      | ISO-8859-1                                  |\n" +
+						"| WINDOWS125x | Windows-1250 to Windows-1258                |\n" +
+						"+-------------+---------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -3080,18 +3556,25 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+----------+----------------------------------+\n" +
-						"| Value    |     Character Encoding           |\n" +
-						"+----------+----------------------------------+\n" +
-						"| UTF8     | UTF-8                            |\n" +
-						"| UTF8M    | UTF-8 with BOM                   |\n" +
-						"| UTF16    | An alias of UTF16BE              |\n" +
-						"| UTF16BE  | UTF-16 Big-Endian                |\n" +
-						"| UTF16LE  | UTF-16 Little-Endian             |\n" +
-						"| UTF16BEM | UTF-16 Big-Endian with BOM       |\n" +
-						"| UTF16LEM | UTF-16 Little-Endian with BOM    |\n" +
-						"| SJIS     | Shift_JIS                        |\n" +
-						"+----------+----------------------------------+\n" +
+						"+-------------+-------------------------------+\n" +
+						"| Value       |     Character Encoding        |\n" +
+						"+-------------+-------------------------------+\n" +
+						"| UTF8        | UTF-8                         |\n" +
+						"| UTF8M       | UTF-8 with BOM                |\n" +
+						"| UTF16       | An alias of UTF16BE           |\n" +
+						"| UTF16BE     | UTF-16 Big-Endian             |\n" +
+						"| UTF16LE     | UTF-16 Little-Endian          |\n" +
+						"| UTF16BEM    | UTF-16 Big-Endian with BOM    |\n" +
+						"| UTF16LEM    | UTF-16 Little-Endian with BOM |\n" +
+						"| SJIS        | Shift_JIS                     |\n" +
+						"| EUCJP       | EUC-JP                        |\n" +
+						"| ISO2022JP   | ISO-2022-JP                   |\n" +
+						"| GB18030     | GB18030                       |\n" +
+						"| BIG5        | Big5                          |\n" +
+						"| EUCKR       | EUC-KR                        |\n" +
+						"| LATIN1      | ISO-8859-1                    |\n" +
+						"| WINDOWS125x | Windows-1250 to Windows-1258  |\n" +
+						"+-------------+-------------------------------+\n" +
 						"```",
 				},
 			},
@@ -3126,6 +3609,10 @@ var CsvqSyntax = []Expression{
 						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
 						"| ORG   | Text Table for Emacs Org-mode            |\n" +
 						"| TEXT  | Text Table for console                   |\n" +
+						"| XML   | XML Format                               |\n" +
+						"| YAML  | YAML Format                              |\n" +
+						"| HTML  | HTML Table                               |\n" +
+						"| SQL   | CREATE TABLE and INSERT Statements       |\n" +
 						"+-------+------------------------------------------+\n" +
 						"```",
 				},
@@ -0,0 +1,429 @@
+package texttable
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+)
+
+const PadChar = ' '
+
+type BorderStyle int
+
+const (
+	ASCII BorderStyle = iota
+	SingleLine
+	DoubleLine
+	Minimal
+)
+
+type rule struct {
+	left  string
+	fill  string
+	cross string
+	right string
+}
+
+type border struct {
+	top    rule
+	middle rule
+	bottom rule
+
+	left    string
+	inner   string
+	right   string
+	padding string
+}
+
+var borders = map[BorderStyle]border{
+	ASCII: {
+		top:     rule{left: "+", fill: "-", cross: "+", right: "+"},
+		middle:  rule{left: "+", fill: "-", cross: "+", right: "+"},
+		bottom:  rule{left: "+", fill: "-", cross: "+", right: "+"},
+		left:    "|",
+		inner:   "|",
+		right:   "|",
+		padding: " ",
+	},
+	SingleLine: {
+		top:     rule{left: "┌", fill: "─", cross: "┬", right: "┐"},
+		middle:  rule{left: "├", fill: "─", cross: "┼", right: "┤"},
+		bottom:  rule{left: "└", fill: "─", cross: "┴", right: "┘"},
+		left:    "│",
+		inner:   "│",
+		right:   "│",
+		padding: " ",
+	},
+	DoubleLine: {
+		top:     rule{left: "╔", fill: "═", cross: "╦", right: "╗"},
+		middle:  rule{left: "╠", fill: "═", cross: "╬", right: "╣"},
+		bottom:  rule{left: "╚", fill: "═", cross: "╩", right: "╝"},
+		left:    "║",
+		inner:   "║",
+		right:   "║",
+		padding: " ",
+	},
+	Minimal: {
+		middle:  rule{fill: "-", cross: "  "},
+		inner:   "  ",
+		padding: "",
+	},
+}
+
+type Field struct {
+	Contents  string
+	Alignment text.FieldAlignment
+
+	lines []string
+	width int
+}
+
+func NewField(contents string, alignment text.FieldAlignment) Field {
+	return Field{
+		Contents:  contents,
+		Alignment: alignment,
+	}
+}
+
+// Encoder writes records as a text table for console.
+//
+// If Expanded is true, or if MaxWidth is greater than 0 and the table is wider than MaxWidth,
+// then each record is written as a block that has one field per line.
+//
+// If WithoutHeader is true, then the header is not written. In expanded blocks, the labels of the fields are omitted.
+type Encoder struct {
+	Style                BorderStyle
+	Expanded             bool
+	MaxWidth             int
+	WithoutHeader        bool
+	LineBreak            text.LineBreak
+	EastAsianEncoding    bool
+	CountDiacriticalSign bool
+	CountFormatCode      bool
+	Encoding             text.Encoding
+
+	border    border
+	header    []Field
+	recordSet [][]Field
+	fieldLen  int
+	lineBreak string
+	writer    *bufio.Writer
+}
+
+func NewEncoder(style BorderStyle, recordCounts int) *Encoder {
+	return &Encoder{
+		Style:                style,
+		Expanded:             false,
+		MaxWidth:             0,
+		WithoutHeader:        false,
+		LineBreak:            text.LF,
+		EastAsianEncoding:    false,
+		CountDiacriticalSign: false,
+		CountFormatCode:      false,
+		Encoding:             text.UTF8,
+		recordSet:            make([][]Field, 0, recordCounts),
+	}
+}
+
+func (e *Encoder) SetHeader(header []Field) {
+	e.header = e.prepareRecord(header)
+	if e.fieldLen < len(header) {
+		e.fieldLen = len(header)
+	}
+}
+
+func (e *Encoder) AppendRecord(record []Field) {
+	e.recordSet = append(e.recordSet, e.prepareRecord(record))
+	if e.fieldLen < len(record) {
+		e.fieldLen = len(record)
+	}
+}
+
+func (e *Encoder) prepareRecord(record []Field) []Field {
+	for i := range record {
+		lines := strings.Split(record[i].Contents, "\n")
+
+		width := 0
+		for _, v := range lines {
+			if l := e.textWidth(v); width < l {
+				width = l
+			}
+		}
+
+		record[i].lines = lines
+		record[i].width = width
+	}
+	return record
+}
+
+func (e *Encoder) textWidth(s string) int {
+	return text.Width(s, e.EastAsianEncoding, e.CountDiacriticalSign, e.CountFormatCode)
+}
+
+func (e *Encoder) Encode() (string, error) {
+	if e.fieldLen < 1 {
+		return "", nil
+	}
+
+	var ok bool
+	if e.border, ok = borders[e.Style]; !ok {
+		e.border = borders[ASCII]
+	}
+	e.lineBreak = e.LineBreak.Value()
+
+	buf := new(bytes.Buffer)
+	writer, err := charset.GetTransformWriter(buf, e.Encoding)
+	if err != nil {
+		return "", err
+	}
+	e.writer = bufio.NewWriter(writer)
+
+	fieldWidths := e.fieldWidths()
+	if e.Expanded || (0 < e.MaxWidth && e.MaxWidth < e.lineWidth(fieldWidths)) {
+		err = e.encodeExpanded()
+	} else {
+		err = e.encodeTable(fieldWidths)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err = e.writer.Flush(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (e *Encoder) fieldWidths() []int {
+	widths := make([]int, e.fieldLen)
+
+	for _, record := range e.recordSet {
+		for i, f := range record {
+			if widths[i] < f.width {
+				widths[i] = f.width
+			}
+		}
+	}
+
+	if e.WithoutHeader {
+		return widths
+	}
+	for i, f := range e.header {
+		if widths[i] < f.width {
+			widths[i] = f.width
+		}
+		if ((widths[i] - f.width) % 2) == 1 {
+			widths[i] = widths[i] + 1
+		}
+	}
+	return widths
+}
+
+func (e *Encoder) lineWidth(widths []int) int {
+	padLen := utf8.RuneCountInString(e.border.padding)
+
+	w := utf8.RuneCountInString(e.border.left) + utf8.RuneCountInString(e.border.right)
+	for i := range widths {
+		if 0 < i {
+			w = w + utf8.RuneCountInString(e.border.inner)
+		}
+		w = w + widths[i] + padLen*2
+	}
+	return w
+}
+
+func (e *Encoder) encodeTable(widths []int) error {
+	lines := make([]string, 0, len(e.recordSet)+4)
+
+	if 0 < len(e.border.top.fill) {
+		lines = append(lines, e.formatRule(e.border.top, widths))
+	}
+	if !e.WithoutHeader {
+		lines = append(lines, e.formatRecord(e.header, widths)...)
+		if 0 < len(e.border.middle.fill) {
+			lines = append(lines, e.formatRule(e.border.middle, widths))
+		}
+	}
+	if 0 < len(e.recordSet) {
+		for _, record := range e.recordSet {
+			lines = append(lines, e.formatRecord(record, widths)...)
+		}
+		if 0 < len(e.border.bottom.fill) {
+			lines = append(lines, e.formatRule(e.border.bottom, widths))
+		}
+	}
+
+	return e.writeLines(lines)
+}
+
+func (e *Encoder) formatRule(r rule, widths []int) string {
+	padLen := utf8.RuneCountInString(e.border.padding)
+
+	var b strings.Builder
+	b.WriteString(r.left)
+	for i, w := range widths {
+		if 0 < i {
+			b.WriteString(r.cross)
+		}
+		b.WriteString(strings.Repeat(r.fill, w+padLen*2))
+	}
+	b.WriteString(r.right)
+	return b.String()
+}
+
+func (e *Encoder) formatRecord(record []Field, widths []int) []string {
+	lineLen := 0
+	for _, f := range record {
+		if lineLen < len(f.lines) {
+			lineLen = len(f.lines)
+		}
+	}
+
+	lines := make([]string, 0, lineLen)
+	for lineIdx := 0; lineIdx < lineLen; lineIdx++ {
+		var b strings.Builder
+		b.WriteString(e.border.left)
+
+		for i := 0; i < e.fieldLen; i++ {
+			if 0 < i {
+				b.WriteString(e.border.inner)
+			}
+			b.WriteString(e.border.padding)
+
+			if len(record) <= i || len(record[i].lines) <= lineIdx || len(record[i].lines[lineIdx]) < 1 {
+				b.WriteString(strings.Repeat(string(PadChar), widths[i]))
+			} else {
+				s := record[i].lines[lineIdx]
+				padLen := widths[i] - e.textWidth(s)
+
+				align := record[i].Alignment
+				if (align == text.LeftAligned || align == text.NotAligned) && text.IsRightToLeftLetters(s) {
+					align = text.RightAligned
+				}
+
+				switch align {
+				case text.Centering:
+					halfPadLen := padLen / 2
+					b.WriteString(strings.Repeat(string(PadChar), halfPadLen))
+					b.WriteString(s)
+					b.WriteString(strings.Repeat(string(PadChar), padLen-halfPadLen))
+				case text.RightAligned:
+					b.WriteString(strings.Repeat(string(PadChar), padLen))
+					b.WriteString(s)
+				default:
+					b.WriteString(s)
+					b.WriteString(strings.Repeat(string(PadChar), padLen))
+				}
+			}
+
+			b.WriteString(e.border.padding)
+		}
+
+		b.WriteString(e.border.right)
+
+		line := b.String()
+		if len(e.border.right) < 1 {
+			line = strings.TrimRight(line, string(PadChar))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (e *Encoder) encodeExpanded() error {
+	labelWidth := 0
+	if !e.WithoutHeader {
+		for _, f := range e.header {
+			if labelWidth < f.width {
+				labelWidth = f.width
+			}
+		}
+	}
+
+	valueWidth := 0
+	for _, record := range e.recordSet {
+		for _, f := range record {
+			if valueWidth < f.width {
+				valueWidth = f.width
+			}
+		}
+	}
+
+	separator := e.border.padding + e.border.inner + e.border.padding
+	if e.WithoutHeader {
+		separator = ""
+	}
+	blockWidth := labelWidth + utf8.RuneCountInString(separator) + valueWidth
+
+	fill := e.border.middle.fill
+	if len(fill) < 1 {
+		fill = "-"
+	}
+
+	lines := make([]string, 0, len(e.recordSet)*(e.fieldLen+1))
+	for i, record := range e.recordSet {
+		title := fill + "[ RECORD " + strconv.Itoa(i+1) + " ]"
+		if titleWidth := utf8.RuneCountInString(title); titleWidth < blockWidth {
+			title = title + strings.Repeat(fill, blockWidth-titleWidth)
+		}
+		lines = append(lines, title)
+
+		for j := 0; j < e.fieldLen; j++ {
+			var label Field
+			if !e.WithoutHeader && j < len(e.header) {
+				label = e.header[j]
+			}
+			var field Field
+			if j < len(record) {
+				field = record[j]
+			}
+
+			lineLen := len(label.lines)
+			if lineLen < len(field.lines) {
+				lineLen = len(field.lines)
+			}
+			if lineLen < 1 {
+				lineLen = 1
+			}
+
+			for lineIdx := 0; lineIdx < lineLen; lineIdx++ {
+				var b strings.Builder
+
+				l := ""
+				if lineIdx < len(label.lines) {
+					l = label.lines[lineIdx]
+				}
+				b.WriteString(l)
+				b.WriteString(strings.Repeat(string(PadChar), labelWidth-e.textWidth(l)))
+				b.WriteString(separator)
+				if lineIdx < len(field.lines) {
+					b.WriteString(field.lines[lineIdx])
+				}
+
+				lines = append(lines, strings.TrimRight(b.String(), string(PadChar)))
+			}
+		}
+	}
+
+	return e.writeLines(lines)
+}
+
+func (e *Encoder) writeLines(lines []string) error {
+	for i, line := range lines {
+		if 0 < i {
+			if _, err := e.writer.WriteString(e.lineBreak); err != nil {
+				return err
+			}
+		}
+		if _, err := e.writer.WriteString(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package texttable
+
+import (
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var encoderEncodeTests = []struct {
+	Name      string
+	Style     BorderStyle
+	Expanded  bool
+	MaxWidth  int
+	NoHeader  bool
+	LineBreak text.LineBreak
+	Header    []Field
+	Records   [][]Field
+	Expect    string
+}{
+	{
+		Name:      "ASCII",
+		Style:     ASCII,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("c2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+			{NewField("23", text.RightAligned), NewField("d\ne", text.NotAligned)},
+		},
+		Expect: "+----+------+\n" +
+			"| c1 |  c2  |\n" +
+			"+----+------+\n" +
+			"|  1 | abc  |\n" +
+			"| 23 | d    |\n" +
+			"|    | e    |\n" +
+			"+----+------+",
+	},
+	{
+		Name:      "Double Line",
+		Style:     DoubleLine,
+		LineBreak: text.CRLF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("c2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+		},
+		Expect: "╔════╦══════╗\r\n" +
+			"║ c1 ║  c2  ║\r\n" +
+			"╠════╬══════╣\r\n" +
+			"║  1 ║ abc  ║\r\n" +
+			"╚════╩══════╝",
+	},
+	{
+		Name:      "Minimal",
+		Style:     Minimal,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("c2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+		},
+		Expect: "c1   c2\n" +
+			"--  ----\n" +
+			" 1  abc",
+	},
+	{
+		Name:      "ASCII Without Header",
+		Style:     ASCII,
+		NoHeader:  true,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("column2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+			{NewField("23", text.RightAligned), NewField("d", text.NotAligned)},
+		},
+		Expect: "+----+-----+\n" +
+			"|  1 | abc |\n" +
+			"| 23 | d   |\n" +
+			"+----+-----+",
+	},
+	{
+		Name:      "Minimal Without Header",
+		Style:     Minimal,
+		NoHeader:  true,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("c2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+		},
+		Expect: "1  abc",
+	},
+	{
+		Name:      "Expanded",
+		Style:     SingleLine,
+		Expanded:  true,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("column2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+		},
+		Expect: "─[ RECORD 1 ]\n" +
+			"c1      │ 1\n" +
+			"column2 │ abc",
+	},
+	{
+		Name:      "Expanded by MaxWidth",
+		Style:     ASCII,
+		MaxWidth:  12,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("c2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abcdef", text.NotAligned)},
+		},
+		Expect: "-[ RECORD 1 ]\n" +
+			"c1 | 1\n" +
+			"c2 | abcdef",
+	},
+	{
+		Name:      "Expanded Without Header",
+		Style:     SingleLine,
+		Expanded:  true,
+		NoHeader:  true,
+		LineBreak: text.LF,
+		Header:    []Field{NewField("c1", text.Centering), NewField("column2", text.Centering)},
+		Records: [][]Field{
+			{NewField("1", text.RightAligned), NewField("abc", text.NotAligned)},
+		},
+		Expect: "─[ RECORD 1 ]\n" +
+			"1\n" +
+			"abc",
+	},
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	for _, v := range encoderEncodeTests {
+		e := NewEncoder(v.Style, len(v.Records))
+		e.Expanded = v.Expanded
+		e.MaxWidth = v.MaxWidth
+		e.WithoutHeader = v.NoHeader
+		e.LineBreak = v.LineBreak
+		e.SetHeader(v.Header)
+		for _, r := range v.Records {
+			e.AppendRecord(r)
+		}
+
+		result, err := e.Encode()
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Expect)
+		}
+	}
+}
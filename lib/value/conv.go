@@ -139,6 +139,192 @@ func StrToTime(s string, formats []string) (time.Time, bool) {
 	return time.Time{}, false
 }
 
+// StrToNumberInLocale converts a string representing a number with the decimal point and the thousands separators
+// of the locale to an integer or a float value.
+//
+// If thousands separators are used, then they must separate every three digits of the integer part.
+func StrToNumberInLocale(s string, locale *cmd.Locale) (Primary, bool) {
+	runes := []rune(cmd.TrimSpace(s))
+	if len(runes) < 1 {
+		return nil, false
+	}
+
+	var buf bytes.Buffer
+	pos := 0
+	if runes[pos] == '+' || runes[pos] == '-' {
+		buf.WriteRune(runes[pos])
+		pos++
+	}
+
+	groupDigits := 0
+	grouped := false
+	for ; pos < len(runes); pos++ {
+		r := runes[pos]
+		if '0' <= r && r <= '9' {
+			buf.WriteRune(r)
+			groupDigits++
+			continue
+		}
+		if r == locale.DecimalPoint || !locale.IsThousandsSeparator(r) {
+			break
+		}
+		if groupDigits < 1 || (grouped && groupDigits != 3) || (!grouped && 3 < groupDigits) {
+			return nil, false
+		}
+		grouped = true
+		groupDigits = 0
+	}
+	if groupDigits < 1 || (grouped && groupDigits != 3) {
+		return nil, false
+	}
+
+	if len(runes) <= pos {
+		if i, e := strconv.ParseInt(buf.String(), 10, 64); e == nil {
+			return NewInteger(i), true
+		}
+		if f, e := strconv.ParseFloat(buf.String(), 64); e == nil {
+			return NewFloat(f), true
+		}
+		return nil, false
+	}
+
+	if runes[pos] != locale.DecimalPoint || len(runes) < pos+2 {
+		return nil, false
+	}
+	buf.WriteByte('.')
+	for pos = pos + 1; pos < len(runes); pos++ {
+		if runes[pos] < '0' || '9' < runes[pos] {
+			return nil, false
+		}
+		buf.WriteRune(runes[pos])
+	}
+
+	f, e := strconv.ParseFloat(buf.String(), 64)
+	if e != nil {
+		return nil, false
+	}
+	return NewFloat(f), true
+}
+
+// StrToTimeInLocale converts a string representing a date in the date order of the locale to a datetime value.
+//
+// Dates are written with numbers separated by periods, slashes or hyphens, and may be followed by the time
+// separated by a space, such as "31.12.2026 13:45:30". Years with two digits are interpreted as years
+// from 1969 to 2068.
+func StrToTimeInLocale(s string, locale *cmd.Locale) (time.Time, bool) {
+	s = cmd.TrimSpace(s)
+
+	date := s
+	clock := ""
+	if i := strings.IndexByte(s, ' '); -1 < i {
+		date = s[:i]
+		clock = cmd.TrimSpace(s[i+1:])
+	}
+
+	if len(date) < 6 {
+		return time.Time{}, false
+	}
+	sep := ""
+	for _, c := range []string{".", "/", "-"} {
+		if strings.Count(date, c) == 2 {
+			sep = c
+			break
+		}
+	}
+	if len(sep) < 1 {
+		return time.Time{}, false
+	}
+	elems := strings.Split(date, sep)
+
+	var year, month, day string
+	switch locale.DateOrder {
+	case cmd.DateOrderDMY:
+		day, month, year = elems[0], elems[1], elems[2]
+	case cmd.DateOrderMDY:
+		month, day, year = elems[0], elems[1], elems[2]
+	default:
+		year, month, day = elems[0], elems[1], elems[2]
+	}
+	if (len(year) != 2 && len(year) != 4) || len(month) < 1 || 2 < len(month) || len(day) < 1 || 2 < len(day) {
+		return time.Time{}, false
+	}
+
+	layout := "2006"
+	if len(year) == 2 {
+		layout = "06"
+	}
+	layout = layout + "-1-2"
+	str := year + "-" + month + "-" + day
+
+	if 0 < len(clock) {
+		switch strings.Count(clock, ":") {
+		case 1:
+			layout = layout + " 15:04"
+		case 2:
+			layout = layout + " 15:04:05.999999999"
+		default:
+			return time.Time{}, false
+		}
+		str = str + " " + clock
+	}
+
+	t, e := time.ParseInLocation(layout, str, cmd.GetLocation())
+	if e != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// FormatDatetime formats a datetime according to the format of the function DATETIME_FORMAT.
+//
+// The placeholder %L is replaced with the name of the timezone such as "Europe/Berlin".
+// If the name is not available, then the abbreviation of the timezone is used instead.
+func FormatDatetime(t time.Time, format string) string {
+	parts := splitDatetimeFormatByZoneName(format)
+	if len(parts) == 1 {
+		return t.Format(DatetimeFormats.Get(format))
+	}
+
+	name := t.Location().String()
+	if name == "Local" {
+		name = t.Format("MST")
+	}
+
+	var buf bytes.Buffer
+	for i, part := range parts {
+		if 0 < i {
+			buf.WriteString(name)
+		}
+		if 0 < len(part) {
+			buf.WriteString(t.Format(DatetimeFormats.Get(part)))
+		}
+	}
+	return buf.String()
+}
+
+func splitDatetimeFormatByZoneName(format string) []string {
+	if !strings.Contains(format, "%L") {
+		return []string{format}
+	}
+
+	parts := make([]string, 0, 2)
+	runes := []rune(format)
+	start := 0
+	escaped := false
+	for i, r := range runes {
+		if !escaped {
+			escaped = r == '%'
+			continue
+		}
+		if r == 'L' {
+			parts = append(parts, string(runes[start:i-1]))
+			start = i + 1
+		}
+		escaped = false
+	}
+	return append(parts, string(runes[start:]))
+}
+
 func ConvertDatetimeFormat(format string) string {
 	runes := []rune(format)
 	var buf bytes.Buffer
@@ -202,6 +388,10 @@ func ConvertDatetimeFormat(format string) string {
 			buf.WriteString("2006")
 		case 'y':
 			buf.WriteString("06")
+		case 'O':
+			buf.WriteString("-07:00")
+		case 'o':
+			buf.WriteString("-0700")
 		case 'Z':
 			buf.WriteString("Z07:00")
 		case 'z':
@@ -1,6 +1,7 @@
 package value
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -158,6 +159,70 @@ func TestStrToTime(t *testing.T) {
 	}
 }
 
+var strToNumberInLocaleTests = []struct {
+	Input  string
+	Locale string
+	Result Primary
+	OK     bool
+}{
+	{Input: "1.234,56", Locale: "de-DE", Result: NewFloat(1234.56), OK: true},
+	{Input: "-1.234.567", Locale: "de-DE", Result: NewInteger(-1234567), OK: true},
+	{Input: "0,5", Locale: "de-DE", Result: NewFloat(0.5), OK: true},
+	{Input: "1,234.56", Locale: "en-US", Result: NewFloat(1234.56), OK: true},
+	{Input: "1 234,5", Locale: "fr-FR", Result: NewFloat(1234.5), OK: true},
+	{Input: "1\u202f234", Locale: "fr-FR", Result: NewInteger(1234), OK: true},
+	{Input: "1'234.5", Locale: "de-CH", Result: NewFloat(1234.5), OK: true},
+	{Input: "1.23", Locale: "de-DE", OK: false},
+	{Input: "1234.567", Locale: "de-DE", OK: false},
+	{Input: "1,234.56", Locale: "de-DE", OK: false},
+	{Input: "1,", Locale: "de-DE", OK: false},
+	{Input: "abc", Locale: "de-DE", OK: false},
+}
+
+func TestStrToNumberInLocale(t *testing.T) {
+	for _, v := range strToNumberInLocaleTests {
+		locale, _ := cmd.ParseLocale(v.Locale)
+		result, ok := StrToNumberInLocale(v.Input, locale)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q in %s", ok, v.OK, v.Input, v.Locale)
+			continue
+		}
+		if ok && !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %q in %s", result, v.Result, v.Input, v.Locale)
+		}
+	}
+}
+
+var strToTimeInLocaleTests = []struct {
+	Input  string
+	Locale string
+	Result time.Time
+	OK     bool
+}{
+	{Input: "31.12.2026", Locale: "de-DE", Result: time.Date(2026, 12, 31, 0, 0, 0, 0, cmd.GetLocation()), OK: true},
+	{Input: "1.2.26 13:45", Locale: "de-DE", Result: time.Date(2026, 2, 1, 13, 45, 0, 0, cmd.GetLocation()), OK: true},
+	{Input: "12/31/2026 13:45:30.5", Locale: "en-US", Result: time.Date(2026, 12, 31, 13, 45, 30, 500000000, cmd.GetLocation()), OK: true},
+	{Input: "2026.12.31", Locale: "ko-KR", Result: time.Date(2026, 12, 31, 0, 0, 0, 0, cmd.GetLocation()), OK: true},
+	{Input: "12/31/2026", Locale: "de-DE", OK: false},
+	{Input: "31.12.202", Locale: "de-DE", OK: false},
+	{Input: "31.12.2026 13", Locale: "de-DE", OK: false},
+	{Input: "1.234,56", Locale: "de-DE", OK: false},
+}
+
+func TestStrToTimeInLocale(t *testing.T) {
+	for _, v := range strToTimeInLocaleTests {
+		locale, _ := cmd.ParseLocale(v.Locale)
+		result, ok := StrToTimeInLocale(v.Input, locale)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q in %s", ok, v.OK, v.Input, v.Locale)
+			continue
+		}
+		if ok && !result.Equal(v.Result) {
+			t.Errorf("result = %s, want %s for %q in %s", result, v.Result, v.Input, v.Locale)
+		}
+	}
+}
+
 var convertDatetimeFormatTests = []struct {
 	Datetime string
 	Format   string
@@ -235,6 +300,14 @@ var convertDatetimeFormatTests = []struct {
 		Format: "%y",
 		Result: "06",
 	},
+	{
+		Format: "%O",
+		Result: "-07:00",
+	},
+	{
+		Format: "%o",
+		Result: "-0700",
+	},
 	{
 		Format: "%Z",
 		Result: "Z07:00",
@@ -254,6 +327,37 @@ func TestConvertDatetimeFormat(t *testing.T) {
 	}
 }
 
+var formatDatetimeTests = []struct {
+	Time   time.Time
+	Format string
+	Result string
+}{
+	{
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.FixedZone("+09:00", 9*3600)),
+		Format: "%Y-%m-%d %T %O %o %Z",
+		Result: "2012-02-03 09:18:15 +09:00 +0900 +09:00",
+	},
+	{
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+		Format: "%T %L (%Z)",
+		Result: "09:18:15 UTC (Z)",
+	},
+	{
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.FixedZone("JST", 9*3600)),
+		Format: "%L%% %%L %L",
+		Result: "JST% %L JST",
+	},
+}
+
+func TestFormatDatetime(t *testing.T) {
+	for _, v := range formatDatetimeTests {
+		result := FormatDatetime(v.Time, v.Format)
+		if result != v.Result {
+			t.Errorf("result = %q, want %q for %q", result, v.Result, v.Format)
+		}
+	}
+}
+
 func TestFloat64ToTime(t *testing.T) {
 	f := float64(1136181845)
 	expect := time.Date(2006, 1, 2, 6, 4, 5, 0, time.UTC).In(cmd.GetLocation())
@@ -0,0 +1,212 @@
+package xml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+const (
+	AttributePrefix = "@"
+	TextKey         = "#text"
+)
+
+// declarationSampleSize is the number of bytes read to find the XML declaration.
+const declarationSampleSize = 1024
+
+var encodingDeclaration = regexp.MustCompile(`\sencoding\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+
+type node struct {
+	name     string
+	obj      json.Object
+	text     strings.Builder
+	hasChild bool
+}
+
+// Decode reads an XML document and converts it to a JSON structure.
+//
+// The document is converted to an object that has one member keyed by the name of the root element.
+// Attributes are converted to members keyed by the attribute names prefixed with "@",
+// and child elements that have the same name are gathered into an array.
+// An element that has neither attributes nor child elements is converted to a string, or null if it is empty.
+// Text contents of an element that has attributes or child elements are converted to a member keyed by "#text".
+//
+// The reader must return UTF-8 encoded text. Encoding declarations in the document are ignored,
+// so the encoding should be determined by DeclaredEncoding before the text is converted.
+func Decode(r io.Reader) (json.Object, error) {
+	d := xml.NewDecoder(r)
+	d.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	doc := json.NewObject(1)
+	stack := make([]*node, 0, 10)
+
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return doc, err
+		}
+
+		switch token.(type) {
+		case xml.StartElement:
+			elem := token.(xml.StartElement)
+			if len(stack) < 1 && 0 < doc.Len() {
+				return doc, errors.New("xml document must have only one root element")
+			}
+			if 0 < len(stack) {
+				stack[len(stack)-1].hasChild = true
+			}
+
+			n := &node{
+				name: elem.Name.Local,
+				obj:  json.NewObject(len(elem.Attr)),
+			}
+			for _, attr := range elem.Attr {
+				if attr.Name.Space == "xmlns" || (len(attr.Name.Space) < 1 && attr.Name.Local == "xmlns") {
+					continue
+				}
+				n.obj.Add(AttributePrefix+attr.Name.Local, json.String(attr.Value))
+			}
+			stack = append(stack, n)
+		case xml.CharData:
+			if 0 < len(stack) {
+				stack[len(stack)-1].text.Write(token.(xml.CharData))
+			}
+		case xml.EndElement:
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			val := n.value()
+			if len(stack) < 1 {
+				doc.Add(n.name, val)
+			} else {
+				addMember(&stack[len(stack)-1].obj, n.name, val)
+			}
+		}
+	}
+
+	if doc.Len() < 1 {
+		return doc, errors.New("xml document has no root element")
+	}
+	return doc, nil
+}
+
+// DeclaredEncoding returns the encoding name in the XML declaration at the beginning of the reader,
+// or an empty string if the declaration or the encoding is not specified.
+// Declarations that are not written in ASCII-compatible encodings are not found.
+//
+// The reader is returned to the position at which it was passed.
+func DeclaredEncoding(r io.ReadSeeker) (string, error) {
+	pos, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+
+	sample := make([]byte, declarationSampleSize)
+	n, err := io.ReadFull(r, sample)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	if _, err = r.Seek(pos, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	sample = bytes.TrimPrefix(sample[:n], []byte{0xef, 0xbb, 0xbf})
+	if !bytes.HasPrefix(sample, []byte("<?xml")) || len(sample) < 6 || !isSpace(sample[5]) {
+		return "", nil
+	}
+	end := bytes.Index(sample, []byte("?>"))
+	if end < 0 {
+		return "", nil
+	}
+
+	m := encodingDeclaration.FindSubmatch(sample[:end])
+	if m == nil {
+		return "", nil
+	}
+	if 0 < len(m[1]) {
+		return string(m[1]), nil
+	}
+	return string(m[2]), nil
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+func (n *node) value() json.Structure {
+	if n.obj.Len() < 1 && !n.hasChild {
+		if n.text.Len() < 1 {
+			return json.Null{}
+		}
+		return json.String(n.text.String())
+	}
+
+	if s := strings.TrimSpace(n.text.String()); 0 < len(s) {
+		n.obj.Add(TextKey, json.String(s))
+	}
+	return n.obj
+}
+
+func addMember(obj *json.Object, key string, val json.Structure) {
+	if !obj.Exists(key) {
+		obj.Add(key, val)
+		return
+	}
+
+	if ar, ok := obj.Value(key).(json.Array); ok {
+		obj.Update(key, append(ar, val))
+	} else {
+		obj.Update(key, json.Array{obj.Value(key), val})
+	}
+}
+
+// Rows returns the child elements of the root element in the document decoded by Decode,
+// and the name of the child elements if all of them have the same name.
+func Rows(doc json.Object) (json.Array, string) {
+	rows := make(json.Array, 0, 10)
+	rowElement := ""
+
+	if doc.Len() < 1 {
+		return rows, rowElement
+	}
+
+	root, ok := doc.Members[0].Value.(json.Object)
+	if !ok {
+		return rows, rowElement
+	}
+
+	for _, m := range root.Members {
+		if strings.HasPrefix(m.Key, AttributePrefix) || m.Key == TextKey {
+			continue
+		}
+
+		if len(rowElement) < 1 && len(rows) < 1 {
+			rowElement = m.Key
+		} else if rowElement != m.Key {
+			rowElement = ""
+		}
+
+		if ar, ok := m.Value.(json.Array); ok {
+			rows = append(rows, ar...)
+		} else {
+			rows = append(rows, m.Value)
+		}
+	}
+
+	for i := range rows {
+		if _, ok := rows[i].(json.Null); ok {
+			rows[i] = json.NewObject(0)
+		}
+	}
+	return rows, rowElement
+}
@@ -0,0 +1,185 @@
+package xml
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+var decodeTests = []struct {
+	Name   string
+	Input  string
+	Expect json.Object
+	Error  string
+}{
+	{
+		Name: "Decode",
+		Input: "<?xml version=\"1.0\" encoding=\"Shift_JIS\"?>\n" +
+			"<catalog xmlns:x=\"urn:x\">\n" +
+			"  <book id=\"1\" x:lang=\"en\">\n" +
+			"    <title>Go &amp; You</title>\n" +
+			"    <price/>\n" +
+			"  </book>\n" +
+			"  <book id=\"2\">note<title>title2</title></book>\n" +
+			"</catalog>",
+		Expect: json.Object{Members: []json.ObjectMember{
+			{Key: "catalog", Value: json.Object{Members: []json.ObjectMember{
+				{Key: "book", Value: json.Array{
+					json.Object{Members: []json.ObjectMember{
+						{Key: "@id", Value: json.String("1")},
+						{Key: "@lang", Value: json.String("en")},
+						{Key: "title", Value: json.String("Go & You")},
+						{Key: "price", Value: json.Null{}},
+					}},
+					json.Object{Members: []json.ObjectMember{
+						{Key: "@id", Value: json.String("2")},
+						{Key: "title", Value: json.String("title2")},
+						{Key: "#text", Value: json.String("note")},
+					}},
+				}},
+			}}},
+		}},
+	},
+	{
+		Name:  "Decode Leaf Root Element",
+		Input: "<root> text </root>",
+		Expect: json.Object{Members: []json.ObjectMember{
+			{Key: "root", Value: json.String(" text ")},
+		}},
+	},
+	{
+		Name:  "Decode Syntax Error",
+		Input: "<root><a></root>",
+		Error: "XML syntax error on line 1: element <a> closed by </root>",
+	},
+	{
+		Name:  "Decode No Root Element Error",
+		Input: "<?xml version=\"1.0\"?>",
+		Error: "xml document has no root element",
+	},
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range decodeTests {
+		result, err := Decode(strings.NewReader(v.Input))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result.Encode(), v.Expect.Encode())
+		}
+	}
+}
+
+var rowsTests = []struct {
+	Name          string
+	Input         string
+	Expect        json.Array
+	ExpectRowName string
+}{
+	{
+		Name:  "Rows",
+		Input: "<rows count=\"2\"><row id=\"1\"><c1>a</c1></row><row/></rows>",
+		Expect: json.Array{
+			json.Object{Members: []json.ObjectMember{
+				{Key: "@id", Value: json.String("1")},
+				{Key: "c1", Value: json.String("a")},
+			}},
+			json.Object{Members: []json.ObjectMember{}},
+		},
+		ExpectRowName: "row",
+	},
+	{
+		Name:  "Rows with Different Names",
+		Input: "<rows><a><c1>a</c1></a><b><c1>b</c1></b></rows>",
+		Expect: json.Array{
+			json.Object{Members: []json.ObjectMember{
+				{Key: "c1", Value: json.String("a")},
+			}},
+			json.Object{Members: []json.ObjectMember{
+				{Key: "c1", Value: json.String("b")},
+			}},
+		},
+		ExpectRowName: "",
+	},
+	{
+		Name:          "Rows Empty Root Element",
+		Input:         "<rows></rows>",
+		Expect:        json.Array{},
+		ExpectRowName: "",
+	},
+}
+
+func TestRows(t *testing.T) {
+	for _, v := range rowsTests {
+		doc, err := Decode(strings.NewReader(v.Input))
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		result, rowName := Rows(doc)
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result.Encode(), v.Expect.Encode())
+		}
+		if rowName != v.ExpectRowName {
+			t.Errorf("%s: row name = %q, want %q", v.Name, rowName, v.ExpectRowName)
+		}
+	}
+}
+
+var declaredEncodingTests = []struct {
+	Name   string
+	Input  string
+	Expect string
+}{
+	{
+		Name:   "DeclaredEncoding",
+		Input:  "<?xml version=\"1.0\" encoding=\"Shift_JIS\"?>\n<rows/>",
+		Expect: "Shift_JIS",
+	},
+	{
+		Name:   "DeclaredEncoding Single Quotes with BOM",
+		Input:  "\xef\xbb\xbf<?xml version='1.0' encoding='UTF-8' standalone='yes'?><rows/>",
+		Expect: "UTF-8",
+	},
+	{
+		Name:   "DeclaredEncoding Not Specified",
+		Input:  "<?xml version=\"1.0\"?><rows/>",
+		Expect: "",
+	},
+	{
+		Name:   "DeclaredEncoding Without Declaration",
+		Input:  "<rows encoding=\"UTF-8\"/>",
+		Expect: "",
+	},
+}
+
+func TestDeclaredEncoding(t *testing.T) {
+	for _, v := range declaredEncodingTests {
+		r := strings.NewReader(v.Input)
+		result, err := DeclaredEncoding(r)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Expect)
+		}
+		if pos, _ := r.Seek(0, io.SeekCurrent); pos != 0 {
+			t.Errorf("%s: position = %d, want 0", v.Name, pos)
+		}
+	}
+}
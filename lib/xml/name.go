@@ -0,0 +1,100 @@
+package xml
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+)
+
+func isNameStartRune(r rune) bool {
+	return r == '_' || r == ':' || unicode.IsLetter(r)
+}
+
+func isNameRune(r rune) bool {
+	return isNameStartRune(r) || r == '-' || r == '.' || unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc)
+}
+
+// IsName reports whether the string can be used as an element name or an attribute name.
+func IsName(s string) bool {
+	if len(s) < 1 {
+		return false
+	}
+
+	for i, r := range []rune(s) {
+		if i == 0 {
+			if !isNameStartRune(r) {
+				return false
+			}
+		} else if !isNameRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// ConvertToName replaces the characters that are not allowed in names with underscores.
+func ConvertToName(s string) string {
+	runes := []rune(s)
+	name := make([]rune, 0, len(runes)+1)
+
+	if len(runes) < 1 {
+		return "_"
+	}
+	if !isNameStartRune(runes[0]) && isNameRune(runes[0]) {
+		name = append(name, '_')
+	}
+	for _, r := range runes {
+		if isNameRune(r) {
+			name = append(name, r)
+		} else {
+			name = append(name, '_')
+		}
+	}
+	return string(name)
+}
+
+// EncodingName returns the name of the encoding used in XML declarations.
+func EncodingName(enc text.Encoding) string {
+	switch enc {
+	case text.UTF16, text.UTF16BEM, text.UTF16LEM:
+		return "UTF-16"
+	case text.UTF16BE:
+		return "UTF-16BE"
+	case text.UTF16LE:
+		return "UTF-16LE"
+	case text.SJIS:
+		return "Shift_JIS"
+	default:
+		if name := charset.IANAName(enc); 0 < len(name) {
+			return name
+		}
+		return "UTF-8"
+	}
+}
+
+// ParseEncodingName returns the encoding of the name used in XML declarations.
+// Names are compared case-insensitively. If the name is not supported, then false is returned.
+func ParseEncodingName(name string) (text.Encoding, bool) {
+	switch strings.ToUpper(name) {
+	case "UTF-8", "US-ASCII":
+		return text.UTF8, true
+	case "UTF-16":
+		return text.UTF16, true
+	case "UTF-16BE":
+		return text.UTF16BE, true
+	case "UTF-16LE":
+		return text.UTF16LE, true
+	case "SHIFT_JIS":
+		return text.SJIS, true
+	}
+
+	for enc := range charset.EncodingLiteral {
+		if strings.EqualFold(charset.IANAName(enc), name) {
+			return enc, true
+		}
+	}
+	return text.AUTO, false
+}
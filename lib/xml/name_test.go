@@ -0,0 +1,98 @@
+package xml
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+)
+
+var isNameTests = []struct {
+	Input  string
+	Expect bool
+}{
+	{Input: "row", Expect: true},
+	{Input: "_row-1.a", Expect: true},
+	{Input: "x:row", Expect: true},
+	{Input: "行", Expect: true},
+	{Input: "", Expect: false},
+	{Input: "1row", Expect: false},
+	{Input: "-row", Expect: false},
+	{Input: "row name", Expect: false},
+}
+
+func TestIsName(t *testing.T) {
+	for _, v := range isNameTests {
+		result := IsName(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %t, want %t for %q", result, v.Expect, v.Input)
+		}
+	}
+}
+
+var convertToNameTests = []struct {
+	Input  string
+	Expect string
+}{
+	{Input: "row", Expect: "row"},
+	{Input: "row name", Expect: "row_name"},
+	{Input: "1row", Expect: "_1row"},
+	{Input: "@id", Expect: "_id"},
+	{Input: "", Expect: "_"},
+}
+
+func TestConvertToName(t *testing.T) {
+	for _, v := range convertToNameTests {
+		result := ConvertToName(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %q", result, v.Expect, v.Input)
+		}
+	}
+}
+
+var encodingNameTests = []struct {
+	Input  text.Encoding
+	Expect string
+}{
+	{Input: text.UTF8, Expect: "UTF-8"},
+	{Input: text.UTF16LEM, Expect: "UTF-16"},
+	{Input: text.SJIS, Expect: "Shift_JIS"},
+	{Input: charset.EUCJP, Expect: "EUC-JP"},
+	{Input: charset.WINDOWS1252, Expect: "windows-1252"},
+}
+
+func TestEncodingName(t *testing.T) {
+	for _, v := range encodingNameTests {
+		result := EncodingName(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %s", result, v.Expect, charset.String(v.Input))
+		}
+	}
+}
+
+var parseEncodingNameTests = []struct {
+	Input  string
+	Expect text.Encoding
+	OK     bool
+}{
+	{Input: "UTF-8", Expect: text.UTF8, OK: true},
+	{Input: "utf-16le", Expect: text.UTF16LE, OK: true},
+	{Input: "Shift_JIS", Expect: text.SJIS, OK: true},
+	{Input: "euc-jp", Expect: charset.EUCJP, OK: true},
+	{Input: "ISO-8859-1", Expect: charset.LATIN1, OK: true},
+	{Input: "x-unknown", Expect: text.AUTO, OK: false},
+}
+
+func TestParseEncodingName(t *testing.T) {
+	for _, v := range parseEncodingNameTests {
+		result, ok := ParseEncodingName(v.Input)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q", ok, v.OK, v.Input)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("result = %s, want %s for %q", charset.String(result), charset.String(v.Expect), v.Input)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package xml
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/charset"
+
+	"github.com/mithrandie/go-text"
+)
+
+const IndentSpaces = "  "
+
+type Field struct {
+	Contents string
+	IsNull   bool
+}
+
+func NewField(s string) Field {
+	return Field{Contents: s}
+}
+
+func NewNullField() Field {
+	return Field{IsNull: true}
+}
+
+type fieldType int
+
+const (
+	elementField fieldType = iota
+	attributeField
+	textField
+)
+
+type Writer struct {
+	PrettyPrint bool
+
+	rootElement string
+	rowElement  string
+	names       []string
+	types       []fieldType
+	lineBreak   string
+	encoding    text.Encoding
+
+	writer  *bufio.Writer
+	started bool
+}
+
+// NewWriter returns a writer that writes records as child elements of the root element.
+//
+// A field whose label is prefixed with "@" is written as an attribute of the row element,
+// and a field whose label is "#text" is written as text contents of the row element.
+// Other fields are written as child elements of the row element.
+// Characters that are not allowed in names are replaced with underscores.
+func NewWriter(w io.Writer, header []string, rootElement string, rowElement string, lineBreak text.LineBreak, enc text.Encoding) (*Writer, error) {
+	if !IsName(rootElement) {
+		return nil, errors.New(fmt.Sprintf("invalid root element name: %q", rootElement))
+	}
+	if !IsName(rowElement) {
+		return nil, errors.New(fmt.Sprintf("invalid row element name: %q", rowElement))
+	}
+
+	names := make([]string, len(header))
+	types := make([]fieldType, len(header))
+	for i, label := range header {
+		switch {
+		case label == TextKey:
+			types[i] = textField
+		case strings.HasPrefix(label, AttributePrefix) && 1 < len(label):
+			names[i] = ConvertToName(label[len(AttributePrefix):])
+			types[i] = attributeField
+		default:
+			names[i] = ConvertToName(label)
+			types[i] = elementField
+		}
+	}
+
+	writer, err := charset.GetTransformWriter(w, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		rootElement: rootElement,
+		rowElement:  rowElement,
+		names:       names,
+		types:       types,
+		lineBreak:   lineBreak.Value(),
+		encoding:    enc,
+		writer:      bufio.NewWriter(writer),
+	}, nil
+}
+
+func (e *Writer) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	if _, err := e.writer.WriteString("<?xml version=\"1.0\" encoding=\"" + EncodingName(e.encoding) + "\"?>" + e.lineBreak); err != nil {
+		return err
+	}
+	_, err := e.writer.WriteString("<" + e.rootElement + ">")
+	return err
+}
+
+func (e *Writer) newLine(depth int) error {
+	if !e.PrettyPrint {
+		return nil
+	}
+	_, err := e.writer.WriteString(e.lineBreak + strings.Repeat(IndentSpaces, depth))
+	return err
+}
+
+func (e *Writer) Write(record []Field) error {
+	if len(record) != len(e.names) {
+		return errors.New("field length does not match")
+	}
+
+	if err := e.start(); err != nil {
+		return err
+	}
+	if err := e.newLine(1); err != nil {
+		return err
+	}
+
+	if _, err := e.writer.WriteString("<" + e.rowElement); err != nil {
+		return err
+	}
+	for i := range record {
+		if e.types[i] != attributeField || record[i].IsNull {
+			continue
+		}
+		if _, err := e.writer.WriteString(" " + e.names[i] + "=\""); err != nil {
+			return err
+		}
+		if err := xml.EscapeText(e.writer, []byte(record[i].Contents)); err != nil {
+			return err
+		}
+		if err := e.writer.WriteByte('"'); err != nil {
+			return err
+		}
+	}
+	if err := e.writer.WriteByte('>'); err != nil {
+		return err
+	}
+
+	for i := range record {
+		if e.types[i] != textField {
+			continue
+		}
+		if err := xml.EscapeText(e.writer, []byte(record[i].Contents)); err != nil {
+			return err
+		}
+	}
+
+	hasElement := false
+	for i := range record {
+		if e.types[i] != elementField {
+			continue
+		}
+		hasElement = true
+
+		if err := e.newLine(2); err != nil {
+			return err
+		}
+		if record[i].IsNull {
+			if _, err := e.writer.WriteString("<" + e.names[i] + "/>"); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := e.writer.WriteString("<" + e.names[i] + ">"); err != nil {
+			return err
+		}
+		if err := xml.EscapeText(e.writer, []byte(record[i].Contents)); err != nil {
+			return err
+		}
+		if _, err := e.writer.WriteString("</" + e.names[i] + ">"); err != nil {
+			return err
+		}
+	}
+
+	if hasElement {
+		if err := e.newLine(1); err != nil {
+			return err
+		}
+	}
+	_, err := e.writer.WriteString("</" + e.rowElement + ">")
+	return err
+}
+
+// Flush writes the end tag of the root element and flushes the buffered data.
+func (e *Writer) Flush() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if err := e.newLine(0); err != nil {
+		return err
+	}
+	if _, err := e.writer.WriteString("</" + e.rootElement + ">"); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
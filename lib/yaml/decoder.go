@@ -0,0 +1,232 @@
+package yaml
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/mithrandie/go-text/json"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nullTag  = "!!null"
+	boolTag  = "!!bool"
+	intTag   = "!!int"
+	floatTag = "!!float"
+	strTag   = "!!str"
+	mergeTag = "!!merge"
+)
+
+// aliasExpansionLimit is the maximum number of nodes that can be generated by expanding aliases in a stream.
+// It prevents a small document with nested aliases from being expanded to an enormous structure.
+var aliasExpansionLimit = 1000000
+
+type decoder struct {
+	expanding     map[*yaml.Node]bool
+	expandedNodes int
+}
+
+func newDecoder() *decoder {
+	return &decoder{
+		expanding: make(map[*yaml.Node]bool),
+	}
+}
+
+// Decode reads a YAML stream and converts it to a JSON structure.
+//
+// Mappings are converted to objects and sequences are converted to arrays.
+// Aliases are replaced with the nodes that they refer to, and merge keys are expanded.
+// An alias that refers to a node containing itself, or aliases generating too many nodes cause an error.
+// Scalars that are resolved as null, boolean, integer or float values are converted to the corresponding values,
+// and other scalars are converted to strings.
+//
+// If the stream contains more than one document, then the documents are gathered into an array.
+func Decode(r io.Reader) (json.Structure, error) {
+	d := yaml.NewDecoder(r)
+	dec := newDecoder()
+
+	documents := make(json.Array, 0, 1)
+	for {
+		var node yaml.Node
+		if err := d.Decode(&node); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		st, err := dec.convert(&node)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, st)
+	}
+
+	switch len(documents) {
+	case 0:
+		return nil, errors.New("yaml document is empty")
+	case 1:
+		return documents[0], nil
+	default:
+		return documents, nil
+	}
+}
+
+func (d *decoder) enterAlias(node *yaml.Node) (*yaml.Node, error) {
+	if d.expanding[node.Alias] {
+		return nil, errors.New(fmt.Sprintf("line %d, column %d: alias *%s refers to a node containing itself", node.Line, node.Column, node.Value))
+	}
+	d.expanding[node.Alias] = true
+	return node.Alias, nil
+}
+
+func (d *decoder) leaveAlias(node *yaml.Node) {
+	delete(d.expanding, node.Alias)
+}
+
+func (d *decoder) convert(node *yaml.Node) (json.Structure, error) {
+	if 0 < len(d.expanding) {
+		d.expandedNodes++
+		if aliasExpansionLimit < d.expandedNodes {
+			return nil, errors.New(fmt.Sprintf("line %d, column %d: aliases are expanded to too many nodes", node.Line, node.Column))
+		}
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) < 1 {
+			return json.Null{}, nil
+		}
+		return d.convert(node.Content[0])
+	case yaml.AliasNode:
+		target, err := d.enterAlias(node)
+		if err != nil {
+			return nil, err
+		}
+		st, err := d.convert(target)
+		d.leaveAlias(node)
+		return st, err
+	case yaml.SequenceNode:
+		ar := make(json.Array, 0, len(node.Content))
+		for _, item := range node.Content {
+			st, err := d.convert(item)
+			if err != nil {
+				return nil, err
+			}
+			ar = append(ar, st)
+		}
+		return ar, nil
+	case yaml.MappingNode:
+		obj := json.NewObject(len(node.Content) / 2)
+		if err := d.addMappingMembers(&obj, node); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	default:
+		return convertScalar(node)
+	}
+}
+
+func (d *decoder) addMappingMembers(obj *json.Object, node *yaml.Node) error {
+	for i := 0; i+1 < len(node.Content); i = i + 2 {
+		keyNode := node.Content[i]
+		valueNode := node.Content[i+1]
+
+		if keyNode.Kind == yaml.ScalarNode && keyNode.ShortTag() == mergeTag {
+			if err := d.mergeMembers(obj, valueNode); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if keyNode.Kind == yaml.AliasNode {
+			keyNode = keyNode.Alias
+		}
+		if keyNode.Kind != yaml.ScalarNode {
+			return errors.New(fmt.Sprintf("line %d, column %d: mapping key must be a scalar", keyNode.Line, keyNode.Column))
+		}
+
+		val, err := d.convert(valueNode)
+		if err != nil {
+			return err
+		}
+
+		if obj.Exists(keyNode.Value) {
+			obj.Update(keyNode.Value, val)
+		} else {
+			obj.Add(keyNode.Value, val)
+		}
+	}
+	return nil
+}
+
+func (d *decoder) mergeMembers(obj *json.Object, node *yaml.Node) error {
+	if node.Kind == yaml.AliasNode {
+		target, err := d.enterAlias(node)
+		if err != nil {
+			return err
+		}
+		err = d.mergeMembers(obj, target)
+		d.leaveAlias(node)
+		return err
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		merged := json.NewObject(len(node.Content) / 2)
+		if err := d.addMappingMembers(&merged, node); err != nil {
+			return err
+		}
+		for _, m := range merged.Members {
+			if !obj.Exists(m.Key) {
+				obj.Add(m.Key, m.Value)
+			}
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if err := d.mergeMembers(obj, item); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.New(fmt.Sprintf("line %d, column %d: merge value must be a mapping or a sequence of mappings", node.Line, node.Column))
+	}
+	return nil
+}
+
+func convertScalar(node *yaml.Node) (json.Structure, error) {
+	switch node.ShortTag() {
+	case nullTag:
+		return json.Null{}, nil
+	case boolTag:
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return nil, err
+		}
+		return json.Boolean(b), nil
+	case intTag:
+		var i int64
+		if err := node.Decode(&i); err == nil {
+			return json.Integer(i), nil
+		}
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return json.String(node.Value), nil
+		}
+		return json.Float(f), nil
+	case floatTag:
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, err
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return json.String(strconv.FormatFloat(f, 'f', -1, 64)), nil
+		}
+		return json.Float(f), nil
+	default:
+		return json.String(node.Value), nil
+	}
+}
@@ -0,0 +1,145 @@
+package yaml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+var decodeTests = []struct {
+	Name   string
+	Input  string
+	Expect json.Structure
+	Error  string
+}{
+	{
+		Name: "Decode",
+		Input: "# comment\n" +
+			"- name: a\n" +
+			"  count: 1\n" +
+			"  rate: 1.5\n" +
+			"  enabled: true\n" +
+			"  note: ~\n" +
+			"  code: \"001\"\n" +
+			"  tags: [x, y]\n" +
+			"- name: b\n" +
+			"  text: |\n" +
+			"    line1\n" +
+			"    line2\n",
+		Expect: json.Array{
+			json.Object{Members: []json.ObjectMember{
+				{Key: "name", Value: json.String("a")},
+				{Key: "count", Value: json.Integer(1)},
+				{Key: "rate", Value: json.Float(1.5)},
+				{Key: "enabled", Value: json.Boolean(true)},
+				{Key: "note", Value: json.Null{}},
+				{Key: "code", Value: json.String("001")},
+				{Key: "tags", Value: json.Array{json.String("x"), json.String("y")}},
+			}},
+			json.Object{Members: []json.ObjectMember{
+				{Key: "name", Value: json.String("b")},
+				{Key: "text", Value: json.String("line1\nline2\n")},
+			}},
+		},
+	},
+	{
+		Name: "Decode Aliases and Merge Keys",
+		Input: "base: &base\n" +
+			"  port: 80\n" +
+			"  host: localhost\n" +
+			"servers:\n" +
+			"  - <<: *base\n" +
+			"    host: web\n" +
+			"  - *base\n",
+		Expect: json.Object{Members: []json.ObjectMember{
+			{Key: "base", Value: json.Object{Members: []json.ObjectMember{
+				{Key: "port", Value: json.Integer(80)},
+				{Key: "host", Value: json.String("localhost")},
+			}}},
+			{Key: "servers", Value: json.Array{
+				json.Object{Members: []json.ObjectMember{
+					{Key: "port", Value: json.Integer(80)},
+					{Key: "host", Value: json.String("web")},
+				}},
+				json.Object{Members: []json.ObjectMember{
+					{Key: "port", Value: json.Integer(80)},
+					{Key: "host", Value: json.String("localhost")},
+				}},
+			}},
+		}},
+	},
+	{
+		Name: "Decode Multiple Documents",
+		Input: "a: 1\n" +
+			"---\n" +
+			"a: 2\n",
+		Expect: json.Array{
+			json.Object{Members: []json.ObjectMember{
+				{Key: "a", Value: json.Integer(1)},
+			}},
+			json.Object{Members: []json.ObjectMember{
+				{Key: "a", Value: json.Integer(2)},
+			}},
+		},
+	},
+	{
+		Name:  "Decode Empty Document Error",
+		Input: "# comment only\n",
+		Error: "yaml document is empty",
+	},
+	{
+		Name: "Decode Recursive Alias Error",
+		Input: "- &a\n" +
+			"  x: 1\n" +
+			"  y: *a\n",
+		Error: "line 3, column 6: alias *a refers to a node containing itself",
+	},
+	{
+		Name: "Decode Recursive Merge Key Error",
+		Input: "a: &a\n" +
+			"  x: 1\n" +
+			"  <<: *a\n",
+		Error: "line 3, column 7: alias *a refers to a node containing itself",
+	},
+	{
+		Name: "Decode Alias Expansion Limit Error",
+		Input: "a: &a [x, x, x, x, x, x, x, x, x, x]\n" +
+			"b: &b [*a, *a, *a, *a, *a, *a, *a, *a, *a, *a]\n" +
+			"c: &c [*b, *b, *b, *b, *b, *b, *b, *b, *b, *b]\n" +
+			"d: &d [*c, *c, *c, *c, *c, *c, *c, *c, *c, *c]\n" +
+			"e: &e [*d, *d, *d, *d, *d, *d, *d, *d, *d, *d]\n" +
+			"f: &f [*e, *e, *e, *e, *e, *e, *e, *e, *e, *e]\n" +
+			"g: &g [*f, *f, *f, *f, *f, *f, *f, *f, *f, *f]\n" +
+			"h: &h [*g, *g, *g, *g, *g, *g, *g, *g, *g, *g]\n" +
+			"i: &i [*h, *h, *h, *h, *h, *h, *h, *h, *h, *h]\n",
+		Error: "line 1, column 20: aliases are expanded to too many nodes",
+	},
+	{
+		Name:  "Decode Syntax Error",
+		Input: "a: [1, 2\n",
+		Error: "yaml: line 1: did not find expected ',' or ']'",
+	},
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range decodeTests {
+		result, err := Decode(strings.NewReader(v.Input))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result.Encode(), v.Expect.Encode())
+		}
+	}
+}
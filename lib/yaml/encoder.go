@@ -0,0 +1,98 @@
+package yaml
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/json"
+	"gopkg.in/yaml.v3"
+)
+
+const IndentSpaces = 2
+
+// Encode converts a JSON structure to a YAML document.
+func Encode(data json.Structure, lineBreak text.LineBreak) (string, error) {
+	node, err := convertToNode(data)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	e := yaml.NewEncoder(buf)
+	e.SetIndent(IndentSpaces)
+	if err := e.Encode(node); err != nil {
+		return "", err
+	}
+	if err := e.Close(); err != nil {
+		return "", err
+	}
+
+	s := buf.String()
+	if lineBreak != text.LF {
+		s = strings.Replace(s, text.LF.Value(), lineBreak.Value(), -1)
+	}
+	return s, nil
+}
+
+func convertToNode(data json.Structure) (*yaml.Node, error) {
+	switch data.(type) {
+	case json.Object:
+		obj := data.(json.Object)
+		node := &yaml.Node{
+			Kind:    yaml.MappingNode,
+			Content: make([]*yaml.Node, 0, obj.Len()*2),
+		}
+		for _, m := range obj.Members {
+			val, err := convertToNode(m.Value)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, newScalarNode(strTag, m.Key), val)
+		}
+		return node, nil
+	case json.Array:
+		ar := data.(json.Array)
+		node := &yaml.Node{
+			Kind:    yaml.SequenceNode,
+			Content: make([]*yaml.Node, 0, len(ar)),
+		}
+		for _, v := range ar {
+			val, err := convertToNode(v)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, val)
+		}
+		return node, nil
+	case json.String:
+		return newScalarNode(strTag, data.(json.String).Raw()), nil
+	case json.Integer:
+		return newScalarNode(intTag, strconv.FormatInt(data.(json.Integer).Raw(), 10)), nil
+	case json.Float:
+		return newFloatNode(data.(json.Float).Raw())
+	case json.Number:
+		return newFloatNode(data.(json.Number).Raw())
+	case json.Boolean:
+		return newScalarNode(boolTag, strconv.FormatBool(data.(json.Boolean).Raw())), nil
+	default: // json.Null
+		return newScalarNode(nullTag, "null"), nil
+	}
+}
+
+func newScalarNode(tag string, value string) *yaml.Node {
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   tag,
+		Value: value,
+	}
+}
+
+func newFloatNode(f float64) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(f); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
@@ -0,0 +1,77 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/json"
+)
+
+var encodeTests = []struct {
+	Name      string
+	Input     json.Structure
+	LineBreak text.LineBreak
+	Expect    string
+}{
+	{
+		Name: "Encode",
+		Input: json.Array{
+			json.Object{Members: []json.ObjectMember{
+				{Key: "name", Value: json.String("a")},
+				{Key: "count", Value: json.Integer(1)},
+				{Key: "rate", Value: json.Float(1.5)},
+				{Key: "enabled", Value: json.Boolean(true)},
+				{Key: "note", Value: json.Null{}},
+				{Key: "code", Value: json.String("001")},
+				{Key: "nested", Value: json.Object{Members: []json.ObjectMember{
+					{Key: "tags", Value: json.Array{json.String("x"), json.String("y")}},
+				}}},
+			}},
+			json.Object{Members: []json.ObjectMember{
+				{Key: "name", Value: json.String("line1\nline2")},
+			}},
+		},
+		LineBreak: text.LF,
+		Expect: "- name: a\n" +
+			"  count: 1\n" +
+			"  rate: 1.5\n" +
+			"  enabled: true\n" +
+			"  note: null\n" +
+			"  code: \"001\"\n" +
+			"  nested:\n" +
+			"    tags:\n" +
+			"      - x\n" +
+			"      - y\n" +
+			"- name: |-\n" +
+			"    line1\n" +
+			"    line2\n",
+	},
+	{
+		Name:      "Encode Empty Array",
+		Input:     json.Array{},
+		LineBreak: text.CRLF,
+		Expect:    "[]\r\n",
+	},
+	{
+		Name: "Encode with CRLF",
+		Input: json.Object{Members: []json.ObjectMember{
+			{Key: "a", Value: json.Integer(1)},
+			{Key: "b", Value: json.Integer(2)},
+		}},
+		LineBreak: text.CRLF,
+		Expect:    "a: 1\r\nb: 2\r\n",
+	},
+}
+
+func TestEncode(t *testing.T) {
+	for _, v := range encodeTests {
+		result, err := Encode(v.Input, v.LineBreak)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Expect)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/action"
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -66,7 +67,7 @@ func main() {
 		cli.StringFlag{
 			Name:  "delimiter, d",
 			Value: ",",
-			Usage: "field delimiter for CSV",
+			Usage: "field delimiter for CSV, or \"AUTO\" to detect it",
 		},
 		cli.StringFlag{
 			Name:  "delimiter-positions, m",
@@ -89,6 +90,36 @@ func main() {
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.BoolFlag{
+			Name:  "lenient",
+			Usage: "pad or truncate ragged rows and skip malformed rows of CSV",
+		},
+		cli.StringFlag{
+			Name:  "reject-file",
+			Usage: "file path to write rows skipped in lenient mode",
+		},
+		cli.IntFlag{
+			Name:  "skip-lines",
+			Usage: "number of leading lines to skip in CSV",
+		},
+		cli.StringFlag{
+			Name:  "comment",
+			Usage: "prefix of lines to be ignored in CSV",
+		},
+		cli.StringFlag{
+			Name:  "quote",
+			Value: "\"",
+			Usage: "quotation mark for CSV",
+		},
+		cli.StringFlag{
+			Name:  "quote-escape",
+			Value: "DOUBLE",
+			Usage: "escaping of quotation marks in CSV. one of: DOUBLE|BACKSLASH",
+		},
+		cli.StringFlag{
+			Name:  "locale",
+			Usage: "locale in which numbers and dates are written in files. e.g. de-DE",
+		},
 		cli.StringFlag{
 			Name:  "out, o",
 			Usage: "export result sets of select queries to `FILE`",
@@ -116,6 +147,16 @@ func main() {
 			Name:  "write-delimiter-positions, M",
 			Usage: "delimiter positions for FIXED in query results",
 		},
+		cli.StringFlag{
+			Name:  "write-quote",
+			Value: "\"",
+			Usage: "quotation mark for CSV in query results",
+		},
+		cli.StringFlag{
+			Name:  "write-quote-escape",
+			Value: "DOUBLE",
+			Usage: "escaping of quotation marks for CSV in query results. one of: DOUBLE|BACKSLASH",
+		},
 		cli.BoolFlag{
 			Name:  "without-header, N",
 			Usage: "export result sets of select queries without the header line",
@@ -136,7 +177,40 @@ func main() {
 		},
 		cli.BoolFlag{
 			Name:  "pretty-print, P",
-			Usage: "make JSON output easier to read in query results",
+			Usage: "make JSON and XML output easier to read in query results",
+		},
+		cli.StringFlag{
+			Name:  "xml-root-element",
+			Value: "rows",
+			Usage: "root element name for XML in query results",
+		},
+		cli.StringFlag{
+			Name:  "xml-row-element",
+			Value: "row",
+			Usage: "row element name for XML in query results",
+		},
+		cli.StringFlag{
+			Name:  "sql-table",
+			Usage: "table name for SQL in query results",
+		},
+		cli.StringFlag{
+			Name:  "sql-dialect",
+			Value: "POSTGRES",
+			Usage: "SQL dialect in query results",
+		},
+		cli.StringFlag{
+			Name:  "border-style",
+			Value: "ASCII",
+			Usage: "border style of text tables in query results",
+		},
+		cli.StringFlag{
+			Name:  "expanded",
+			Value: "OFF",
+			Usage: "display each record of text tables as a list of fields",
+		},
+		cli.BoolFlag{
+			Name:  "html-document",
+			Usage: "wrap result sets in HTML format into a self-contained HTML document",
 		},
 		cli.BoolFlag{
 			Name:  "east-asian-encoding, W",
@@ -172,6 +246,10 @@ func main() {
 			Name:  "stats, x",
 			Usage: "show execution time and memory statistics",
 		},
+		cli.BoolFlag{
+			Name:  "watch",
+			Usage: "re-execute the query when the loaded files or the source file are modified",
+		},
 	}
 
 	app.Commands = []cli.Command{
@@ -211,6 +289,52 @@ func main() {
 				return action.Syntax(ctx, proc, words)
 			}),
 		},
+		{
+			Name:      "diff",
+			Usage:     "Compare two tables by key columns",
+			ArgsUsage: "TABLE1 TABLE2",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "key, k",
+					Usage: "comma-separated key columns",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 2 != c.NArg() {
+					return query.NewIncorrectCommandUsageError("diff subcommand takes exactly 2 arguments")
+				}
+				if len(c.String("key")) < 1 {
+					return query.NewIncorrectCommandUsageError("diff subcommand requires key columns")
+				}
+
+				keys := strings.Split(c.String("key"), ",")
+				for i := range keys {
+					keys[i] = strings.TrimSpace(keys[i])
+				}
+				return action.Diff(ctx, proc, c.Args().Get(0), c.Args().Get(1), keys)
+			}),
+		},
+		{
+			Name:      "profile",
+			Usage:     "Show statistics of values in each column of a table",
+			ArgsUsage: "TABLE",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "top, n",
+					Value: query.DefaultProfileTopN,
+					Usage: "number of the most frequent values to show",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 1 != c.NArg() {
+					return query.NewIncorrectCommandUsageError("profile subcommand takes exactly 1 argument")
+				}
+				if c.Int("top") < 0 {
+					return query.NewIncorrectCommandUsageError("top must be a non-negative integer")
+				}
+				return action.Profile(ctx, proc, c.Args().First(), c.Int("top"))
+			}),
+		},
 		{
 			Name:      "check-update",
 			Usage:     "Check for updates",
@@ -246,7 +370,18 @@ func main() {
 		}
 
 		if len(queryString) < 1 {
+			if c.GlobalBool("watch") {
+				return query.NewIncorrectCommandUsageError("\"--watch\" option cannot be used in the interactive shell")
+			}
 			err = action.LaunchInteractiveShell(ctx, proc)
+		} else if c.GlobalBool("watch") {
+			if proc.Tx.Session.CanReadStdin {
+				return query.NewIncorrectCommandUsageError("\"--watch\" option cannot be used with input from pipe or redirection")
+			}
+			if 0 < len(c.GlobalString("out")) {
+				return query.NewIncorrectCommandUsageError("\"--watch\" option cannot be used with \"--out\" option")
+			}
+			err = action.Watch(ctx, proc, queryString, path)
 		} else {
 			err = action.Run(ctx, proc, queryString, path, c.GlobalString("out"))
 		}
@@ -306,9 +441,15 @@ func commandAction(fn func(ctx context.Context, c *cli.Context, proc *query.Proc
 		var signalReceived error
 
 		go func() {
-			sig := <-ch
-			signalReceived = query.NewSignalReceived(sig)
-			cancel()
+			for sig := range ch {
+				if sig == os.Interrupt && proc.Tx.Session.Terminal() != nil {
+					// In the interactive shell, an interrupt cancels only the running statement.
+					continue
+				}
+				signalReceived = query.NewSignalReceived(sig)
+				cancel()
+				return
+			}
 		}()
 
 		// Run pre-load commands
@@ -383,6 +524,33 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("without-null") {
 		_ = tx.SetFlag(cmd.WithoutNullFlag, c.GlobalBool("without-null"))
 	}
+	if c.GlobalIsSet("lenient") {
+		_ = tx.SetFlag(cmd.LenientFlag, c.GlobalBool("lenient"))
+	}
+	if c.GlobalIsSet("reject-file") {
+		_ = tx.SetFlag(cmd.RejectFileFlag, c.GlobalString("reject-file"))
+	}
+	if c.GlobalIsSet("skip-lines") {
+		_ = tx.SetFlag(cmd.SkipLinesFlag, c.GlobalInt64("skip-lines"))
+	}
+	if c.GlobalIsSet("comment") {
+		_ = tx.SetFlag(cmd.CommentFlag, c.GlobalString("comment"))
+	}
+	if c.GlobalIsSet("quote") {
+		if err := tx.SetFlag(cmd.QuoteFlag, c.GlobalString("quote")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("quote-escape") {
+		if err := tx.SetFlag(cmd.QuoteEscapeFlag, c.GlobalString("quote-escape")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("locale") {
+		if err := tx.SetFlag(cmd.LocaleFlag, c.GlobalString("locale")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 
 	if c.GlobalIsSet("strip-ending-line-break") {
 		_ = tx.SetFlag(cmd.StripEndingLineBreakFlag, c.GlobalBool("strip-ending-line-break"))
@@ -407,6 +575,16 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 			return query.NewIncorrectCommandUsageError(err.Error())
 		}
 	}
+	if c.GlobalIsSet("write-quote") {
+		if err := tx.SetFlag(cmd.ExportQuoteFlag, c.GlobalString("write-quote")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("write-quote-escape") {
+		if err := tx.SetFlag(cmd.ExportQuoteEscapeFlag, c.GlobalString("write-quote-escape")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 	if c.GlobalIsSet("without-header") {
 		_ = tx.SetFlag(cmd.WithoutHeaderFlag, c.GlobalBool("without-header"))
 	}
@@ -426,6 +604,37 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("pretty-print") {
 		_ = tx.SetFlag(cmd.PrettyPrintFlag, c.GlobalBool("pretty-print"))
 	}
+	if c.GlobalIsSet("xml-root-element") {
+		if err := tx.SetFlag(cmd.XmlRootElementFlag, c.GlobalString("xml-root-element")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("xml-row-element") {
+		if err := tx.SetFlag(cmd.XmlRowElementFlag, c.GlobalString("xml-row-element")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("sql-table") {
+		_ = tx.SetFlag(cmd.SqlTableFlag, c.GlobalString("sql-table"))
+	}
+	if c.GlobalIsSet("sql-dialect") {
+		if err := tx.SetFlag(cmd.SqlDialectFlag, c.GlobalString("sql-dialect")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("border-style") {
+		if err := tx.SetFlag(cmd.BorderStyleFlag, c.GlobalString("border-style")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("expanded") {
+		if err := tx.SetFlag(cmd.ExpandedFlag, c.GlobalString("expanded")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("html-document") {
+		tx.Flags.SetHtmlDocument(c.GlobalBool("html-document"))
+	}
 
 	if c.GlobalIsSet("east-asian-encoding") {
 		_ = tx.SetFlag(cmd.EastAsianEncodingFlag, c.GlobalBool("east-asian-encoding"))